@@ -0,0 +1,159 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
+
+	gasfreekeeper "github.com/AltheaFoundation/althea-L1/x/gasfree/keeper"
+	gasfreetypes "github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// DeductAlternativeFeeDecorator charges the fee of Txs which pay entirely in one of the gasfree module's
+// AlternativeFeeDenoms, in place of the min gas price checks and DeductFeeDecorator which only accept the native token.
+// Txs paying any other fee are passed along untouched.
+//
+// The fee is converted to its native token value, which must cover both the global MinGasPrice and (in CheckTx)
+// the validator's minimum gas price for the native token, before it is collected by the gasfree module.
+type DeductAlternativeFeeDecorator struct {
+	ak              AccountKeeper
+	feegrantKeeper  ante.FeegrantKeeper
+	gasfreeKeeper   gasfreekeeper.Keeper
+	feeMarketKeeper feemarketkeeper.Keeper
+	evmKeeper       *evmkeeper.Keeper
+}
+
+func NewDeductAlternativeFeeDecorator(
+	ak AccountKeeper,
+	feegrantKeeper ante.FeegrantKeeper,
+	gasfreeKeeper gasfreekeeper.Keeper,
+	feeMarketKeeper feemarketkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
+) DeductAlternativeFeeDecorator {
+	if evmKeeper == nil {
+		panic("evm keeper is required for DeductAlternativeFeeDecorator")
+	}
+
+	return DeductAlternativeFeeDecorator{
+		ak:              ak,
+		feegrantKeeper:  feegrantKeeper,
+		gasfreeKeeper:   gasfreeKeeper,
+		feeMarketKeeper: feeMarketKeeper,
+		evmKeeper:       evmKeeper,
+	}
+}
+
+// AnteHandle checks the value of an alternative fee against the minimum gas prices, deducts it from the fee payer
+// (or fee granter), and sets the Tx priority from the native value paid per unit of gas
+func (dafd DeductAlternativeFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	altDenom, isAltFee := dafd.gasfreeKeeper.GetAlternativeFeeDenomForFee(ctx, fee)
+	if !isAltFee {
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	if !simulate && (ctx.BlockHeight() > 0 && gas == 0) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	feeValue, err := dafd.gasfreeKeeper.GetAlternativeFeeNativeValue(ctx, altDenom, fee[0])
+	if err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "unable to value alternative fee %s: %v", fee, err)
+	}
+
+	if !simulate {
+		evmDenom := dafd.evmKeeper.GetParams(ctx).EvmDenom
+		requiredValue := dafd.getRequiredFeeValue(ctx, evmDenom, gas)
+		if feeValue.LT(requiredValue) {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"insufficient fee; got: %s worth %s%s, required: %s%s", fee, feeValue, evmDenom, requiredValue, evmDenom,
+			)
+		}
+	}
+
+	if err := dafd.deductFee(ctx, tx, feeTx, altDenom, fee); err != nil {
+		return ctx, err
+	}
+
+	priority := int64(0)
+	if gas > 0 {
+		priorityInt := feeValue.Quo(sdk.NewIntFromUint64(gas))
+		priority = math.MaxInt64
+		if priorityInt.IsInt64() {
+			priority = priorityInt.Int64()
+		}
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// getRequiredFeeValue returns the minimum native token value the fee of a Tx wanting gas must have
+func (dafd DeductAlternativeFeeDecorator) getRequiredFeeValue(ctx sdk.Context, evmDenom string, gas uint64) sdk.Int {
+	minGasPrice := dafd.feeMarketKeeper.GetParams(ctx).MinGasPrice
+	// Validators may only raise the minimum in CheckTx, just like the usual DeductFeeDecorator check
+	if ctx.IsCheckTx() {
+		validatorMinGasPrice := ctx.MinGasPrices().AmountOf(evmDenom)
+		if validatorMinGasPrice.GT(minGasPrice) {
+			minGasPrice = validatorMinGasPrice
+		}
+	}
+
+	return minGasPrice.MulInt64(int64(gas)).Ceil().TruncateInt()
+}
+
+// deductFee collects the alternative fee from the fee granter if one is set, otherwise from the fee payer
+func (dafd DeductAlternativeFeeDecorator) deductFee(
+	ctx sdk.Context, tx sdk.Tx, feeTx sdk.FeeTx, altDenom gasfreetypes.AlternativeFeeDenom, fee sdk.Coins,
+) error {
+	if addr := dafd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := feePayer
+
+	if feeGranter != nil {
+		if dafd.feegrantKeeper == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			err := dafd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	if acc := dafd.ak.GetAccount(ctx, deductFeesFrom); acc == nil {
+		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if err := dafd.gasfreeKeeper.CollectAlternativeFee(ctx, deductFeesFrom, altDenom, fee); err != nil {
+		return errorsmod.Wrap(err, "unable to collect alternative fee")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+	))
+
+	return nil
+}
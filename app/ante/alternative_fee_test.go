@@ -0,0 +1,100 @@
+package ante_test
+
+import (
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	altheaconfig "github.com/AltheaFoundation/althea-L1/config"
+	gasfreetypes "github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// Checks that fees paid in an alternative fee denom are accepted only when the denom is registered and the fee is worth
+// enough native token, and that they are deducted from the payer in place of the native token
+func (suite *AnteTestSuite) TestDeductAlternativeFeeDecorator() {
+	suite.SetupTest()
+
+	altDenom := "alt"
+	holding := int64(10000000000)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(altDenom, sdk.NewInt(holding)))))
+	//nolint: exhaustruct
+	metadata := banktypes.Metadata{
+		Base:        altDenom,
+		Display:     altDenom,
+		Name:        altDenom,
+		Description: "Alternative fee coin",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: altDenom, Exponent: 0, Aliases: []string{}}},
+		Symbol:      strings.ToUpper(altDenom),
+	}
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+
+	// Validators only accept the native token, requiring TestGasLimit * 100 aalthea worth of fees
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(altheaconfig.BaseDenom, sdk.NewInt(100))))
+	privKey := suite.NewCosmosPrivkey()
+	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
+	suite.FundAccount(suite.ctx, addr, big.NewInt(holding))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(altDenom, sdk.NewInt(holding)))))
+
+	// Each alt is worth 2 aalthea, so half the native fee is required
+	requiredFee := sdk.NewIntFromUint64(TestGasLimit * 100 / 2)
+	createTx := func(fee sdk.Int) sdk.Tx {
+		msg := suite.CreateTestCosmosMsgSend(sdk.NewInt(0), altheaconfig.BaseDenom, sdk.NewInt(1000), addr, addr)
+		msg.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(altDenom, fee)))
+		return suite.CreateSignedCosmosTx(suite.ctx, msg, privKey)
+	}
+	sufficientTx := createTx(requiredFee)
+	insufficientTx := createTx(requiredFee.SubRaw(1))
+
+	// An unregistered denom is rejected by the validator min gas prices
+	unregisteredCtx, _ := suite.ctx.CacheContext()
+	_, err := suite.anteHandler(unregisteredCtx, sufficientTx, false)
+	suite.Require().Error(err)
+
+	altFeeDenom := gasfreetypes.AlternativeFeeDenom{
+		Denom:                      altDenom,
+		StaticRate:                 sdk.NewDec(2),
+		DexPoolIdx:                 0,
+		SwapToNative:               false,
+		MaxSwapSlippageBasisPoints: 0,
+	}
+	suite.app.GasfreeKeeper.SetAlternativeFeeDenoms(suite.ctx, []gasfreetypes.AlternativeFeeDenom{altFeeDenom})
+
+	// A fee worth less than the required native value is rejected
+	insufficientCtx, _ := suite.ctx.CacheContext()
+	_, err = suite.anteHandler(insufficientCtx, insufficientTx, false)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "insufficient fee")
+
+	// A fee worth enough is collected in the alternative denom, leaving the native balance untouched
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	sufficientCtx, _ := suite.ctx.CacheContext()
+	nativeBefore := suite.app.BankKeeper.GetBalance(sufficientCtx, addr, altheaconfig.BaseDenom)
+	altBefore := suite.app.BankKeeper.GetBalance(sufficientCtx, addr, altDenom)
+	collectedBefore := suite.app.BankKeeper.GetBalance(sufficientCtx, feeCollector, altDenom)
+	newCtx, err := suite.anteHandler(sufficientCtx, sufficientTx, false)
+	suite.Require().NoError(err)
+	// Priority is the native value paid per unit of gas
+	suite.Require().Equal(int64(100), newCtx.Priority())
+
+	suite.Require().Equal(nativeBefore, suite.app.BankKeeper.GetBalance(sufficientCtx, addr, altheaconfig.BaseDenom))
+	suite.Require().Equal(altBefore.Amount.Sub(requiredFee), suite.app.BankKeeper.GetBalance(sufficientCtx, addr, altDenom).Amount)
+	suite.Require().Equal(collectedBefore.Amount.Add(requiredFee), suite.app.BankKeeper.GetBalance(sufficientCtx, feeCollector, altDenom).Amount)
+
+	// Fees which are not swapped to native stay in their denom, which the distribution module pays out to validators
+	// and delegators from the fee collector just like the native token
+	validator := suite.app.StakingKeeper.GetAllValidators(sufficientCtx)[0]
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: consAddr, Power: 1}, SignedLastBlock: true}}
+	suite.app.DistrKeeper.AllocateTokens(sufficientCtx, 1, 1, consAddr, votes)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(sufficientCtx, feeCollector, altDenom).IsZero())
+	rewards := suite.app.DistrKeeper.GetValidatorOutstandingRewards(sufficientCtx, validator.GetOperator())
+	suite.Require().True(rewards.Rewards.AmountOf(altDenom).IsPositive())
+}
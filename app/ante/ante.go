@@ -102,14 +102,24 @@ func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
-		// Gasfree txs ignore the min gas price requirement
-		gasfree.NewSelectiveBypassDecorator(*options.GasfreeKeeper, ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper)),
+		// Gasfree txs ignore the min gas price requirement, alternative fee txs check it in DeductAlternativeFeeDecorator
+		gasfree.NewSelectiveBypassDecorator(*options.GasfreeKeeper, gasfree.NewAlternativeFeeBypassDecorator(*options.GasfreeKeeper,
+			ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		)),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		// Gasfree txs do not have fees deducted the normal way, their fees will be deducted separately
-		gasfree.NewSelectiveBypassDecorator(*options.GasfreeKeeper, ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil)),
+		gasfree.NewSelectiveBypassDecorator(*options.GasfreeKeeper, gasfree.NewAlternativeFeeBypassDecorator(*options.GasfreeKeeper,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		)),
+		// Charge fees paid in an alternative fee denom
+		gasfree.NewSelectiveBypassDecorator(*options.GasfreeKeeper,
+			NewDeductAlternativeFeeDecorator(options.AccountKeeper, options.FeegrantKeeper, *options.GasfreeKeeper, options.FeeMarketKeeper, options.EvmKeeper),
+		),
 		// Charge gas fees for gasfree messages
 		NewChargeGasfreeFeesDecorator(options.AccountKeeper, *options.GasfreeKeeper, *options.MicrotxKeeper),
+		// Gasfree txs skip the fee based priority set in DeductFeeDecorator, so derive it from their in-token fees instead
+		gasfree.NewGasfreePriorityDecorator(*options.GasfreeKeeper),
 		NewValidatorCommissionDecorator(options.Cdc),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
@@ -133,10 +143,15 @@ func newCosmosAnteHandlerEip712(options HandlerOptions) sdk.AnteHandler {
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
-		ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		// Alternative fee txs check the min gas price in DeductAlternativeFeeDecorator
+		gasfree.NewAlternativeFeeBypassDecorator(*options.GasfreeKeeper, ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper)),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		gasfree.NewAlternativeFeeBypassDecorator(*options.GasfreeKeeper,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
+		),
+		// Charge fees paid in an alternative fee denom
+		NewDeductAlternativeFeeDecorator(options.AccountKeeper, options.FeegrantKeeper, *options.GasfreeKeeper, options.FeeMarketKeeper, options.EvmKeeper),
 		NewValidatorCommissionDecorator(options.Cdc),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
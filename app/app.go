@@ -133,8 +133,12 @@ import (
 	"github.com/AltheaFoundation/althea-L1/app/ante"
 	altheaappparams "github.com/AltheaFoundation/althea-L1/app/params"
 	"github.com/AltheaFoundation/althea-L1/app/upgrades"
+	"github.com/AltheaFoundation/althea-L1/app/upgrades/sirius"
 	"github.com/AltheaFoundation/althea-L1/app/upgrades/tethys"
 	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	"github.com/AltheaFoundation/althea-L1/x/circuit"
+	circuitkeeper "github.com/AltheaFoundation/althea-L1/x/circuit/keeper"
+	circuittypes "github.com/AltheaFoundation/althea-L1/x/circuit/types"
 	"github.com/AltheaFoundation/althea-L1/x/erc20"
 	erc20client "github.com/AltheaFoundation/althea-L1/x/erc20/client"
 	erc20keeper "github.com/AltheaFoundation/althea-L1/x/erc20/keeper"
@@ -223,6 +227,7 @@ var (
 		erc20.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	}
 	ModuleBasics = append(
 		ModuleBasicsLessGroup,
@@ -233,29 +238,35 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmtypes.FeeBurner:             {authtypes.Burner},
-		minttypes.ModuleName:           {authtypes.Minter},
-		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
-		lockuptypes.ModuleName:         nil,
-		microtxtypes.ModuleName:        nil,
-		gasfreetypes.ModuleName:        nil,
-		onboardingtypes.ModuleName:     nil,
-		nativedextypes.ModuleName:      nil,
-		feemarkettypes.ModuleName:      nil,
-		icatypes.ModuleName:            nil,
+		authtypes.FeeCollectorName:          nil,
+		distrtypes.ModuleName:               nil,
+		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                 {authtypes.Burner},
+		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmtypes.FeeBurner:                  {authtypes.Burner},
+		minttypes.ModuleName:                {authtypes.Minter},
+		erc20types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		lockuptypes.ModuleName:              nil,
+		microtxtypes.ModuleName:             nil,
+		gasfreetypes.ModuleName:             nil,
+		onboardingtypes.ModuleName:          nil,
+		nativedextypes.ModuleName:           nil,
+		nativedextypes.IncentivesModuleName: nil,
+		feemarkettypes.ModuleName:           nil,
+		icatypes.ModuleName:                 nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
 		distrtypes.ModuleName: true,
 		evmtypes.FeeBurner:    true,
+		// nativedex receives the DEX treasury proceeds as they are converted to Cosmos coins, and the native token
+		// from the swaps of gasfree's alternative fees
+		nativedextypes.ModuleName: true,
+		// nativedex_incentives holds the liquidity incentive funds withdrawn from the community pool
+		nativedextypes.IncentivesModuleName: true,
 	}
 
 	// enable checks that run on the first BeginBlocker execution after an upgrade/genesis init/node restart
@@ -310,6 +321,7 @@ type AltheaApp struct { // nolint: golint
 	GasfreeKeeper    *gasfreekeeper.Keeper
 	OnboardingKeeper *onboardingkeeper.Keeper
 	NativedexKeeper  *nativedexkeeper.Keeper
+	CircuitKeeper    *circuitkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      *capabilitykeeper.ScopedKeeper
@@ -406,18 +418,25 @@ func (app AltheaApp) ValidateMembers() {
 	if app.LockupKeeper == nil {
 		panic("Nil LockupKeeper!")
 	}
+	// Ensure the erc20 keeper is set on the lockup keeper
+	app.LockupKeeper.ValidateDependencies()
 	if app.MicrotxKeeper == nil {
 		panic("Nil MicrotxKeeper!")
 	}
 	if app.GasfreeKeeper == nil {
 		panic("Nil GasfreeKeeper!")
 	}
+	// Ensure the microtx, erc20, and nativedex keepers are set on the gasfree keeper
+	app.GasfreeKeeper.ValidateDependencies()
 	if app.OnboardingKeeper == nil {
 		panic("Nil OnboardingKeeper")
 	}
 	if app.NativedexKeeper == nil {
 		panic("Nil NativedexKeeper")
 	}
+	if app.CircuitKeeper == nil {
+		panic("Nil CircuitKeeper")
+	}
 
 	// scoped keepers
 	if app.ScopedIBCKeeper == nil {
@@ -474,7 +493,7 @@ func NewAltheaApp(
 		icahosttypes.StoreKey, group.StoreKey, feegrant.StoreKey,
 
 		lockuptypes.StoreKey, microtxtypes.StoreKey, gasfreetypes.StoreKey,
-		onboardingtypes.StoreKey, nativedextypes.StoreKey,
+		onboardingtypes.StoreKey, nativedextypes.StoreKey, circuittypes.StoreKey,
 	)
 	// Transient keys which only last for a block before being wiped
 	// Params uses thsi to track whether some parameter changed this block or not
@@ -652,7 +671,7 @@ func NewAltheaApp(
 
 	// Gasfree allows for gasless transactions by bypassing the gas charging ante handlers for specific txs consisting of
 	// governance controlled message types. These txs are charged fees out-of-band in a separate ante handler
-	gasfreeKeeper := gasfreekeeper.NewKeeper(appCodec, keys[gasfreetypes.StoreKey], app.GetSubspace(gasfreetypes.ModuleName))
+	gasfreeKeeper := gasfreekeeper.NewKeeper(appCodec, keys[gasfreetypes.StoreKey], app.GetSubspace(gasfreetypes.ModuleName), &bankKeeper)
 	app.GasfreeKeeper = &gasfreeKeeper
 
 	// ERC20 provides translation between Cosmos-style tokens and Ethereum ERC20 contracts so that things like IBC work
@@ -667,9 +686,34 @@ func NewAltheaApp(
 	)
 	app.Erc20Keeper = &erc20Keeper
 
+	// Lockup locks the chain at genesis to prevent native token transfers before the chain is sufficiently decentralized
+	lockupKeeper := lockupkeeper.NewKeeper(
+		appCodec, keys[lockuptypes.StoreKey], app.GetSubspace(lockuptypes.ModuleName), bankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.LockupKeeper = &lockupKeeper
+
+	// The lockup EVM hook needs the token pairs to identify the ERC20 representations of locked tokens
+	lockupKeeper.SetErc20Keeper(&erc20Keeper)
+	// Lockup inspects the funds moved by each Msg type it may lock, modules register inspectors for their own Msgs
+	lockupKeeper.RegisterMsgInspectors(erc20Keeper.LockupMsgInspectors())
+
+	// Circuit allows governance or its appointed emergency authority to disable Msg types and EVM contracts chain-wide
+	circuitKeeper := circuitkeeper.NewKeeper(
+		appCodec, keys[circuittypes.StoreKey], app.GetSubspace(circuittypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.CircuitKeeper = &circuitKeeper
+	// The MsgServiceRouter rejects every Msg type disabled by the circuit keeper before it executes
+	bApp.SetCircuitBreaker(circuitKeeper)
+
 	// Connect the inter-module EVM hooks together, these are the only modules allowed to interact with how contracts are
-	// executed, including ERC20's  Cosmos Coin <-> EVM ERC20 Token translation functions via magic contract address
-	evmKeeper = *evmKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(erc20Keeper.Hooks()))
+	// executed, including ERC20's  Cosmos Coin <-> EVM ERC20 Token translation functions via magic contract address,
+	// the circuit module's rejection of calls to disabled contracts, and the lockup module's rejection of locked token
+	// transfers
+	evmKeeper = *evmKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(circuitKeeper.Hooks(), lockupKeeper.Hooks(), erc20Keeper.Hooks()),
+	)
 	app.EvmKeeper = &evmKeeper
 
 	// Note: onboarding keeper must have transfer keeper and channel keeper and the ics4 wrapper set
@@ -736,9 +780,15 @@ func NewAltheaApp(
 	// Nativedex allows management of the native DEX instance from the Cosmos side
 	nativedexKeeper := nativedexkeeper.NewKeeper(
 		keys[nativedextypes.StoreKey], appCodec, app.GetSubspace(nativedextypes.ModuleName),
-		app.Erc20Keeper,
+		app.Erc20Keeper, app.Erc20Keeper, bankKeeper, distrKeeper, circuitKeeper,
 	)
+	nativedexKeeper.SetProposalHandlerFactory(nativedex.NewNativeDexProposalExecutor)
 	app.NativedexKeeper = &nativedexKeeper
+	lockupKeeper.RegisterMsgInspectors(nativedexKeeper.LockupMsgInspectors())
+	// Every Msg type locked by default must be inspectable, otherwise those Msgs would be rejected while locked
+	if err := lockupKeeper.ValidateMsgInspectors(lockuptypes.DefaultParams().LockedMessageTypes); err != nil {
+		panic(err)
+	}
 
 	// Register custom governance proposal logic via router keys and handler functions
 	govRouter := govv1beta1.NewRouter()
@@ -782,12 +832,6 @@ func NewAltheaApp(
 
 	// Althea custom modules
 
-	// Lockup locks the chain at genesis to prevent native token transfers before the chain is sufficiently decentralized
-	lockupKeeper := lockupkeeper.NewKeeper(
-		appCodec, keys[lockuptypes.StoreKey], app.GetSubspace(lockuptypes.ModuleName),
-	)
-	app.LockupKeeper = &lockupKeeper
-
 	// Microtx enables peer-to-peer automated microtransactions to form the payment layer for Althea-based networks
 	microtxKeeper := microtxkeeper.NewKeeper(
 		keys[microtxtypes.StoreKey], app.GetSubspace(microtxtypes.ModuleName), appCodec,
@@ -795,6 +839,13 @@ func NewAltheaApp(
 	)
 	app.MicrotxKeeper = &microtxKeeper
 
+	// Gasfree fee estimation depends on microtx and erc20 params, which both depend on gasfree
+	gasfreeKeeper.SetMicrotxKeeper(&microtxKeeper)
+	gasfreeKeeper.SetErc20Keeper(&erc20Keeper)
+	// Alternative fee denoms are swapped on the native DEX, and both they and gasfree fees are priced by its TWAP oracle
+	gasfreeKeeper.SetNativedexKeeper(&nativedexKeeper)
+	gasfreeKeeper.SetPriceOracle(&nativedexKeeper)
+
 	// --------------------------------------------------------------------------
 	// ----------------------- AppModule Intitialization ------------------------
 	// --------------------------------------------------------------------------
@@ -883,6 +934,7 @@ func NewAltheaApp(
 		microtx.NewAppModule(microtxKeeper, accountKeeper),
 		onboarding.NewAppModule(onboardingKeeper),
 		nativedex.NewAppModule(nativedexKeeper, accountKeeper),
+		circuit.NewAppModule(circuitKeeper),
 		groupmodule.NewAppModule(appCodec, groupKeeper, accountKeeper, bankKeeper, interfaceRegistry),
 	)
 	app.MM = &mm
@@ -921,6 +973,7 @@ func NewAltheaApp(
 		erc20types.ModuleName,
 		onboardingtypes.ModuleName,
 		nativedextypes.ModuleName,
+		circuittypes.ModuleName,
 		icatypes.ModuleName,
 		group.ModuleName,
 	)
@@ -955,6 +1008,7 @@ func NewAltheaApp(
 		lockuptypes.ModuleName,
 		microtxtypes.ModuleName,
 		nativedextypes.ModuleName,
+		circuittypes.ModuleName,
 		group.ModuleName,
 	)
 
@@ -987,6 +1041,7 @@ func NewAltheaApp(
 		erc20types.ModuleName,
 		onboardingtypes.ModuleName,
 		nativedextypes.ModuleName,
+		circuittypes.ModuleName,
 		group.ModuleName,
 		crisistypes.ModuleName,
 	)
@@ -997,7 +1052,10 @@ func NewAltheaApp(
 
 	mm.RegisterInvariants(&crisisKeeper)
 	mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	configurator := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// Msg services are registered through the lockup middleware, which enforces the lockup on every executed Msg,
+	// including those which skip the AnteHandler such as x/group and x/gov proposals or ICA host packets
+	msgServiceMiddleware := lockup.NewMsgServiceMiddleware(app.MsgServiceRouter(), lockupKeeper)
+	configurator := module.NewConfigurator(appCodec, msgServiceMiddleware, app.GRPCQueryRouter())
 	app.Configurator = &configurator
 	mm.RegisterServices(*app.Configurator)
 
@@ -1070,6 +1128,7 @@ func (app *AltheaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock)
 // firstBeginBlocker runs once at the end of the first BeginBlocker to check static assertions as a validator starts up
 func (app *AltheaApp) firstBeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	app.assertBaseDenomMatchesConfig(ctx)
+	app.assertLockedMsgTypesInspected(ctx)
 }
 
 // EndBlocker delegates the ABCI EndBlock execution to the ModuleManager
@@ -1099,7 +1158,9 @@ func (app *AltheaApp) setAnteHandler(appOpts servertypes.AppOptions) {
 
 	// Create the lockup AnteHandler, to ensure sufficient decentralization before funds may be transferred
 	lockupAnteHandler := lockup.NewWrappedLockupAnteHandler(ah, *app.LockupKeeper, app.AppCodec())
-	app.SetAnteHandler(lockupAnteHandler)
+	// Reject any Msg type or EVM contract disabled by a tripped circuit
+	circuitAnteHandler := circuit.NewWrappedCircuitAnteHandler(lockupAnteHandler, *app.CircuitKeeper, app.AppCodec())
+	app.SetAnteHandler(circuitAnteHandler)
 }
 
 func (app *AltheaApp) setPostHandler() {
@@ -1294,6 +1355,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(onboardingtypes.ModuleName)
 	paramsKeeper.Subspace(nativedextypes.ModuleName)
+	paramsKeeper.Subspace(circuittypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -1332,6 +1394,18 @@ func (app *AltheaApp) registerStoreLoaders() {
 
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	// Sirius
+	if upgradeInfo.Name == sirius.PlanName {
+		// Register the Circuit module as a new module that needs a new store allocated
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added:   sirius.AddedStoreKeys,
+			Renamed: nil,
+			Deleted: nil,
+		}
+
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 func (app *AltheaApp) NewAnteHandlerOptions(appOpts servertypes.AppOptions) ante.HandlerOptions {
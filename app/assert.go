@@ -30,3 +30,17 @@ func (app *AltheaApp) assertBaseDenomMatchesConfig(ctx sdk.Context) {
 		))
 	}
 }
+
+// Assert that every Msg type locked by the lockup module has a registered MsgInspector, since the lockup AnteHandler
+// rejects any locked Msg it is unable to inspect
+// Note: The locked Msg types are a governance controlled param, so a failure here is logged instead of halting the chain
+func (app *AltheaApp) assertLockedMsgTypesInspected(ctx sdk.Context) {
+	if app.LockupKeeper == nil {
+		panic("Unable to assert first BeginBlock configuration, the lockup keeper is nil when it should be initialized!")
+	}
+
+	lockedMsgTypes := app.LockupKeeper.GetLockedMessageTypes(ctx)
+	if err := app.LockupKeeper.ValidateMsgInspectors(lockedMsgTypes); err != nil {
+		ctx.Logger().Error("Locked Msgs of these types will be rejected while the chain is locked", "error", err)
+	}
+}
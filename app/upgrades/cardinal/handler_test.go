@@ -129,6 +129,7 @@ func (suite *HandlerTestSuite) TestEnsureNativedexParams_ParamsAlreadyConfigured
 		VerifiedNativeDexAddress:     verifiedNativeDexAddress,
 		VerifiedCrocPolicyAddress:    verifiedCrocPolicyAddress,
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     "",
 	}
 
 	suite.app.NativedexKeeper.SetParams(suite.ctx, configuredParams)
@@ -185,6 +186,7 @@ func (suite *HandlerTestSuite) TestParamsValidation() {
 		VerifiedNativeDexAddress:     "0xd263DC98dEc57828e26F69bA8687281BA5D052E0",
 		VerifiedCrocPolicyAddress:    "0x14Ae279edb4D569BAFb98ff08299A0135Da6867a",
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     "",
 	}
 	err := validParams.ValidateBasic()
 	suite.Require().NoError(err, "Valid params should pass validation")
@@ -199,6 +201,7 @@ func (suite *HandlerTestSuite) TestParamsValidation() {
 		VerifiedNativeDexAddress:     "not_an_address",
 		VerifiedCrocPolicyAddress:    "0x14Ae279edb4D569BAFb98ff08299A0135Da6867a",
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     "",
 	}
 	err = invalidParams.ValidateBasic()
 	suite.Require().Error(err, "Invalid address should fail validation")
@@ -213,6 +216,7 @@ func (suite *HandlerTestSuite) TestGetNativeDexAddress() {
 		VerifiedNativeDexAddress:     testDexAddr,
 		VerifiedCrocPolicyAddress:    testPolicyAddr,
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     "",
 	}
 
 	suite.app.NativedexKeeper.SetParams(suite.ctx, params)
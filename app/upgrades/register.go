@@ -11,6 +11,7 @@ import (
 	nativedexkeeper "github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
 
 	"github.com/AltheaFoundation/althea-L1/app/upgrades/cardinal"
+	"github.com/AltheaFoundation/althea-L1/app/upgrades/sirius"
 	"github.com/AltheaFoundation/althea-L1/app/upgrades/tethys"
 )
 
@@ -36,4 +37,9 @@ func RegisterUpgradeHandlers(
 		cardinal.TethysToCardinalPlanName,
 		cardinal.GetCardinalUpgradeHandler(mm, configurator, crisisKeeper, distrKeeper, accountKeeper, nativedexKeeper, gasfreeKeeper),
 	)
+	// Sirius upgrade
+	upgradeKeeper.SetUpgradeHandler(
+		sirius.PlanName,
+		sirius.GetSiriusUpgradeHandler(mm, configurator, crisisKeeper, accountKeeper),
+	)
 }
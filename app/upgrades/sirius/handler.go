@@ -0,0 +1,66 @@
+package sirius
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	circuittypes "github.com/AltheaFoundation/althea-L1/x/circuit/types"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// PlanName is the on-chain upgrade plan name this handler is written for.
+// It should match the name supplied in the governance upgrade proposal.
+var PlanName = "sirius"
+
+// AddedStoreKeys are the stores of the modules added by the Sirius upgrade, which must be allocated by the store loader
+var AddedStoreKeys = []string{circuittypes.StoreKey}
+
+// GetSiriusUpgradeHandler returns the upgrade handler for the Sirius upgrade. It runs the module migrations:
+//   - gasfree v1 -> v2, adding the fee accounting epoch length param.
+//   - gasfree v2 -> v3, adding the gasfree priority prices param.
+//   - gasfree v3 -> v4, adding the alternative fee denoms param.
+//   - lockup v1 -> v2, adding the scheduled unlock and locked token denom change params.
+//   - lockup v2 -> v3, moving the lock exempt addresses from the params into the lockup store.
+//   - nativedex v2 -> v3, adding the CrocQuery address param used to price alternative fees.
+//   - nativedex v3 -> v4, adding the timelock, contract selector, auto collect, oracle, and incentive params.
+//   - circuit, which is new and is initialized from its default genesis (including its params).
+//
+// It then creates the nativedex_incentives module account, which holds the funds of the liquidity incentive programs.
+func GetSiriusUpgradeHandler(
+	mm *module.Manager, configurator *module.Configurator, crisisKeeper *crisiskeeper.Keeper, accountKeeper authkeeper.AccountKeeper,
+) func(
+	ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap,
+) (module.VersionMap, error) {
+	if mm == nil || configurator == nil || crisisKeeper == nil {
+		panic("Nil argument to GetSiriusUpgradeHandler")
+	}
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Module Consensus Version Map", "vmap", vmap)
+
+		ctx.Logger().Info("Sirius Upgrade: Running any configured module migrations")
+		out, outErr := mm.RunMigrations(ctx, *configurator, vmap)
+		if outErr != nil {
+			return out, outErr
+		}
+
+		initIncentivesModuleAccount(ctx, accountKeeper)
+
+		ctx.Logger().Info("Asserting invariants after upgrade")
+		crisisKeeper.AssertInvariants(ctx)
+
+		ctx.Logger().Info("Sirius Upgrade Successful")
+		return out, nil
+	}
+}
+
+// initIncentivesModuleAccount creates the nativedex incentives module account, so that the funds of the first incentive
+// program are not sent to a plain account at its address
+func initIncentivesModuleAccount(ctx sdk.Context, accountKeeper authkeeper.AccountKeeper) {
+	modAcc := accountKeeper.GetModuleAccount(ctx, nativedextypes.IncentivesModuleName)
+	if modAcc.GetName() != nativedextypes.IncentivesModuleName {
+		panic("Created account for nativedex incentives does not have the right module name!")
+	}
+}
@@ -0,0 +1,96 @@
+package sirius_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	"github.com/AltheaFoundation/althea-L1/app/upgrades/sirius"
+	circuittypes "github.com/AltheaFoundation/althea-L1/x/circuit/types"
+	gasfreetypes "github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *althea.AltheaApp
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.app = althea.NewSetup(false, func(aa *althea.AltheaApp, gs simapp.GenesisState) simapp.GenesisState {
+		return gs
+	})
+	//nolint: exhaustruct
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "althea_7357-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: althea.ValidatorPubKey.Address().Bytes(),
+	})
+}
+
+// TestRunsEveryMigration checks that the handler brings the pre-upgrade module versions up to the current ones,
+// setting the params added by each migration to their defaults and initializing the new circuit module
+func (suite *HandlerTestSuite) TestRunsEveryMigration() {
+	vmap := suite.app.MM.GetVersionMap()
+	delete(vmap, circuittypes.ModuleName)
+
+	vmap[gasfreetypes.ModuleName] = 1
+	suite.app.GasfreeKeeper.SetFeeAccountingEpochLength(suite.ctx, 1)
+	suite.app.GasfreeKeeper.SetGasFreePriorityPrices(suite.ctx, []gasfreetypes.DenomPrice{{Denom: "ibc/usdc", NativePrice: sdk.OneDec()}})
+	// nolint: exhaustruct
+	suite.app.GasfreeKeeper.SetAlternativeFeeDenoms(suite.ctx, []gasfreetypes.AlternativeFeeDenom{{Denom: "ibc/usdc", StaticRate: sdk.OneDec()}})
+
+	vmap[nativedextypes.ModuleName] = 2
+	nativedexParams := suite.app.NativedexKeeper.GetParams(suite.ctx)
+	nativedexParams.VerifiedCrocQueryAddress = common.HexToAddress("0x1111111111111111111111111111111111111111").Hex()
+	nativedexParams.TimelockBlocks = 10
+	suite.app.NativedexKeeper.SetParams(suite.ctx, nativedexParams)
+
+	vmap[lockuptypes.ModuleName] = 1
+	suite.app.LockupKeeper.SetUnlockHeight(suite.ctx, 100)
+	// The lock exempt addresses are a param until lockup v3, which the current key table no longer registers
+	exempt := sdk.AccAddress([]byte("exempt_address______")).String()
+	lockupParams := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(lockuptypes.ModuleName+"/"))
+	lockupParams.Set(lockuptypes.LockExemptKey, []byte(fmt.Sprintf("[%q]", exempt)))
+
+	handler := sirius.GetSiriusUpgradeHandler(suite.app.MM, suite.app.Configurator, suite.app.CrisisKeeper, *suite.app.AccountKeeper)
+	// nolint: exhaustruct
+	out, err := handler(suite.ctx, upgradetypes.Plan{Name: sirius.PlanName, Height: 1}, vmap)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.MM.GetVersionMap(), out)
+
+	suite.Require().Equal(gasfreetypes.DefaultParams().FeeAccountingEpochLength, suite.app.GasfreeKeeper.GetFeeAccountingEpochLength(suite.ctx))
+	suite.Require().ElementsMatch(gasfreetypes.DefaultParams().GasFreePriorityPrices, suite.app.GasfreeKeeper.GetGasFreePriorityPrices(suite.ctx))
+	suite.Require().ElementsMatch(gasfreetypes.DefaultParams().AlternativeFeeDenoms, suite.app.GasfreeKeeper.GetAlternativeFeeDenoms(suite.ctx))
+	nativedexParams = suite.app.NativedexKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(nativedextypes.DefaultParams().VerifiedCrocQueryAddress, nativedexParams.VerifiedCrocQueryAddress)
+	suite.Require().Equal(nativedextypes.DefaultParams().TimelockBlocks, nativedexParams.TimelockBlocks)
+	suite.Require().Equal(lockuptypes.DefaultParams().UnlockHeight, suite.app.LockupKeeper.GetUnlockHeight(suite.ctx))
+	suite.Require().Contains(suite.app.LockupKeeper.GetLockExemptAddresses(suite.ctx), exempt)
+	suite.Require().Equal(circuittypes.DefaultParams().MaxTripDuration, suite.app.CircuitKeeper.GetMaxTripDuration(suite.ctx))
+
+	incentives := suite.app.AccountKeeper.GetAccount(suite.ctx, nativedextypes.IncentivesModuleAddress)
+	suite.Require().NotNil(incentives)
+	_, isModuleAccount := incentives.(authtypes.ModuleAccountI)
+	suite.Require().True(isModuleAccount)
+}
@@ -13,6 +13,8 @@ var (
 	Uint64Type, u64err   = abi.NewType("uint64", "", nil)
 	Uint128Type, u128err = abi.NewType("uint128", "", nil)
 	Uint256Type, u256err = abi.NewType("uint256", "", nil)
+	Int24Type, i24err    = abi.NewType("int24", "", nil)
+	Int128Type, i128err  = abi.NewType("int128", "", nil)
 	AddressType, adrerr  = abi.NewType("address", "", nil)
 	BoolType, boolerr    = abi.NewType("bool", "", nil)
 )
@@ -20,8 +22,8 @@ var (
 var typeMap map[string]abi.Type = map[string]abi.Type{}
 
 func init() {
-	if u8err != nil || u16err != nil || u32err != nil || u64err != nil || u128err != nil || u256err != nil || adrerr != nil || boolerr != nil {
-		panic(fmt.Sprintf("failed to create ABI types: %v, %v, %v, %v, %v, %v, %v, %v", u8err, u16err, u32err, u64err, u128err, u256err, adrerr, boolerr))
+	if u8err != nil || u16err != nil || u32err != nil || u64err != nil || u128err != nil || u256err != nil || i24err != nil || i128err != nil || adrerr != nil || boolerr != nil {
+		panic(fmt.Sprintf("failed to create ABI types: %v, %v, %v, %v, %v, %v, %v, %v, %v, %v", u8err, u16err, u32err, u64err, u128err, u256err, i24err, i128err, adrerr, boolerr))
 	}
 	typeMap["bool"] = BoolType
 	typeMap["uint8"] = Uint8Type
@@ -30,6 +32,8 @@ func init() {
 	typeMap["uint64"] = Uint64Type
 	typeMap["uint128"] = Uint128Type
 	typeMap["uint256"] = Uint256Type
+	typeMap["int24"] = Int24Type
+	typeMap["int128"] = Int128Type
 	typeMap["address"] = AddressType
 }
 
@@ -64,3 +68,11 @@ func EncodeTypes(names []string, values []interface{}) ([]byte, error) {
 	}
 	return EncodeArguments(args, values)
 }
+
+func DecodeTypes(names []string, data []byte) ([]interface{}, error) {
+	args, err := GetTypeArguments(names)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Arguments(args).Unpack(data)
+}
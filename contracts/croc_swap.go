@@ -0,0 +1,261 @@
+package contracts
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The CrocSwapDex and CrocQuery contracts are deployed from the iFi DEX repo, so only the ABI fragments for the
+// functions called by the Cosmos modules are defined here rather than embedding the full compiled contracts.
+//
+// queryPoolParams and queryPoolTemplate return a PoolSpecs.Pool struct, which is made up entirely of static fields and
+// so has the same ABI encoding as the flattened list of outputs declared here
+//
+// CrocSwapDex keeps its protocol state in internal storage variables without getters, the fields which CrocQuery does
+// not expose are read directly from their storage slots with readSlot, see CrocSlot* below
+
+const crocSwapDexABIJSON = `[
+	{
+		"type": "function",
+		"name": "swap",
+		"stateMutability": "payable",
+		"inputs": [
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"},
+			{"name": "isBuy", "type": "bool"},
+			{"name": "inBaseQty", "type": "bool"},
+			{"name": "qty", "type": "uint128"},
+			{"name": "tip", "type": "uint16"},
+			{"name": "limitPrice", "type": "uint128"},
+			{"name": "minOut", "type": "uint128"},
+			{"name": "reserveFlags", "type": "uint8"}
+		],
+		"outputs": [
+			{"name": "baseFlow", "type": "int128"},
+			{"name": "quoteFlow", "type": "int128"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "userCmd",
+		"stateMutability": "payable",
+		"inputs": [
+			{"name": "callpath", "type": "uint16"},
+			{"name": "cmd", "type": "bytes"}
+		],
+		"outputs": [
+			{"name": "", "type": "bytes"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "readSlot",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "slot", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "data", "type": "uint256"}
+		]
+	}
+]`
+
+const crocQueryABIJSON = `[
+	{
+		"type": "function",
+		"name": "queryPrice",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "", "type": "uint128"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryLiquidity",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "", "type": "uint128"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryCurveTick",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "", "type": "int24"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryPoolParams",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "schema", "type": "uint8"},
+			{"name": "feeRate", "type": "uint16"},
+			{"name": "protocolTake", "type": "uint8"},
+			{"name": "tickSize", "type": "uint16"},
+			{"name": "jitThresh", "type": "uint8"},
+			{"name": "knockoutBits", "type": "uint8"},
+			{"name": "oracleFlags", "type": "uint8"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryAmbientTokens",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "owner", "type": "address"},
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "liq", "type": "uint128"},
+			{"name": "baseQty", "type": "uint128"},
+			{"name": "quoteQty", "type": "uint128"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryRangeTokens",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "owner", "type": "address"},
+			{"name": "base", "type": "address"},
+			{"name": "quote", "type": "address"},
+			{"name": "poolIdx", "type": "uint256"},
+			{"name": "lowerTick", "type": "int24"},
+			{"name": "upperTick", "type": "int24"}
+		],
+		"outputs": [
+			{"name": "liq", "type": "uint128"},
+			{"name": "baseQty", "type": "uint128"},
+			{"name": "quoteQty", "type": "uint128"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "queryPoolTemplate",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "poolIdx", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "schema", "type": "uint8"},
+			{"name": "feeRate", "type": "uint16"},
+			{"name": "protocolTake", "type": "uint8"},
+			{"name": "tickSize", "type": "uint16"},
+			{"name": "jitThresh", "type": "uint8"},
+			{"name": "knockoutBits", "type": "uint8"},
+			{"name": "oracleFlags", "type": "uint8"}
+		]
+	}
+]`
+
+var (
+	// CrocSwapDexABI holds the CrocSwapDex functions used by the Cosmos modules
+	CrocSwapDexABI abi.ABI
+	// CrocQueryABI holds the CrocQuery functions used by the Cosmos modules
+	CrocQueryABI abi.ABI
+
+	// CrocMinSqrtPrice is the lowest price limit accepted by CrocSwapDex, used as the limit for unbounded sells
+	CrocMinSqrtPrice = big.NewInt(65538)
+	// CrocMaxSqrtPrice is the highest price limit accepted by CrocSwapDex, used as the limit for unbounded buys
+	CrocMaxSqrtPrice, _ = new(big.Int).SetString("21267430153580247136652501917186561137", 10)
+)
+
+// CrocSwapDex storage slots, which follow the declaration order of solidity-dex/contracts/mixins/StorageLayout.sol.
+// Slot 0 packs lockHolder_, sudoMode_, msgValSpent_, hotPathOpen_, inSafeMode_ and relayerTakeRate_, followed by the
+// 65536 slots of proxyPaths_, then authority_ and the mappings of the LevelBook, KnockoutLiq, TickCensus and
+// PoolRegistry mixins, whose newPoolLiq_ and protocolTakeRate_ share a slot. After the ProtocolAccount,
+// PositionRegistrar, LiquidityCurve and UserBalance mappings, treasury_ shares the last slot with treasuryStartTime_
+const (
+	CrocFlagsSlot      uint64 = 0
+	CrocProxyPathsSlot uint64 = 1
+	CrocAuthoritySlot  uint64 = 65537
+	CrocNewPoolSlot    uint64 = 65547
+	CrocTreasurySlot   uint64 = 65553
+)
+
+// Offsets of the values packed into CrocSwapDex storage slots, in bytes from the least significant end of the slot
+const (
+	CrocHotPathOpenOffset      = 22
+	CrocSafeModeOffset         = 23
+	CrocRelayerTakeRateOffset  = 24
+	CrocProtocolTakeRateOffset = 16
+)
+
+// CrocProxyPathSlot returns the storage slot holding the address of the sidecar proxy contract at proxy path idx
+func CrocProxyPathSlot(idx uint16) uint64 {
+	return CrocProxyPathsSlot + uint64(idx)
+}
+
+// CrocSlotByte returns the byte stored at offset bytes from the least significant end of a storage slot's value
+func CrocSlotByte(value *big.Int, offset uint) uint8 {
+	shifted := new(big.Int).Rsh(value, 8*offset)
+	return uint8(shifted.And(shifted, big.NewInt(0xff)).Uint64())
+}
+
+// CrocSlotAddress returns the address stored in the least significant 20 bytes of a storage slot's value
+func CrocSlotAddress(value *big.Int) common.Address {
+	var word [32]byte
+	value.FillBytes(word[:])
+	return common.BytesToAddress(word[12:])
+}
+
+// CrocSlotUint128 returns the uint128 stored in the least significant 16 bytes of a storage slot's value
+func CrocSlotUint128(value *big.Int) *big.Int {
+	return new(big.Int).And(value, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)))
+}
+
+// CrocWarmPath is the CrocSwapDex.userCmd() callpath index for liquidity provider commands
+const CrocWarmPath uint16 = 2
+
+// CrocSwapDex warm path command codes, see solidity-dex/contracts/callpaths/WarmPath.sol
+const (
+	CrocMintRangeLiqCode     uint8 = 1
+	CrocBurnRangeLiqCode     uint8 = 2
+	CrocMintAmbientLiqCode   uint8 = 3
+	CrocBurnAmbientLiqCode   uint8 = 4
+	CrocHarvestCode          uint8 = 5
+	CrocMintRangeBaseCode    uint8 = 11
+	CrocMintRangeQuoteCode   uint8 = 12
+	CrocMintAmbientBaseCode  uint8 = 31
+	CrocMintAmbientQuoteCode uint8 = 32
+)
+
+func init() {
+	var err error
+	CrocSwapDexABI, err = abi.JSON(strings.NewReader(crocSwapDexABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	CrocQueryABI, err = abi.JSON(strings.NewReader(crocQueryABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
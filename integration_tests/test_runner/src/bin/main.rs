@@ -17,6 +17,7 @@ use test_runner::bootstrapping::start_ibc_relayer;
 use test_runner::bootstrapping::{deploy_erc20_contracts, get_keys};
 use test_runner::tests::dex::advanced_dex_test;
 use test_runner::tests::dex::basic_dex_test;
+use test_runner::tests::dex::dex_auto_collect_test;
 use test_runner::tests::dex::dex_ops_proposal_test;
 use test_runner::tests::dex::dex_safe_mode_test;
 use test_runner::tests::dex::dex_swap_many;
@@ -278,6 +279,18 @@ pub async fn main() {
             )
             .await;
             return;
+        } else if test_type == "DEX_AUTO_COLLECT" {
+            dex_auto_collect_test(
+                &contact,
+                &web30,
+                keys,
+                EVM_USER_KEYS.clone(),
+                erc20_addresses,
+                dex_contracts,
+                contracts.walthea_address,
+            )
+            .await;
+            return;
         } else if test_type == "DEX_OPS_PROPOSAL" {
             dex_ops_proposal_test(
                 &contact,
@@ -1011,6 +1011,128 @@ pub async fn dex_query_authority(
     Ok(EthAddress::from_slice(&query_res[12..32])?)
 }
 
+// CrocSwapDex storage slots read by the nativedex module through readSlot(), see contracts/croc_swap.go
+pub const DEX_FLAGS_SLOT: u64 = 0;
+pub const DEX_AUTHORITY_SLOT: u64 = 65537;
+pub const DEX_TREASURY_SLOT: u64 = 65553;
+// Byte index of inSafeMode_ in the big-endian DEX_FLAGS_SLOT word, it is packed 23 bytes above the least significant end
+pub const DEX_SAFE_MODE_BYTE: usize = 31 - 23;
+
+pub async fn dex_read_slot(
+    web30: &Web3,
+    dex_contract: EthAddress,
+    caller: Option<EthAddress>,
+    slot: u64,
+) -> Result<Vec<u8>, Web3Error> {
+    // ABI: readSlot (uint256 slot) returns (uint256 data)
+    let caller = caller.unwrap_or(dex_contract);
+    let payload = clarity::abi::encode_call("readSlot(uint256)", &[Uint256::from(slot).into()])?;
+
+    let query_res = web30
+        .simulate_transaction(
+            TransactionRequest::quick_tx(caller, dex_contract, payload),
+            None,
+        )
+        .await?;
+    if query_res.len() != 32 {
+        return Err(Web3Error::ContractCallError(format!(
+            "dex_read_slot: unexpected result length {}",
+            query_res.len()
+        )));
+    }
+
+    Ok(query_res)
+}
+
+pub async fn dex_slot_safe_mode(
+    web30: &Web3,
+    dex_contract: EthAddress,
+    caller: Option<EthAddress>,
+) -> Result<bool, Web3Error> {
+    let slot = dex_read_slot(web30, dex_contract, caller, DEX_FLAGS_SLOT).await?;
+    Ok(slot[DEX_SAFE_MODE_BYTE] > 0u8)
+}
+
+pub async fn dex_slot_authority(
+    web30: &Web3,
+    dex_contract: EthAddress,
+    caller: Option<EthAddress>,
+) -> Result<EthAddress, Web3Error> {
+    let slot = dex_read_slot(web30, dex_contract, caller, DEX_AUTHORITY_SLOT).await?;
+    Ok(EthAddress::from_slice(&slot[12..32])?)
+}
+
+pub async fn dex_slot_treasury(
+    web30: &Web3,
+    dex_contract: EthAddress,
+    caller: Option<EthAddress>,
+) -> Result<EthAddress, Web3Error> {
+    let slot = dex_read_slot(web30, dex_contract, caller, DEX_TREASURY_SLOT).await?;
+    Ok(EthAddress::from_slice(&slot[12..32])?)
+}
+
+// Byte range of treasuryStartTime_ in the big-endian DEX_TREASURY_SLOT word, a uint64 packed above the 20 byte treasury_
+pub const DEX_TREASURY_START_BYTES: std::ops::Range<usize> = 4..12;
+
+/// Reads the unix time from which the DEX allows protocol fees to be collected to its treasury
+pub async fn dex_slot_treasury_start_time(
+    web30: &Web3,
+    dex_contract: EthAddress,
+    caller: Option<EthAddress>,
+) -> Result<u64, Web3Error> {
+    let slot = dex_read_slot(web30, dex_contract, caller, DEX_TREASURY_SLOT).await?;
+    let mut start = [0u8; 8];
+    start.copy_from_slice(&slot[DEX_TREASURY_START_BYTES]);
+    Ok(u64::from_be_bytes(start))
+}
+
+/// Queries the protocol fees of `token` accumulated by the DEX and not yet collected to its treasury
+pub async fn croc_query_protocol_accum(
+    web30: &Web3,
+    croc_query_contract: EthAddress,
+    caller: Option<EthAddress>,
+    token: EthAddress,
+) -> Result<Uint256, Web3Error> {
+    // ABI: queryProtocolAccum (address token) returns (uint128)
+    let caller = caller.unwrap_or(croc_query_contract);
+    let payload = clarity::abi::encode_call("queryProtocolAccum(address)", &[token.into()])?;
+
+    let query_res = web30
+        .simulate_transaction(
+            TransactionRequest::quick_tx(caller, croc_query_contract, payload),
+            None,
+        )
+        .await?;
+
+    Ok(Uint256::from_be_bytes(&query_res))
+}
+
+/// Queries the surplus collateral of `token` which `owner` holds within the DEX, collected protocol fees are paid
+/// to the treasury as surplus collateral
+pub async fn croc_query_surplus(
+    web30: &Web3,
+    croc_query_contract: EthAddress,
+    caller: Option<EthAddress>,
+    owner: EthAddress,
+    token: EthAddress,
+) -> Result<Uint256, Web3Error> {
+    // ABI: querySurplus (address owner, address token) returns (uint128 surplus)
+    let caller = caller.unwrap_or(croc_query_contract);
+    let payload = clarity::abi::encode_call(
+        "querySurplus(address,address)",
+        &[owner.into(), token.into()],
+    )?;
+
+    let query_res = web30
+        .simulate_transaction(
+            TransactionRequest::quick_tx(caller, croc_query_contract, payload),
+            None,
+        )
+        .await?;
+
+    Ok(Uint256::from_be_bytes(&query_res))
+}
+
 /// Specifies an opsResolution call to be made on the CrocPolicy contract
 #[derive(Debug, Clone)]
 pub struct OpsResolutionArgs {
@@ -6,10 +6,12 @@ use crate::bootstrapping::DexAddresses;
 use crate::dex_utils::{
     croc_policy_ops_resolution, croc_policy_treasury_resolution, croc_query_curve_tick,
     croc_query_dex, croc_query_pool_params, croc_query_pool_template, croc_query_price,
-    croc_query_range_position, dex_authority_transfer, dex_direct_protocol_cmd,
-    dex_mint_ambient_in_amount, dex_mint_ranged_in_amount, dex_mint_ranged_pos,
-    dex_query_authority, dex_query_safe_mode, dex_swap, dex_user_cmd, OpsResolutionArgs,
-    ProtocolCmdArgs, SwapArgs, UserCmdArgs, BOOT_PATH, COLD_PATH, MAX_PRICE, MIN_PRICE, WARM_PATH,
+    croc_query_protocol_accum, croc_query_range_position, croc_query_surplus,
+    dex_authority_transfer, dex_direct_protocol_cmd, dex_mint_ambient_in_amount,
+    dex_mint_ranged_in_amount, dex_mint_ranged_pos, dex_query_authority, dex_query_safe_mode,
+    dex_slot_authority, dex_slot_safe_mode, dex_slot_treasury, dex_slot_treasury_start_time,
+    dex_swap, dex_user_cmd, OpsResolutionArgs, ProtocolCmdArgs, SwapArgs, UserCmdArgs, BOOT_PATH,
+    COLD_PATH, MAX_PRICE, MIN_PRICE, WARM_PATH,
 };
 use crate::type_urls::{
     COLLECT_TREASURY_PROPOSAL_TYPE_URL, HOT_PATH_OPEN_PROPOSAL_TYPE_URL, OPS_PROPOSAL_TYPE_URL,
@@ -31,6 +33,7 @@ use althea_proto::cosmos_sdk_proto::cosmos::params::v1beta1::{
     ParamChange, ParameterChangeProposal,
 };
 use clarity::{Address as EthAddress, PrivateKey, Uint256};
+use deep_space::client::types::LatestBlock;
 use deep_space::{Coin, Contact};
 use num::{Bounded, Zero};
 use num256::Int256;
@@ -645,6 +648,12 @@ pub async fn dex_safe_mode_test(
 
     info!("Testing safe mode");
     submit_and_pass_safe_mode_proposal(contact, &validator_keys, true, false).await;
+    assert!(
+        dex_slot_safe_mode(web3, dex_contracts.dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the safe mode slot"),
+        "the DEX safe mode slot should be set"
+    );
 
     safe_mode_operations(
         true,
@@ -665,6 +674,12 @@ pub async fn dex_safe_mode_test(
             .expect("Unable to query safe mode"),
         "dex should not be in safe mode"
     );
+    assert!(
+        !dex_slot_safe_mode(web3, dex_contracts.dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the safe mode slot"),
+        "the DEX safe mode slot should be cleared"
+    );
 
     safe_mode_operations(
         false,
@@ -761,6 +776,197 @@ pub async fn dex_ops_proposal_test(
     info!("Successfully tested nativedex OpsProposal");
 }
 
+/// Tests that the nativedex EndBlocker collects the DEX protocol fees on its own once AutoCollectInterval is set,
+/// which relies on the module reading the DEX treasury and safe mode from the CrocSwapDex storage slots
+pub async fn dex_auto_collect_test(
+    contact: &Contact,
+    web3: &Web3,
+    validator_keys: Vec<ValidatorKeys>,
+    evm_user_keys: Vec<EthermintUserKey>,
+    erc20_contracts: Vec<EthAddress>,
+    dex_contracts: DexAddresses,
+    walthea: EthAddress,
+) {
+    info!("Start dex auto collect test");
+    let evm_user = evm_user_keys.first().unwrap();
+    let (pool_base, pool_quote) = pool_tokens(erc20_contracts.clone());
+
+    basic_dex_setup(
+        contact,
+        web3,
+        dex_contracts.dex,
+        dex_contracts.query,
+        dex_contracts.policy,
+        evm_user,
+        &validator_keys,
+        pool_base,
+        pool_quote,
+        walthea,
+    )
+    .await;
+
+    // The treasury must be a contract, use WALTHEA since the test only inspects the surplus collateral it is paid
+    let treasury = walthea;
+    if dex_slot_treasury(web3, dex_contracts.dex, Some(evm_user.eth_address))
+        .await
+        .expect("Unable to read the treasury slot")
+        != treasury
+    {
+        submit_and_pass_set_treasury_proposal(contact, &validator_keys, treasury, false).await;
+    }
+    assert_eq!(
+        dex_slot_treasury(web3, dex_contracts.dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the treasury slot"),
+        treasury,
+        "the DEX treasury slot should hold the new treasury"
+    );
+
+    // Take a protocol fee from the pool's swaps: SetTakeRate (114) for new pools, then ResyncTakeRate (115) for the pool
+    let take_rate: Uint256 = 64u8.into();
+    submit_and_pass_nativedex_ops_proposal(
+        contact,
+        &validator_keys,
+        COLD_PATH,
+        clarity::abi::encode_tokens(&[Uint256::from(114u8).into(), take_rate.into()]),
+    )
+    .await;
+    submit_and_pass_nativedex_ops_proposal(
+        contact,
+        &validator_keys,
+        COLD_PATH,
+        clarity::abi::encode_tokens(&[
+            Uint256::from(115u8).into(),
+            pool_base.into(),
+            pool_quote.into(),
+            (*POOL_IDX).into(),
+        ]),
+    )
+    .await;
+    let pool = croc_query_pool_params(
+        web3,
+        dex_contracts.query,
+        Some(evm_user.eth_address),
+        pool_base,
+        pool_quote,
+        *POOL_IDX,
+    )
+    .await
+    .expect("Unable to query pool params");
+    assert_eq!(
+        Uint256::from(pool.protocol_take),
+        take_rate,
+        "the pool should take a protocol fee"
+    );
+
+    swap_many(
+        web3,
+        &dex_contracts,
+        pool_base,
+        pool_quote,
+        evm_user,
+        10,
+        None,
+    )
+    .await;
+    let tokens = [pool_base, pool_quote];
+    let mut accumulated = Vec::new();
+    let mut surpluses = Vec::new();
+    for token in tokens {
+        accumulated.push(
+            croc_query_protocol_accum(web3, dex_contracts.query, Some(evm_user.eth_address), token)
+                .await
+                .expect("Unable to query protocol fees"),
+        );
+        surpluses.push(
+            croc_query_surplus(
+                web3,
+                dex_contracts.query,
+                Some(evm_user.eth_address),
+                treasury,
+                token,
+            )
+            .await
+            .expect("Unable to query treasury surplus"),
+        );
+    }
+    info!("Accumulated protocol fees {accumulated:?}");
+    assert!(
+        accumulated.iter().any(|a| !a.is_zero()),
+        "the swaps should accumulate protocol fees"
+    );
+
+    let interval = 5u64;
+    submit_and_pass_auto_collect_proposal(contact, &validator_keys, &tokens, interval).await;
+
+    // CrocSwapDex refuses collection until a week after the treasury is set, the EndBlocker must skip it meanwhile
+    let start_time =
+        dex_slot_treasury_start_time(web3, dex_contracts.dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the treasury start time");
+    let block_time = match contact.get_latest_block().await {
+        Ok(LatestBlock::Latest { block }) => block.header.unwrap().time.unwrap().seconds as u64,
+        other => panic!("Unable to get the latest block: {other:?}"),
+    };
+    if block_time < start_time {
+        info!("Treasury collection starts at {start_time}, expecting the EndBlocker to skip collection until then");
+        for _ in 0..interval * 2 {
+            contact
+                .wait_for_next_block(OPERATION_TIMEOUT)
+                .await
+                .expect("The chain should keep producing blocks");
+        }
+        for (i, token) in tokens.into_iter().enumerate() {
+            let accum = croc_query_protocol_accum(
+                web3,
+                dex_contracts.query,
+                Some(evm_user.eth_address),
+                token,
+            )
+            .await
+            .expect("Unable to query protocol fees");
+            assert_eq!(
+                accum, accumulated[i],
+                "fees should not be collected before the treasury start time"
+            );
+        }
+        info!("Successfully tested DEX auto collect before the treasury start time");
+        return;
+    }
+
+    for _ in 0..interval * 2 {
+        contact
+            .wait_for_next_block(OPERATION_TIMEOUT)
+            .await
+            .expect("The chain should keep producing blocks");
+    }
+    for (i, token) in tokens.into_iter().enumerate() {
+        let accum =
+            croc_query_protocol_accum(web3, dex_contracts.query, Some(evm_user.eth_address), token)
+                .await
+                .expect("Unable to query protocol fees");
+        let surplus = croc_query_surplus(
+            web3,
+            dex_contracts.query,
+            Some(evm_user.eth_address),
+            treasury,
+            token,
+        )
+        .await
+        .expect("Unable to query treasury surplus");
+        assert!(
+            accum.is_zero(),
+            "the EndBlocker should collect the {token} fees"
+        );
+        assert!(
+            surplus >= surpluses[i] + accumulated[i],
+            "the treasury should be paid the collected {token} fees"
+        );
+    }
+
+    info!("Successfully tested DEX auto collect");
+}
+
 pub fn pool_tokens(erc20_contracts: Vec<EthAddress>) -> (EthAddress, EthAddress) {
     let tokens = erc20_contracts[0..2].to_vec();
     if tokens[0] < tokens[1] {
@@ -985,9 +1191,24 @@ pub async fn basic_dex_setup(
         submit_and_pass_nativedex_config_proposal(contact, validator_keys, dex, policy).await;
     }
 
+    // The nativedex module reads the DEX authority and safe mode from the DEX storage slots
+    assert_eq!(
+        dex_slot_authority(web3, dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the authority slot"),
+        policy,
+        "the DEX authority slot should hold the CrocPolicy contract"
+    );
     let safe_mode = dex_query_safe_mode(web3, dex, Some(evm_user.eth_address))
         .await
         .expect("Unable to query safe mode");
+    assert_eq!(
+        dex_slot_safe_mode(web3, dex, Some(evm_user.eth_address))
+            .await
+            .expect("Unable to read the safe mode slot"),
+        safe_mode,
+        "the DEX safe mode slot should match safeMode()"
+    );
     if safe_mode {
         info!("Dex is in safe mode, disabling safe mode");
         submit_and_pass_safe_mode_proposal(contact, validator_keys, false, true).await;
@@ -1197,6 +1418,47 @@ pub async fn submit_and_pass_nativedex_config_proposal(
     info!("Gov proposal executed with {res:?}");
 }
 
+pub async fn submit_and_pass_auto_collect_proposal(
+    contact: &Contact,
+    keys: &[ValidatorKeys],
+    tokens: &[EthAddress],
+    interval: u64,
+) {
+    let deposit = Coin {
+        amount: one_atom() * 100u8.into(),
+        denom: STAKING_TOKEN.clone(),
+    };
+    let tokens: Vec<String> = tokens.iter().map(|t| t.to_string()).collect();
+    let res = contact
+        .submit_parameter_change_proposal(
+            ParameterChangeProposal {
+                title: "Collect DEX protocol fees automatically".to_string(),
+                description: "Collect DEX protocol fees automatically".to_string(),
+                changes: vec![
+                    // keys defined at x/nativedex/types/genesis.go
+                    ParamChange {
+                        subspace: "nativedex".to_string(),
+                        key: "AutoCollectTokens".to_string(),
+                        value: serde_json::to_string(&tokens).unwrap(),
+                    },
+                    ParamChange {
+                        subspace: "nativedex".to_string(),
+                        key: "AutoCollectInterval".to_string(),
+                        value: format!("\"{interval}\""),
+                    },
+                ],
+            },
+            deposit,
+            get_fee(None),
+            keys[0].validator_key,
+            Some(OPERATION_TIMEOUT),
+        )
+        .await;
+    vote_yes_on_proposals(contact, keys, None).await;
+    wait_for_proposals_to_execute(contact).await;
+    info!("Gov proposal executed with {res:?}");
+}
+
 pub async fn submit_and_pass_upgrade_proxy_proposal(
     contact: &Contact,
     keys: &[ValidatorKeys],
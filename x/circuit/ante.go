@@ -0,0 +1,95 @@
+package circuit
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// wrappedAnteHandler An AnteDecorator used to wrap any AnteHandler for decorator chaining
+type wrappedAnteHandler struct {
+	anteHandler sdk.AnteHandler
+}
+
+// AnteHandle calls wad.anteHandler and then the next one in the chain
+func (wad wrappedAnteHandler) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx, simulate bool,
+	next sdk.AnteHandler,
+) (sdk.Context, error) {
+	modCtx, err := wad.anteHandler(ctx, tx, simulate)
+	if err != nil {
+		return modCtx, err
+	}
+	return next(modCtx, tx, simulate)
+}
+
+// NewWrappedCircuitAnteHandler wraps a CircuitAnteDecorator around the input AnteHandler, which runs first
+func NewWrappedCircuitAnteHandler(
+	anteHandler sdk.AnteHandler,
+	circuitKeeper keeper.Keeper,
+	cdc codec.Codec,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(wrappedAnteHandler{anteHandler}, NewCircuitAnteDecorator(circuitKeeper, cdc))
+}
+
+// NewCircuitAnteDecorator initializes a CircuitAnteDecorator for rejecting disabled Msg types and contracts
+func NewCircuitAnteDecorator(circuitKeeper keeper.Keeper, cdc codec.Codec) CircuitAnteDecorator {
+	return CircuitAnteDecorator{circuitKeeper, cdc}
+}
+
+// CircuitAnteDecorator rejects any tx containing a Msg type disabled by a tripped circuit, including the Msgs nested in
+// an authz MsgExec, or an EVM tx sent to a disabled contract. The same Msg types are rejected at execution time by the
+// circuit keeper acting as the MsgServiceRouter's circuit breaker, and calls to disabled contracts by the EVM hook
+type CircuitAnteDecorator struct {
+	circuitKeeper keeper.Keeper
+	cdc           codec.Codec
+}
+
+// AnteHandle rejects the tx if any of its Msgs is disabled
+func (cad CircuitAnteDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := cad.checkMsg(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cad CircuitAnteDecorator) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	msgType := sdk.MsgTypeURL(msg)
+	if cad.circuitKeeper.IsMsgTypeTripped(ctx, msgType) {
+		return errorsmod.Wrapf(types.ErrCircuitTripped, "%s is disabled", msgType)
+	}
+
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		for _, m := range msg.Msgs {
+			var inner sdk.Msg
+			if err := cad.cdc.UnpackAny(m, &inner); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unpack authz msgexec message: %v", err)
+			}
+			if err := cad.checkMsg(ctx, inner); err != nil {
+				return err
+			}
+		}
+	case *evmtypes.MsgEthereumTx:
+		if to := msg.AsTransaction().To(); to != nil && cad.circuitKeeper.IsContractTripped(ctx, *to) {
+			return errorsmod.Wrapf(types.ErrCircuitTripped, "contract %s is disabled", to.Hex())
+		}
+	}
+	return nil
+}
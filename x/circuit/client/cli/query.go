@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// GetQueryCmd bundles all the query subcmds together so they appear under the `query` or `q` subcommand
+func GetQueryCmd() *cobra.Command {
+	// nolint: exhaustruct
+	circuitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	circuitQueryCmd.AddCommand([]*cobra.Command{
+		CmdQueryParams(),
+		CmdQueryTrippedCircuits(),
+	}...)
+
+	return circuitQueryCmd
+}
+
+// CmdQueryParams fetches the current circuit params
+func CmdQueryParams() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query circuit params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryTrippedCircuits fetches every currently tripped circuit
+func CmdQueryTrippedCircuits() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "tripped-circuits",
+		Args:  cobra.NoArgs,
+		Short: "Query the Msg types and EVM contracts which are currently disabled",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TrippedCircuits(cmd.Context(), &types.QueryTrippedCircuitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+const FlagDuration = "duration"
+
+// GetTxCmd bundles all the subcmds together so they appear under `tx circuit`
+func GetTxCmd() *cobra.Command {
+	// nolint: exhaustruct
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "circuit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand([]*cobra.Command{
+		CmdTripCircuit(),
+		CmdResetCircuit(),
+	}...)
+
+	return circuitTxCmd
+}
+
+// CmdTripCircuit crafts and submits a MsgTripCircuit to the chain
+func CmdTripCircuit() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "trip [targets...] --duration [duration]",
+		Short: "Disable Msg type URLs or EVM contract addresses chain-wide, signed by the emergency authority",
+		Long: `Disable Msg type URLs (e.g. /althea.erc20.v1.MsgConvertCoin) or hex EVM contract addresses (e.g. 0xAbC...)
+chain-wide, until the duration has passed or they are reset. The --from key must be the emergency authority, typically
+a multisig for which the tx should be created with --generate-only and then signed with the multisig's keys.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+			if duration < 0 || duration%time.Second != 0 {
+				return fmt.Errorf("duration must be a non-negative whole number of seconds: %v", duration)
+			}
+
+			msgTypeURLs, contracts := splitTargets(args)
+			msg := types.NewMsgTripCircuit(cliCtx.GetFromAddress().String(), msgTypeURLs, contracts, uint64(duration/time.Second))
+			if err := msg.ValidateBasic(); err != nil {
+				return errorsmod.Wrap(err, "invalid argument provided")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Duration(FlagDuration, 0, "How long the circuits stay tripped, e.g. 24h")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdResetCircuit crafts and submits a MsgResetCircuit to the chain
+func CmdResetCircuit() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "reset [targets...]",
+		Short: "Re-enable Msg type URLs or EVM contract addresses disabled by the emergency authority",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgTypeURLs, contracts := splitTargets(args)
+			msg := types.NewMsgResetCircuit(cliCtx.GetFromAddress().String(), msgTypeURLs, contracts)
+			if err := msg.ValidateBasic(); err != nil {
+				return errorsmod.Wrap(err, "invalid argument provided")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// splitTargets separates the hex contract addresses from the Msg type URLs in targets
+func splitTargets(targets []string) (msgTypeURLs []string, contracts []string) {
+	msgTypeURLs = []string{}
+	contracts = []string{}
+	for _, target := range targets {
+		if strings.HasPrefix(target, "0x") {
+			contracts = append(contracts, target)
+		} else {
+			msgTypeURLs = append(msgTypeURLs, target)
+		}
+	}
+	return msgTypeURLs, contracts
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// nolint: exhaustruct
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for circuit keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing, reverting any EVM tx which called a contract disabled by a
+// tripped circuit. Txs sent directly to a disabled contract are rejected by the AnteHandler, the hook additionally
+// catches internal calls to a disabled contract, so long as the contract emitted a log during the call.
+func (h Hooks) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	if to := msg.To(); to != nil && h.k.IsContractTripped(ctx, *to) {
+		return errorsmod.Wrapf(types.ErrCircuitTripped, "contract %s is disabled", to.Hex())
+	}
+	for _, log := range receipt.Logs {
+		if h.k.IsContractTripped(ctx, log.Address) {
+			return errorsmod.Wrapf(types.ErrCircuitTripped, "contract %s is disabled", log.Address.Hex())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// InitGenesis starts a chain from a genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, circuit := range data.TrippedCircuits {
+		k.SetTrippedCircuit(ctx, circuit)
+	}
+}
+
+// ExportGenesis exports all the state needed to restart the chain
+// from the current state of the chain
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	return types.GenesisState{
+		Params:          k.GetParams(ctx),
+		TrippedCircuits: k.GetTrippedCircuits(ctx),
+	}
+}
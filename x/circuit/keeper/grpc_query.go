@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// nolint: exhaustruct
+// Enforce via type assertion that the Keeper functions as a query server
+var _ types.QueryServer = Keeper{}
+
+// Params queries the params of the circuit module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// TrippedCircuits returns every circuit which is currently tripped
+func (k Keeper) TrippedCircuits(c context.Context, req *types.QueryTrippedCircuitsRequest) (*types.QueryTrippedCircuitsResponse, error) {
+	return &types.QueryTrippedCircuitsResponse{TrippedCircuits: k.GetTrippedCircuits(sdk.UnwrapSDKContext(c))}, nil
+}
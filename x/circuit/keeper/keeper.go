@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// nolint: exhaustruct
+// Enforce via type assertion that the Keeper functions as the app's circuit breaker
+var _ baseapp.CircuitBreaker = Keeper{}
+
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	paramSpace paramstypes.Subspace
+	cdc        codec.BinaryCodec
+
+	// authority is the governance module account address, which may trip and reset any circuit
+	authority string
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace, authority string) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		storeKey:   storeKey,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the governance module account address
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns all of the circuit params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets all of the circuit params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetEmergencyAuthority returns the address appointed by governance to trip circuits, empty if there is none
+func (k Keeper) GetEmergencyAuthority(ctx sdk.Context) string {
+	emergencyAuthority := types.DefaultParams().EmergencyAuthority
+	k.paramSpace.GetIfExists(ctx, types.EmergencyAuthorityKey, &emergencyAuthority)
+	return emergencyAuthority
+}
+
+// GetMaxTripDuration returns the longest the emergency authority may trip a circuit for, in seconds
+func (k Keeper) GetMaxTripDuration(ctx sdk.Context) uint64 {
+	maxTripDuration := types.DefaultParams().MaxTripDuration
+	k.paramSpace.GetIfExists(ctx, types.MaxTripDurationKey, &maxTripDuration)
+	return maxTripDuration
+}
+
+// GetTrippedCircuit returns the circuit of circuitType for target, if any, including expired circuits which have not
+// yet been pruned
+func (k Keeper) GetTrippedCircuit(ctx sdk.Context, circuitType types.CircuitType, target string) (types.TrippedCircuit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTrippedCircuitKey(circuitType, target))
+	if bz == nil {
+		return types.TrippedCircuit{}, false // nolint: exhaustruct
+	}
+	var circuit types.TrippedCircuit
+	k.cdc.MustUnmarshal(bz, &circuit)
+	return circuit, true
+}
+
+// SetTrippedCircuit stores circuit, replacing any circuit with the same type and target
+func (k Keeper) SetTrippedCircuit(ctx sdk.Context, circuit types.TrippedCircuit) {
+	ctx.KVStore(k.storeKey).Set(types.GetTrippedCircuitKey(circuit.CircuitType, circuit.Target), k.cdc.MustMarshal(&circuit))
+}
+
+// DeleteTrippedCircuit resets the circuit of circuitType for target
+func (k Keeper) DeleteTrippedCircuit(ctx sdk.Context, circuitType types.CircuitType, target string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetTrippedCircuitKey(circuitType, target))
+}
+
+// IterateTrippedCircuits calls cb on every stored circuit until cb returns true
+func (k Keeper) IterateTrippedCircuits(ctx sdk.Context, cb func(circuit types.TrippedCircuit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.MsgTypeCircuitKeyPrefix, types.ContractCircuitKeyPrefix} {
+		iter := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			var circuit types.TrippedCircuit
+			k.cdc.MustUnmarshal(iter.Value(), &circuit)
+			if cb(circuit) {
+				iter.Close()
+				return
+			}
+		}
+		iter.Close()
+	}
+}
+
+// GetTrippedCircuits returns every circuit which is tripped at the current block time
+func (k Keeper) GetTrippedCircuits(ctx sdk.Context) []types.TrippedCircuit {
+	circuits := []types.TrippedCircuit{}
+	k.IterateTrippedCircuits(ctx, func(circuit types.TrippedCircuit) (stop bool) {
+		if !isExpired(ctx, circuit) {
+			circuits = append(circuits, circuit)
+		}
+		return false
+	})
+	return circuits
+}
+
+// IsMsgTypeTripped checks if msgTypeURL is currently disabled by a circuit
+func (k Keeper) IsMsgTypeTripped(ctx sdk.Context, msgTypeURL string) bool {
+	return k.isTripped(ctx, types.CIRCUIT_TYPE_MSG_TYPE, msgTypeURL)
+}
+
+// IsContractTripped checks if contract is currently disabled by a circuit
+func (k Keeper) IsContractTripped(ctx sdk.Context, contract common.Address) bool {
+	return k.isTripped(ctx, types.CIRCUIT_TYPE_CONTRACT, contract.Hex())
+}
+
+func (k Keeper) isTripped(ctx sdk.Context, circuitType types.CircuitType, target string) bool {
+	circuit, found := k.GetTrippedCircuit(ctx, circuitType, target)
+	return found && !isExpired(ctx, circuit)
+}
+
+// IsAllowed implements baseapp.CircuitBreaker, it is called by the MsgServiceRouter before executing every Msg
+func (k Keeper) IsAllowed(c context.Context, typeURL string) (bool, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return !k.IsMsgTypeTripped(ctx, typeURL), nil
+}
+
+// PruneExpiredCircuits removes every circuit which has expired by the current block time
+func (k Keeper) PruneExpiredCircuits(ctx sdk.Context) {
+	expired := []types.TrippedCircuit{}
+	k.IterateTrippedCircuits(ctx, func(circuit types.TrippedCircuit) (stop bool) {
+		if isExpired(ctx, circuit) {
+			expired = append(expired, circuit)
+		}
+		return false
+	})
+
+	for _, circuit := range expired {
+		k.DeleteTrippedCircuit(ctx, circuit.CircuitType, circuit.Target)
+		k.Logger(ctx).Info("Circuit expired", "type", circuit.CircuitType.String(), "target", circuit.Target)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCircuitExpired,
+			sdk.NewAttribute(types.CircuitKeyType, circuit.CircuitType.String()),
+			sdk.NewAttribute(types.CircuitKeyTarget, circuit.Target),
+		))
+	}
+}
+
+// isExpired checks if circuit has an expiry which is at or before the current block time
+func isExpired(ctx sdk.Context, circuit types.TrippedCircuit) bool {
+	return !circuit.ExpiresAt.IsZero() && !ctx.BlockTime().Before(circuit.ExpiresAt)
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	"github.com/AltheaFoundation/althea-L1/x/circuit/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+func createTestKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	circuitKey := sdk.NewKVStoreKey(types.StoreKey)
+	tCircuitKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(circuitKey, tCircuitKey).WithBlockTime(time.Unix(1000000, 0))
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, circuitKey, tCircuitKey, types.ModuleName)
+
+	k := keeper.NewKeeper(encCfg.Codec, circuitKey, paramstore, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	keeper.InitGenesis(ctx, k, *types.DefaultGenesisState())
+	return ctx, k
+}
+
+func TestTripCircuit(t *testing.T) {
+	ctx, k := createTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	gov := k.GetAuthority()
+	emergency := sdk.AccAddress([]byte("emergency_multisig__")).String()
+	other := sdk.AccAddress([]byte("other_address_______")).String()
+
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})           // nolint: exhaustruct
+	msgMultiSend := sdk.MsgTypeURL(&banktypes.MsgMultiSend{}) // nolint: exhaustruct
+	contract := common.HexToAddress("0x9a676e781a523b5d0c0e43731313a708cb607508")
+
+	// Without an emergency authority only governance may trip circuits
+	_, err := msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(emergency, []string{msgSend}, nil, 60))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	params := k.GetParams(ctx)
+	params.EmergencyAuthority = emergency
+	k.SetParams(ctx, params)
+
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(other, []string{msgSend}, nil, 60))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// The emergency authority must give a duration within the max trip duration
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(emergency, []string{msgSend}, nil, 0))
+	require.ErrorIs(t, err, types.ErrInvalidDuration)
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(emergency, []string{msgSend}, nil, params.MaxTripDuration+1))
+	require.ErrorIs(t, err, types.ErrInvalidDuration)
+
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(emergency, []string{msgSend}, []string{contract.Hex()}, 60))
+	require.NoError(t, err)
+	require.True(t, k.IsMsgTypeTripped(ctx, msgSend))
+	require.True(t, k.IsContractTripped(ctx, contract))
+	allowed, err := k.IsAllowed(sdk.WrapSDKContext(ctx), msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsAllowed(sdk.WrapSDKContext(ctx), msgMultiSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.Len(t, k.GetTrippedCircuits(ctx), 2)
+
+	// Governance trips are permanent and may not be changed by the emergency authority
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(gov, []string{msgMultiSend}, nil, 0))
+	require.NoError(t, err)
+	_, err = msgServer.ResetCircuit(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuit(emergency, []string{msgMultiSend}, nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.TripCircuit(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuit(emergency, []string{msgMultiSend}, nil, 60))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// The emergency trips expire, governance's do not
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.False(t, k.IsMsgTypeTripped(ctx, msgSend))
+	require.False(t, k.IsContractTripped(ctx, contract))
+	require.True(t, k.IsMsgTypeTripped(ctx, msgMultiSend))
+	k.PruneExpiredCircuits(ctx)
+	_, found := k.GetTrippedCircuit(ctx, types.CIRCUIT_TYPE_MSG_TYPE, msgSend)
+	require.False(t, found)
+	require.Len(t, k.GetTrippedCircuits(ctx), 1)
+
+	// Governance may reset any circuit
+	_, err = msgServer.ResetCircuit(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuit(gov, []string{msgMultiSend}, nil))
+	require.NoError(t, err)
+	require.False(t, k.IsMsgTypeTripped(ctx, msgMultiSend))
+	_, err = msgServer.ResetCircuit(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuit(gov, []string{msgMultiSend}, nil))
+	require.ErrorIs(t, err, types.ErrCircuitNotTripped)
+}
+
+func TestMsgValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("emergency_multisig__")).String()
+	contract := "0x9a676e781a523b5d0c0e43731313a708cb607508"
+
+	require.NoError(t, types.NewMsgTripCircuit(authority, []string{"/althea.erc20.v1.MsgConvertCoin"}, []string{contract}, 60).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuit(authority, nil, nil, 60).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuit(authority, []string{"althea.erc20.v1.MsgConvertCoin"}, nil, 60).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuit(authority, []string{"/cosmos.gov.v1beta1.MsgVote"}, nil, 60).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuit(authority, []string{"/althea.circuit.v1.MsgResetCircuit"}, nil, 60).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuit(authority, nil, []string{"0x0000000000000000000000000000000000000000"}, 60).ValidateBasic())
+	require.Error(t, types.NewMsgResetCircuit(authority, nil, []string{contract, "0x9A676e781A523b5d0C0e43731313A708CB607508"}).ValidateBasic())
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// TripCircuit disables the msg's Msg types and contracts, until the msg's duration has passed or they are reset
+func (m msgServer) TripCircuit(c context.Context, msg *types.MsgTripCircuit) (*types.MsgTripCircuitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	isGov, err := m.checkAuthority(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	// The emergency authority may only trip circuits temporarily, governance may trip them until they are reset
+	if !isGov && (msg.Duration == 0 || msg.Duration > m.GetMaxTripDuration(ctx)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+			"the emergency authority must trip circuits for between 1 and %d seconds", m.GetMaxTripDuration(ctx))
+	}
+	expiresAt := time.Time{}
+	if msg.Duration != 0 {
+		expiresAt = ctx.BlockTime().Add(time.Duration(msg.Duration) * time.Second)
+	}
+
+	for _, circuit := range targetCircuits(msg.MsgTypeUrls, msg.ContractAddresses) {
+		if err := m.checkOverride(ctx, isGov, circuit.CircuitType, circuit.Target); err != nil {
+			return nil, err
+		}
+		circuit.TrippedBy = msg.Authority
+		circuit.ExpiresAt = expiresAt
+		m.SetTrippedCircuit(ctx, circuit)
+
+		m.Logger(ctx).Info("Circuit tripped", "type", circuit.CircuitType.String(), "target", circuit.Target, "authority", msg.Authority)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCircuitTripped,
+			sdk.NewAttribute(types.CircuitKeyType, circuit.CircuitType.String()),
+			sdk.NewAttribute(types.CircuitKeyTarget, circuit.Target),
+			sdk.NewAttribute(types.CircuitKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.CircuitKeyExpiresAt, expiresAt.String()),
+		))
+	}
+
+	return &types.MsgTripCircuitResponse{}, nil
+}
+
+// ResetCircuit re-enables the msg's Msg types and contracts
+func (m msgServer) ResetCircuit(c context.Context, msg *types.MsgResetCircuit) (*types.MsgResetCircuitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	isGov, err := m.checkAuthority(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, circuit := range targetCircuits(msg.MsgTypeUrls, msg.ContractAddresses) {
+		if !m.isTripped(ctx, circuit.CircuitType, circuit.Target) {
+			return nil, errorsmod.Wrapf(types.ErrCircuitNotTripped, "cannot reset %s", circuit.Target)
+		}
+		if err := m.checkOverride(ctx, isGov, circuit.CircuitType, circuit.Target); err != nil {
+			return nil, err
+		}
+		m.DeleteTrippedCircuit(ctx, circuit.CircuitType, circuit.Target)
+
+		m.Logger(ctx).Info("Circuit reset", "type", circuit.CircuitType.String(), "target", circuit.Target, "authority", msg.Authority)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCircuitReset,
+			sdk.NewAttribute(types.CircuitKeyType, circuit.CircuitType.String()),
+			sdk.NewAttribute(types.CircuitKeyTarget, circuit.Target),
+			sdk.NewAttribute(types.CircuitKeyAuthority, msg.Authority),
+		))
+	}
+
+	return &types.MsgResetCircuitResponse{}, nil
+}
+
+// checkAuthority ensures the signer of a circuit Msg is either governance or the emergency authority,
+// returning true if the signer is governance
+func (m msgServer) checkAuthority(ctx sdk.Context, authority string) (bool, error) {
+	if authority == m.authority {
+		return true, nil
+	}
+	if emergencyAuthority := m.GetEmergencyAuthority(ctx); emergencyAuthority != "" && authority == emergencyAuthority {
+		return false, nil
+	}
+	return false, errorsmod.Wrapf(types.ErrUnauthorized, "%s is neither governance nor the emergency authority", authority)
+}
+
+// checkOverride ensures the emergency authority does not change a circuit tripped by governance
+func (m msgServer) checkOverride(ctx sdk.Context, isGov bool, circuitType types.CircuitType, target string) error {
+	if isGov {
+		return nil
+	}
+	existing, found := m.GetTrippedCircuit(ctx, circuitType, target)
+	if found && !isExpired(ctx, existing) && existing.TrippedBy == m.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s was tripped by governance", target)
+	}
+	return nil
+}
+
+// targetCircuits returns a TrippedCircuit for each target, with contract addresses in their checksummed form
+// nolint: exhaustruct
+func targetCircuits(msgTypeURLs []string, contractAddresses []string) []types.TrippedCircuit {
+	circuits := make([]types.TrippedCircuit, 0, len(msgTypeURLs)+len(contractAddresses))
+	for _, msgTypeURL := range msgTypeURLs {
+		circuits = append(circuits, types.TrippedCircuit{CircuitType: types.CIRCUIT_TYPE_MSG_TYPE, Target: msgTypeURL})
+	}
+	for _, contract := range contractAddresses {
+		circuits = append(circuits, types.TrippedCircuit{
+			CircuitType: types.CIRCUIT_TYPE_CONTRACT,
+			Target:      common.HexToAddress(contract).Hex(),
+		})
+	}
+	return circuits
+}
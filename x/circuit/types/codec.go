@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// ModuleCdc is the codec for the module
+var ModuleCdc = codec.NewLegacyAmino()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
+
+// RegisterInterfaces registers the interfaces for the proto stuff
+// nolint: exhaustruct
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTripCircuit{},
+		&MsgResetCircuit{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterCodec registers concrete types on the Amino codec
+// nolint: exhaustruct
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgTripCircuit{}, "circuit/MsgTripCircuit", nil)
+	cdc.RegisterConcrete(&MsgResetCircuit{}, "circuit/MsgResetCircuit", nil)
+}
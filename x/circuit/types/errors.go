@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+const RootCodespace = "circuit"
+
+var (
+	// ErrCircuitTripped the Msg type or EVM contract has been disabled by a tripped circuit
+	ErrCircuitTripped = sdkerrors.Register(RootCodespace, 1, "circuit tripped")
+	// ErrUnauthorized the signer may not trip or reset the circuit
+	ErrUnauthorized = sdkerrors.Register(RootCodespace, 2, "unauthorized")
+	// ErrInvalidDuration the trip duration is not allowed for the signer
+	ErrInvalidDuration = sdkerrors.Register(RootCodespace, 3, "invalid duration")
+	// ErrCircuitNotTripped the circuit to reset is not tripped
+	ErrCircuitNotTripped = sdkerrors.Register(RootCodespace, 4, "circuit not tripped")
+)
@@ -0,0 +1,12 @@
+package types
+
+const (
+	EventTypeCircuitTripped = "circuit-tripped"
+	EventTypeCircuitReset   = "circuit-reset"
+	EventTypeCircuitExpired = "circuit-expired"
+
+	CircuitKeyType      = "circuit_type"
+	CircuitKeyTarget    = "target"
+	CircuitKeyAuthority = "authority"
+	CircuitKeyExpiresAt = "expires_at"
+)
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultGenesisState creates a simple GenesisState suitible for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:          DefaultParams(),
+		TrippedCircuits: []TrippedCircuit{},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		EmergencyAuthority: "",
+		MaxTripDuration:    DefaultMaxTripDuration,
+	}
+}
+
+func (s GenesisState) ValidateBasic() error {
+	if err := ValidateEmergencyAuthority(s.Params.EmergencyAuthority); err != nil {
+		return errorsmod.Wrap(err, "Invalid EmergencyAuthority GenesisState")
+	}
+	if err := ValidateMaxTripDuration(s.Params.MaxTripDuration); err != nil {
+		return errorsmod.Wrap(err, "Invalid MaxTripDuration GenesisState")
+	}
+	seen := make(map[string]struct{}, len(s.TrippedCircuits))
+	for i, circuit := range s.TrippedCircuits {
+		if err := circuit.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "Invalid TrippedCircuit %d GenesisState", i)
+		}
+		key := string(GetTrippedCircuitKey(circuit.CircuitType, circuit.Target))
+		if _, present := seen[key]; present {
+			return fmt.Errorf("duplicate tripped circuit %s", circuit.Target)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic checks that the circuit disables a valid target and was tripped by a valid address
+func (c TrippedCircuit) ValidateBasic() error {
+	switch c.CircuitType {
+	case CIRCUIT_TYPE_MSG_TYPE:
+		if err := ValidateMsgTypeURL(c.Target); err != nil {
+			return err
+		}
+	case CIRCUIT_TYPE_CONTRACT:
+		if err := ValidateContractAddress(c.Target); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid circuit type %v", c.CircuitType)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.TrippedBy); err != nil {
+		return fmt.Errorf("invalid tripped by address %s: %v", c.TrippedBy, err)
+	}
+	return nil
+}
+
+// ValidateMsgTypeURL checks that msgTypeURL is a type URL which may be disabled by a circuit
+func ValidateMsgTypeURL(msgTypeURL string) error {
+	if len(msgTypeURL) < 2 || msgTypeURL[0] != '/' {
+		return fmt.Errorf("invalid msg type url %s", msgTypeURL)
+	}
+	if !IsTrippableMsgType(msgTypeURL) {
+		return fmt.Errorf("msg type url %s may not be disabled", msgTypeURL)
+	}
+	return nil
+}
+
+// ValidateContractAddress checks that contract is a nonzero hex EVM address
+func ValidateContractAddress(contract string) error {
+	if !common.IsHexAddress(contract) || common.HexToAddress(contract) == (common.Address{}) {
+		return fmt.Errorf("invalid contract address %s", contract)
+	}
+	return nil
+}
+
+func ValidateEmergencyAuthority(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid emergency authority type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid emergency authority %s: %v", v, err)
+	}
+	return nil
+}
+
+func ValidateMaxTripDuration(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid max trip duration type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max trip duration must be positive")
+	}
+	return nil
+}
+
+// ParamKeyTable for circuit module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{
+		EmergencyAuthority: "",
+		MaxTripDuration:    0,
+	})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of circuit module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(EmergencyAuthorityKey, &p.EmergencyAuthority, ValidateEmergencyAuthority),
+		paramtypes.NewParamSetPair(MaxTripDurationKey, &p.MaxTripDuration, ValidateMaxTripDuration),
+	}
+}
@@ -0,0 +1,957 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: althea/circuit/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitType is the kind of target disabled by a TrippedCircuit
+type CircuitType int32
+
+const (
+	// CIRCUIT_TYPE_UNSPECIFIED is invalid
+	CIRCUIT_TYPE_UNSPECIFIED CircuitType = 0
+	// CIRCUIT_TYPE_MSG_TYPE disables every Msg with the target type URL
+	CIRCUIT_TYPE_MSG_TYPE CircuitType = 1
+	// CIRCUIT_TYPE_CONTRACT disables every EVM tx which calls the target contract address
+	CIRCUIT_TYPE_CONTRACT CircuitType = 2
+)
+
+var CircuitType_name = map[int32]string{
+	0: "CIRCUIT_TYPE_UNSPECIFIED",
+	1: "CIRCUIT_TYPE_MSG_TYPE",
+	2: "CIRCUIT_TYPE_CONTRACT",
+}
+
+var CircuitType_value = map[string]int32{
+	"CIRCUIT_TYPE_UNSPECIFIED": 0,
+	"CIRCUIT_TYPE_MSG_TYPE":    1,
+	"CIRCUIT_TYPE_CONTRACT":    2,
+}
+
+func (x CircuitType) String() string {
+	return proto.EnumName(CircuitType_name, int32(x))
+}
+
+func (CircuitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e501f7bf26906cf6, []int{0}
+}
+
+// Params struct
+type Params struct {
+	// The bech32 address (typically a multisig) appointed by governance to trip circuits in an emergency,
+	// if empty then only governance may trip circuits
+	EmergencyAuthority string `protobuf:"bytes,1,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
+	// The longest a circuit tripped by the emergency authority may stay tripped, in seconds
+	MaxTripDuration uint64 `protobuf:"varint,2,opt,name=max_trip_duration,json=maxTripDuration,proto3" json:"max_trip_duration,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e501f7bf26906cf6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEmergencyAuthority() string {
+	if m != nil {
+		return m.EmergencyAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetMaxTripDuration() uint64 {
+	if m != nil {
+		return m.MaxTripDuration
+	}
+	return 0
+}
+
+// TrippedCircuit disables a Msg type URL or EVM contract address chain-wide until it expires or is reset
+type TrippedCircuit struct {
+	CircuitType CircuitType `protobuf:"varint,1,opt,name=circuit_type,json=circuitType,proto3,enum=althea.circuit.v1.CircuitType" json:"circuit_type,omitempty"`
+	// target is the Msg type URL or the hex EVM contract address disabled by the circuit
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// tripped_by is the address which tripped the circuit, either governance or the emergency authority
+	TrippedBy string `protobuf:"bytes,3,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	// expires_at is the time the circuit is automatically reset, the zero time if it stays tripped until reset
+	ExpiresAt time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *TrippedCircuit) Reset()         { *m = TrippedCircuit{} }
+func (m *TrippedCircuit) String() string { return proto.CompactTextString(m) }
+func (*TrippedCircuit) ProtoMessage()    {}
+func (*TrippedCircuit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e501f7bf26906cf6, []int{1}
+}
+func (m *TrippedCircuit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrippedCircuit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrippedCircuit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrippedCircuit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrippedCircuit.Merge(m, src)
+}
+func (m *TrippedCircuit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrippedCircuit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrippedCircuit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrippedCircuit proto.InternalMessageInfo
+
+func (m *TrippedCircuit) GetCircuitType() CircuitType {
+	if m != nil {
+		return m.CircuitType
+	}
+	return CIRCUIT_TYPE_UNSPECIFIED
+}
+
+func (m *TrippedCircuit) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TrippedCircuit) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func (m *TrippedCircuit) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+type GenesisState struct {
+	Params          Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TrippedCircuits []TrippedCircuit `protobuf:"bytes,2,rep,name=tripped_circuits,json=trippedCircuits,proto3" json:"tripped_circuits"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e501f7bf26906cf6, []int{2}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTrippedCircuits() []TrippedCircuit {
+	if m != nil {
+		return m.TrippedCircuits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("althea.circuit.v1.CircuitType", CircuitType_name, CircuitType_value)
+	proto.RegisterType((*Params)(nil), "althea.circuit.v1.Params")
+	proto.RegisterType((*TrippedCircuit)(nil), "althea.circuit.v1.TrippedCircuit")
+	proto.RegisterType((*GenesisState)(nil), "althea.circuit.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("althea/circuit/v1/genesis.proto", fileDescriptor_e501f7bf26906cf6) }
+
+var fileDescriptor_e501f7bf26906cf6 = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xda, 0x40,
+	0x10, 0xf5, 0x26, 0x08, 0x95, 0x75, 0x94, 0x90, 0xed, 0x87, 0x1c, 0xd4, 0x1a, 0xca, 0x09, 0x45,
+	0xaa, 0x57, 0x50, 0x55, 0x3d, 0x1b, 0x87, 0x44, 0x48, 0x6d, 0x82, 0x8c, 0x73, 0x68, 0x2f, 0xd6,
+	0x62, 0xb6, 0x66, 0xa5, 0x98, 0xb5, 0xd6, 0xeb, 0x08, 0xff, 0x83, 0x1e, 0x73, 0xef, 0xb1, 0x7f,
+	0x26, 0xb7, 0xe6, 0xd8, 0x53, 0x5b, 0xc1, 0x1f, 0xa9, 0x58, 0x2f, 0x69, 0x51, 0x72, 0xdb, 0x99,
+	0xf7, 0x76, 0x66, 0xde, 0x9b, 0x81, 0x4d, 0x72, 0x25, 0x67, 0x94, 0xe0, 0x88, 0x89, 0x28, 0x67,
+	0x12, 0x5f, 0x77, 0x71, 0x4c, 0xe7, 0x34, 0x63, 0x99, 0x93, 0x0a, 0x2e, 0x39, 0x3a, 0x2c, 0x09,
+	0x8e, 0x26, 0x38, 0xd7, 0xdd, 0xc6, 0xb3, 0x98, 0xc7, 0x5c, 0xa1, 0x78, 0xfd, 0x2a, 0x89, 0x8d,
+	0x66, 0xcc, 0x79, 0x7c, 0x45, 0xb1, 0x8a, 0x26, 0xf9, 0x17, 0x2c, 0x59, 0x42, 0x33, 0x49, 0x92,
+	0xb4, 0x24, 0xb4, 0x29, 0xac, 0x8e, 0x88, 0x20, 0x49, 0x86, 0x30, 0x7c, 0x4a, 0x13, 0x2a, 0x62,
+	0x3a, 0x8f, 0x8a, 0x90, 0xe4, 0x72, 0xc6, 0x05, 0x93, 0x85, 0x05, 0x5a, 0xa0, 0x53, 0xf3, 0xd1,
+	0x3d, 0xe4, 0x6e, 0x10, 0x74, 0x0c, 0x0f, 0x13, 0xb2, 0x08, 0xa5, 0x60, 0x69, 0x38, 0xcd, 0x05,
+	0x91, 0x8c, 0xcf, 0xad, 0x9d, 0x16, 0xe8, 0x54, 0xfc, 0x83, 0x84, 0x2c, 0x02, 0xc1, 0xd2, 0x13,
+	0x9d, 0x6e, 0xff, 0x00, 0x70, 0x7f, 0x9d, 0x48, 0xe9, 0xd4, 0x2b, 0x67, 0x46, 0x2e, 0xdc, 0xd3,
+	0xe3, 0x87, 0xb2, 0x48, 0xa9, 0x6a, 0xb4, 0xdf, 0xb3, 0x9d, 0x07, 0xd2, 0x1c, 0xfd, 0x23, 0x28,
+	0x52, 0xea, 0x9b, 0xd1, 0xbf, 0x00, 0xbd, 0x80, 0x55, 0x49, 0x44, 0x4c, 0xa5, 0x6a, 0x5b, 0xf3,
+	0x75, 0x84, 0x5e, 0x41, 0x28, 0xcb, 0x66, 0xe1, 0xa4, 0xb0, 0x76, 0x15, 0x56, 0xd3, 0x99, 0x7e,
+	0x81, 0x3c, 0x08, 0xe9, 0x22, 0x65, 0x82, 0x66, 0x21, 0x91, 0x56, 0xa5, 0x05, 0x3a, 0x66, 0xaf,
+	0xe1, 0x94, 0x4e, 0x39, 0x1b, 0xa7, 0x9c, 0x60, 0xe3, 0x54, 0xff, 0xc9, 0xed, 0xaf, 0xa6, 0x71,
+	0xf3, 0xbb, 0x09, 0xfc, 0x9a, 0xfe, 0xe7, 0xca, 0xf6, 0x37, 0x00, 0xf7, 0xce, 0xca, 0xa5, 0x8c,
+	0x25, 0x91, 0x14, 0xbd, 0x87, 0xd5, 0x54, 0x39, 0xa9, 0x94, 0x98, 0xbd, 0xa3, 0x47, 0x94, 0x94,
+	0x56, 0xf7, 0x2b, 0xeb, 0x82, 0xbe, 0xa6, 0x23, 0x1f, 0xd6, 0x37, 0xd3, 0x6a, 0x6a, 0x66, 0xed,
+	0xb4, 0x76, 0x3b, 0x66, 0xef, 0xf5, 0x23, 0x25, 0xb6, 0x5d, 0xd4, 0xa5, 0x0e, 0xe4, 0x56, 0x36,
+	0x3b, 0x8e, 0xa1, 0xf9, 0x9f, 0x6b, 0xe8, 0x25, 0xb4, 0xbc, 0xa1, 0xef, 0x5d, 0x0e, 0x83, 0x30,
+	0xf8, 0x34, 0x1a, 0x84, 0x97, 0xe7, 0xe3, 0xd1, 0xc0, 0x1b, 0x9e, 0x0e, 0x07, 0x27, 0x75, 0x03,
+	0x1d, 0xc1, 0xe7, 0x5b, 0xe8, 0xc7, 0xf1, 0x99, 0x7a, 0xd4, 0xc1, 0x03, 0xc8, 0xbb, 0x38, 0x0f,
+	0x7c, 0xd7, 0x0b, 0xea, 0x3b, 0x8d, 0xca, 0xd7, 0xef, 0xb6, 0xd1, 0xbf, 0xb8, 0x5d, 0xda, 0xe0,
+	0x6e, 0x69, 0x83, 0x3f, 0x4b, 0x1b, 0xdc, 0xac, 0x6c, 0xe3, 0x6e, 0x65, 0x1b, 0x3f, 0x57, 0xb6,
+	0xf1, 0xf9, 0x5d, 0xcc, 0xe4, 0x2c, 0x9f, 0x38, 0x11, 0x4f, 0xb0, 0xab, 0x64, 0x9c, 0xf2, 0x7c,
+	0x3e, 0x55, 0xf7, 0x80, 0x4b, 0x5d, 0x6f, 0x3e, 0x74, 0xf1, 0xe2, 0xfe, 0xca, 0xd7, 0x57, 0x90,
+	0x4d, 0xaa, 0x6a, 0x01, 0x6f, 0xff, 0x0e, 0x00, 0x2e, 0x36, 0x8d, 0x04, 0x04, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTripDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTripDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrippedCircuit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrippedCircuit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrippedCircuit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CircuitType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CircuitType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrippedCircuits) > 0 {
+		for iNdEx := len(m.TrippedCircuits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrippedCircuits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxTripDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTripDuration))
+	}
+	return n
+}
+
+func (m *TrippedCircuit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CircuitType != 0 {
+		n += 1 + sovGenesis(uint64(m.CircuitType))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TrippedCircuits) > 0 {
+		for _, e := range m.TrippedCircuits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTripDuration", wireType)
+			}
+			m.MaxTripDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTripDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrippedCircuit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrippedCircuit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrippedCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitType", wireType)
+			}
+			m.CircuitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitType |= CircuitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedCircuits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedCircuits = append(m.TrippedCircuits, TrippedCircuit{})
+			if err := m.TrippedCircuits[len(m.TrippedCircuits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
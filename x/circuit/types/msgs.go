@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authlegacy "github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	TypeMsgTripCircuit  = "trip_circuit"
+	TypeMsgResetCircuit = "reset_circuit"
+)
+
+// nolint: exhaustruct
+var (
+	_ sdk.Msg              = &MsgTripCircuit{}
+	_ sdk.Msg              = &MsgResetCircuit{}
+	_ authlegacy.LegacyMsg = &MsgTripCircuit{}
+	_ authlegacy.LegacyMsg = &MsgResetCircuit{}
+)
+
+// NewMsgTripCircuit returns a new MsgTripCircuit
+func NewMsgTripCircuit(authority string, msgTypeURLs []string, contractAddresses []string, duration uint64) *MsgTripCircuit {
+	return &MsgTripCircuit{
+		Authority:         authority,
+		MsgTypeUrls:       msgTypeURLs,
+		ContractAddresses: contractAddresses,
+		Duration:          duration,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgTripCircuit) Route() string { return RouterKey }
+
+func (msg MsgTripCircuit) Type() string { return TypeMsgTripCircuit }
+
+// ValidateBasic checks for a valid authority and at least one valid, unique target
+func (msg *MsgTripCircuit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in circuit msg trip circuit")
+	}
+	return errorsmod.Wrap(validateTargets(msg.MsgTypeUrls, msg.ContractAddresses), "circuit msg trip circuit")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgTripCircuit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTripCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgResetCircuit returns a new MsgResetCircuit
+func NewMsgResetCircuit(authority string, msgTypeURLs []string, contractAddresses []string) *MsgResetCircuit {
+	return &MsgResetCircuit{
+		Authority:         authority,
+		MsgTypeUrls:       msgTypeURLs,
+		ContractAddresses: contractAddresses,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgResetCircuit) Route() string { return RouterKey }
+
+func (msg MsgResetCircuit) Type() string { return TypeMsgResetCircuit }
+
+// ValidateBasic checks for a valid authority and at least one valid, unique target
+func (msg *MsgResetCircuit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in circuit msg reset circuit")
+	}
+	return errorsmod.Wrap(validateTargets(msg.MsgTypeUrls, msg.ContractAddresses), "circuit msg reset circuit")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgResetCircuit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgResetCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// validateTargets checks that at least one target is given and that every target is valid and unique
+func validateTargets(msgTypeURLs []string, contractAddresses []string) error {
+	if len(msgTypeURLs) == 0 && len(contractAddresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no msg type urls or contract addresses provided")
+	}
+	seen := make(map[string]struct{}, len(msgTypeURLs)+len(contractAddresses))
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := ValidateMsgTypeURL(msgTypeURL); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		key := string(GetTrippedCircuitKey(CIRCUIT_TYPE_MSG_TYPE, msgTypeURL))
+		if _, present := seen[key]; present {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("duplicate msg type url %s", msgTypeURL))
+		}
+		seen[key] = struct{}{}
+	}
+	for _, contract := range contractAddresses {
+		if err := ValidateContractAddress(contract); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		key := string(GetTrippedCircuitKey(CIRCUIT_TYPE_CONTRACT, contract))
+		if _, present := seen[key]; present {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("duplicate contract address %s", contract))
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
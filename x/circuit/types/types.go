@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "circuit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey is the module name router key
+	RouterKey = ModuleName
+
+	// DefaultMaxTripDuration is the default limit on how long the emergency authority may trip a circuit for, 7 days
+	DefaultMaxTripDuration = uint64(7 * 24 * 60 * 60)
+)
+
+var (
+	// EmergencyAuthorityKey Indexes the EmergencyAuthority, the address appointed by governance to trip circuits
+	EmergencyAuthorityKey = []byte("emergencyAuthority")
+
+	// MaxTripDurationKey Indexes the MaxTripDuration, the longest the emergency authority may trip a circuit for
+	MaxTripDurationKey = []byte("maxTripDuration")
+)
+
+var (
+	// MsgTypeCircuitKeyPrefix indexes the TrippedCircuits which disable a Msg type URL
+	MsgTypeCircuitKeyPrefix = []byte{0x1}
+
+	// ContractCircuitKeyPrefix indexes the TrippedCircuits which disable an EVM contract address
+	ContractCircuitKeyPrefix = []byte{0x2}
+)
+
+// GetTrippedCircuitKey returns the key for the circuit of circuitType disabling target,
+// the key's format is [ MsgTypeCircuitKeyPrefix | type URL ] or [ ContractCircuitKeyPrefix | contract address ]
+func GetTrippedCircuitKey(circuitType CircuitType, target string) []byte {
+	switch circuitType {
+	case CIRCUIT_TYPE_MSG_TYPE:
+		return append(append([]byte{}, MsgTypeCircuitKeyPrefix...), []byte(target)...)
+	case CIRCUIT_TYPE_CONTRACT:
+		return append(append([]byte{}, ContractCircuitKeyPrefix...), common.HexToAddress(target).Bytes()...)
+	default:
+		panic("invalid circuit type")
+	}
+}
+
+// untrippableMsgTypePrefixes are the Msg type URLs which may never be disabled, so that a compromised emergency
+// authority can never prevent governance from resetting its circuits or appointing a new emergency authority
+var untrippableMsgTypePrefixes = []string{
+	"/althea.circuit.",
+	"/cosmos.gov.",
+}
+
+// IsTrippableMsgType checks that msgTypeURL may be disabled by a circuit
+func IsTrippableMsgType(msgTypeURL string) bool {
+	for _, prefix := range untrippableMsgTypePrefixes {
+		if strings.HasPrefix(msgTypeURL, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,175 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// The kinds of revert a RevertError may hold
+const (
+	RevertKindNone   = "none"   // the call failed without return data, e.g. out of gas or a bare revert()
+	RevertKindError  = "error"  // a require() or revert("reason") failure, encoded as Error(string)
+	RevertKindPanic  = "panic"  // a failed assert, arithmetic error or similar, encoded as Panic(uint256)
+	RevertKindCustom = "custom" // a Solidity custom error, decoded if the contract ABI is known
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// Descriptions of the Panic(uint256) codes emitted by the Solidity compiler
+	panicReasons = map[uint64]string{
+		0x00: "generic compiler inserted panic",
+		0x01: "assertion failed",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array encoding",
+		0x31: "pop on an empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to an uninitialized function",
+	}
+)
+
+// RevertError is a failed EVM call with its revert data decoded. It unwraps to, and has the cause of,
+// evmtypes.ErrVMExecution so existing error checks and ABCI error codes are unchanged
+type RevertError struct {
+	Contract  string        // the called contract, empty for contract creation
+	VMError   string        // the VmError reported by the EVM, e.g. "execution reverted"
+	Kind      string        // one of the RevertKind constants
+	Reason    string        // the human readable reason, e.g. the Error(string) message or the custom error signature
+	PanicCode *big.Int      // the code of a Panic(uint256) revert
+	ErrorName string        // the name of a decoded custom error
+	Args      []interface{} // the arguments of a decoded custom error
+	Data      []byte        // the raw revert data
+}
+
+func (e *RevertError) Error() string {
+	msg := e.VMError
+	if e.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Reason)
+	}
+	if e.Contract != "" {
+		msg = fmt.Sprintf("%s (contract %s)", msg, e.Contract)
+	}
+	return fmt.Sprintf("%s: %s", msg, evmtypes.ErrVMExecution.Error())
+}
+
+func (e *RevertError) Unwrap() error {
+	return evmtypes.ErrVMExecution
+}
+
+// Cause is followed by the SDK's ABCIInfo instead of Unwrap, so that failed txs report ErrVMExecution's codespace and
+// code rather than the internal error code
+func (e *RevertError) Cause() error {
+	return evmtypes.ErrVMExecution
+}
+
+// DecodeRevert decodes the revert data of a failed call. Custom errors are decoded using the first of the given
+// contract ABIs which defines them, otherwise the reason holds the unknown error's selector
+func DecodeRevert(contract *common.Address, vmError string, data []byte, contractABIs ...abi.ABI) *RevertError {
+	revert := RevertError{
+		Contract:  "",
+		VMError:   vmError,
+		Kind:      RevertKindNone,
+		Reason:    "",
+		PanicCode: nil,
+		ErrorName: "",
+		Args:      nil,
+		Data:      data,
+	}
+	if contract != nil {
+		revert.Contract = contract.Hex()
+	}
+	if len(data) < 4 {
+		return &revert
+	}
+
+	switch selector := data[:4]; {
+	case bytes.Equal(selector, errorSelector):
+		revert.Kind = RevertKindError
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			revert.Reason = "undecodable Error(string)"
+		} else {
+			revert.Reason = reason
+		}
+	case bytes.Equal(selector, panicSelector) && len(data) == 36:
+		revert.Kind = RevertKindPanic
+		revert.PanicCode = new(big.Int).SetBytes(data[4:])
+		description, ok := panicReasons[revert.PanicCode.Uint64()]
+		if !revert.PanicCode.IsUint64() || !ok {
+			description = "unknown panic"
+		}
+		revert.Reason = fmt.Sprintf("panic 0x%x: %s", revert.PanicCode, description)
+	default:
+		revert.Kind = RevertKindCustom
+		revert.Reason = fmt.Sprintf("custom error 0x%x", selector)
+		for _, contractABI := range contractABIs {
+			for _, customErr := range contractABI.Errors {
+				customErr := customErr
+				if !bytes.Equal(customErr.ID[:4], selector) {
+					continue
+				}
+				revert.ErrorName = customErr.Name
+				revert.Reason = customErr.Sig
+				if unpacked, err := customErr.Unpack(data); err == nil {
+					revert.Args, _ = unpacked.([]interface{})
+					revert.Reason = formatCustomError(customErr.Name, revert.Args)
+				}
+				return &revert
+			}
+		}
+	}
+	return &revert
+}
+
+// CheckEVMResponse returns nil if the call succeeded, otherwise it returns the decoded failure as a *RevertError. The
+// decoded reason is carried by the error, so it reaches the tx log or the failure event of the caller, since any event
+// emitted alongside a failed call would be discarded with the rest of its state changes
+func CheckEVMResponse(contract *common.Address, res *evmtypes.MsgEthereumTxResponse, contractABIs ...abi.ABI) error {
+	if !res.Failed() {
+		return nil
+	}
+	return DecodeRevert(contract, res.VmError, res.Ret, contractABIs...)
+}
+
+// CheckEstimateGasError decodes the revert data carried by an EstimateGas error, which is how a reverting call fails
+// estimation. Any other error is returned unchanged
+func CheckEstimateGasError(contract *common.Address, err error, contractABIs ...abi.ABI) error {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+
+	return DecodeRevert(contract, vm.ErrExecutionReverted.Error(), common.FromHex(data), contractABIs...)
+}
+
+// AsRevertError returns the decoded revert wrapped in err, if any
+func AsRevertError(err error) (*RevertError, bool) {
+	var revert *RevertError
+	ok := errors.As(err, &revert)
+	return revert, ok
+}
+
+func formatCustomError(name string, args []interface{}) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%v", arg)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(formatted, ", "))
+}
@@ -0,0 +1,127 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	altheacommon "github.com/AltheaFoundation/althea-L1/x/common"
+)
+
+const testErrorsABI = `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`
+
+// dataError mimics the error returned by EstimateGas for a reverted call
+type dataError struct{ data string }
+
+func (e dataError) Error() string          { return "execution reverted" }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+func encodeRevert(t *testing.T, signature string, types []string, args ...interface{}) []byte {
+	arguments := abi.Arguments{}
+	for _, typ := range types {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		arguments = append(arguments, abi.Argument{Name: "", Type: abiType, Indexed: false})
+	}
+	packed, err := arguments.Pack(args...)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	errorsABI, err := abi.JSON(strings.NewReader(testErrorsABI))
+	require.NoError(t, err)
+	customErr := encodeRevert(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(5), big.NewInt(10))
+
+	for _, tc := range []struct {
+		desc   string
+		data   []byte
+		abis   []abi.ABI
+		kind   string
+		reason string
+	}{
+		{
+			desc:   "no data",
+			data:   nil,
+			kind:   altheacommon.RevertKindNone,
+			reason: "",
+		},
+		{
+			desc:   "Error(string)",
+			data:   encodeRevert(t, "Error(string)", []string{"string"}, "insufficient balance"),
+			kind:   altheacommon.RevertKindError,
+			reason: "insufficient balance",
+		},
+		{
+			desc:   "Panic(uint256)",
+			data:   encodeRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x11)),
+			kind:   altheacommon.RevertKindPanic,
+			reason: "panic 0x11: arithmetic underflow or overflow",
+		},
+		{
+			desc:   "unknown panic code",
+			data:   encodeRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99)),
+			kind:   altheacommon.RevertKindPanic,
+			reason: "panic 0x99: unknown panic",
+		},
+		{
+			desc:   "custom error with ABI",
+			data:   customErr,
+			abis:   []abi.ABI{errorsABI},
+			kind:   altheacommon.RevertKindCustom,
+			reason: "InsufficientBalance(5, 10)",
+		},
+		{
+			desc:   "custom error without ABI",
+			data:   customErr,
+			kind:   altheacommon.RevertKindCustom,
+			reason: fmt.Sprintf("custom error 0x%x", customErr[:4]),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			revert := altheacommon.DecodeRevert(&contract, "execution reverted", tc.data, tc.abis...)
+			require.Equal(t, tc.kind, revert.Kind)
+			require.Equal(t, tc.reason, revert.Reason)
+			require.Equal(t, contract.Hex(), revert.Contract)
+			require.True(t, errors.Is(revert, evmtypes.ErrVMExecution))
+			require.Contains(t, revert.Error(), tc.reason)
+		})
+	}
+}
+
+func TestCheckEstimateGasError(t *testing.T) {
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	other := fmt.Errorf("gas required exceeds allowance")
+	require.Equal(t, other, altheacommon.CheckEstimateGasError(&contract, other))
+
+	data := encodeRevert(t, "Error(string)", []string{"string"}, "not owner")
+	err := altheacommon.CheckEstimateGasError(&contract, dataError{data: "0x" + common.Bytes2Hex(data)})
+	revert, ok := altheacommon.AsRevertError(err)
+	require.True(t, ok)
+	require.Equal(t, "not owner", revert.Reason)
+}
+
+func TestRevertErrorABCIInfo(t *testing.T) {
+	contract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	data := encodeRevert(t, "Error(string)", []string{"string"}, "not owner")
+	revert := altheacommon.DecodeRevert(&contract, "execution reverted", data)
+
+	// Wrapping, as callers do before returning the error, must not hide the code either
+	for _, err := range []error{revert, errorsmod.Wrap(revert, "contract call failed")} {
+		codespace, code, log := errorsmod.ABCIInfo(err, false)
+		require.Equal(t, evmtypes.ErrVMExecution.Codespace(), codespace)
+		require.Equal(t, evmtypes.ErrVMExecution.ABCICode(), code)
+		require.Contains(t, log, "not owner")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/AltheaFoundation/althea-L1/contracts"
+	altheacommon "github.com/AltheaFoundation/althea-L1/x/common"
 
 	"github.com/AltheaFoundation/althea-L1/x/erc20/types"
 )
@@ -154,7 +155,7 @@ func (k Keeper) CallEVM(
 		)
 	}
 
-	resp, err := k.CallEVMWithData(ctx, from, &contract, data, commit)
+	resp, err := k.callEVM(ctx, from, &contract, data, big.NewInt(0), commit, abi)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
 	}
@@ -168,6 +169,32 @@ func (k Keeper) CallEVMWithData(
 	contract *common.Address,
 	data []byte,
 	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	return k.CallEVMWithValue(ctx, from, contract, data, big.NewInt(0), commit)
+}
+
+// CallEVMWithValue performs a smart contract method call using contract data, transferring value of the EVM denom
+// from the caller
+func (k Keeper) CallEVMWithValue(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	value *big.Int,
+	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	return k.callEVM(ctx, from, contract, data, value, commit)
+}
+
+// callEVM applies an EVM message, decoding the revert reason of a failed call with the given contract ABIs
+func (k Keeper) callEVM(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	value *big.Int,
+	commit bool,
+	contractABIs ...abi.ABI,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
 	if err != nil {
@@ -178,9 +205,10 @@ func (k Keeper) CallEVMWithData(
 	if commit {
 		//nolint: exhaustruct
 		args, err := json.Marshal(evmtypes.TransactionArgs{
-			From: &from,
-			To:   contract,
-			Data: (*hexutil.Bytes)(&data),
+			From:  &from,
+			To:    contract,
+			Value: (*hexutil.Big)(value),
+			Data:  (*hexutil.Bytes)(&data),
 		})
 		if err != nil {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal tx args: %s", err.Error())
@@ -192,7 +220,8 @@ func (k Keeper) CallEVMWithData(
 			GasCap: config.DefaultGasCap,
 		})
 		if err != nil {
-			return nil, err
+			// Estimation fails when the call reverts, with the revert data carried by the error
+			return nil, altheacommon.CheckEstimateGasError(contract, err, contractABIs...)
 		}
 		gasCap = gasRes.Gas
 	}
@@ -201,7 +230,7 @@ func (k Keeper) CallEVMWithData(
 		from,
 		contract,
 		nonce,
-		big.NewInt(0), // amount
+		value,         // amount
 		gasCap,        // gasLimit
 		big.NewInt(0), // gasFeeCap
 		big.NewInt(0), // gasTipCap
@@ -216,8 +245,8 @@ func (k Keeper) CallEVMWithData(
 		return nil, err
 	}
 
-	if res.Failed() {
-		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	if err := altheacommon.CheckEVMResponse(contract, res, contractABIs...); err != nil {
+		return nil, err
 	}
 
 	return res, nil
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// LockupMsgInspectors returns the lockup module MsgInspectors for the erc20 Msgs moving funds out of an account,
+// allowing governance to lock them. Msgs moving ERC20 tokens are reported as moving the token pair's Cosmos denom.
+// nolint: exhaustruct
+func (k Keeper) LockupMsgInspectors() map[string]lockuptypes.MsgInspector {
+	return map[string]lockuptypes.MsgInspector{
+		sdk.MsgTypeURL(&types.MsgConvertCoin{}): func(_ sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgConvert, ok := msg.(*types.MsgConvertCoin)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return []lockuptypes.MovedFunds{{Sender: msgConvert.Sender, Coins: sdk.Coins{msgConvert.Coin}}}, nil
+		},
+		sdk.MsgTypeURL(&types.MsgSendCoinToEVM{}): func(_ sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgSend, ok := msg.(*types.MsgSendCoinToEVM)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return []lockuptypes.MovedFunds{{Sender: msgSend.Sender, Coins: sdk.Coins{msgSend.Coin}}}, nil
+		},
+		sdk.MsgTypeURL(&types.MsgConvertERC20{}): func(ctx sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgConvert, ok := msg.(*types.MsgConvertERC20)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return k.lockupMovedERC20(ctx, msgConvert.Sender, msgConvert.ContractAddress, msgConvert.Amount), nil
+		},
+		sdk.MsgTypeURL(&types.MsgSendERC20ToCosmos{}): func(ctx sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgSend, ok := msg.(*types.MsgSendERC20ToCosmos)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return k.lockupMovedERC20(ctx, msgSend.Sender, msgSend.Erc20, msgSend.Amount), nil
+		},
+		sdk.MsgTypeURL(&types.MsgSendERC20ToCosmosAndIBCTransfer{}): func(ctx sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgSend, ok := msg.(*types.MsgSendERC20ToCosmosAndIBCTransfer)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return k.lockupMovedERC20(ctx, msgSend.Sender, msgSend.Erc20, msgSend.Amount), nil
+		},
+	}
+}
+
+// lockupMovedERC20 describes amount of the ERC20 contract moved out of the hex sender as the token pair's Cosmos denom.
+// Contracts without a token pair cannot be converted, so they move no funds.
+func (k Keeper) lockupMovedERC20(ctx sdk.Context, sender string, contract string, amount sdk.Int) []lockuptypes.MovedFunds {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, contract))
+	if !found || amount.IsNil() || !amount.IsPositive() {
+		return []lockuptypes.MovedFunds{}
+	}
+	senderAcc := sdk.AccAddress(common.HexToAddress(sender).Bytes())
+	return []lockuptypes.MovedFunds{{Sender: senderAcc.String(), Coins: sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))}}
+}
+
+func unexpectedLockupMsgError(msg sdk.Msg) error {
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "erc20 lockup MsgInspector called with unexpected Msg %T", msg)
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// nolint: exhaustruct
+func (suite *KeeperTestSuite) TestLockupMsgInspectors() {
+	suite.SetupTest()
+	inspectors := suite.app.Erc20Keeper.LockupMsgInspectors()
+
+	// Every erc20 Msg moving funds out of an account must be inspectable, and registered with the lockup module
+	expectedTypes := []string{
+		sdk.MsgTypeURL(&types.MsgConvertCoin{}),
+		sdk.MsgTypeURL(&types.MsgSendCoinToEVM{}),
+		sdk.MsgTypeURL(&types.MsgConvertERC20{}),
+		sdk.MsgTypeURL(&types.MsgSendERC20ToCosmos{}),
+		sdk.MsgTypeURL(&types.MsgSendERC20ToCosmosAndIBCTransfer{}),
+	}
+	suite.Require().Len(inspectors, len(expectedTypes))
+	for _, msgType := range expectedTypes {
+		suite.Require().Contains(inspectors, msgType)
+	}
+	suite.Require().NoError(suite.app.LockupKeeper.ValidateMsgInspectors(expectedTypes))
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coin := sdk.NewCoin("coin", sdk.NewInt(100))
+	contract := tests.GenerateAddress()
+	pair := types.NewTokenPair(contract, "coin", true, types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, contract, pair.GetID())
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+	unpaired := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		expMoved []lockuptypes.MovedFunds
+	}{
+		{
+			"convert coin moves the coin",
+			&types.MsgConvertCoin{Coin: coin, Receiver: suite.address.Hex(), Sender: sender.String()},
+			[]lockuptypes.MovedFunds{{Sender: sender.String(), Coins: sdk.Coins{coin}}},
+		},
+		{
+			"send coin to EVM moves the coin",
+			&types.MsgSendCoinToEVM{Coin: coin, Sender: sender.String()},
+			[]lockuptypes.MovedFunds{{Sender: sender.String(), Coins: sdk.Coins{coin}}},
+		},
+		{
+			"convert ERC20 moves the pair's denom from the hex sender",
+			&types.MsgConvertERC20{ContractAddress: contract.Hex(), Amount: coin.Amount, Receiver: sender.String(), Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{{Sender: sender.String(), Coins: sdk.Coins{coin}}},
+		},
+		{
+			"send ERC20 to Cosmos moves the pair's denom from the hex sender",
+			&types.MsgSendERC20ToCosmos{Erc20: contract.Hex(), Amount: coin.Amount, Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{{Sender: sender.String(), Coins: sdk.Coins{coin}}},
+		},
+		{
+			"send ERC20 to Cosmos and IBC transfer moves the pair's denom from the hex sender",
+			&types.MsgSendERC20ToCosmosAndIBCTransfer{Erc20: contract.Hex(), Amount: coin.Amount, Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{{Sender: sender.String(), Coins: sdk.Coins{coin}}},
+		},
+		{
+			"ERC20 without a token pair moves nothing",
+			&types.MsgConvertERC20{ContractAddress: unpaired.Hex(), Amount: coin.Amount, Receiver: sender.String(), Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{},
+		},
+		{
+			"zero ERC20 amount moves nothing",
+			&types.MsgSendERC20ToCosmos{Erc20: contract.Hex(), Amount: sdk.ZeroInt(), Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{},
+		},
+		{
+			"nil ERC20 amount moves nothing",
+			&types.MsgSendERC20ToCosmos{Erc20: contract.Hex(), Sender: suite.address.Hex()},
+			[]lockuptypes.MovedFunds{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			moved, err := inspectors[sdk.MsgTypeURL(tc.msg)](suite.ctx, tc.msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expMoved, moved)
+		})
+	}
+
+	// Each inspector rejects Msgs of any other type
+	for _, msgType := range expectedTypes {
+		_, err := inspectors[msgType](suite.ctx, &banktypes.MsgSend{})
+		suite.Require().ErrorIs(err, sdkerrors.ErrInvalidType)
+	}
+}
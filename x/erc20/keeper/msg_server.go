@@ -555,7 +555,7 @@ func (k Keeper) SendCoinToEVM(goCtx context.Context, msg *types.MsgSendCoinToEVM
 		return nil, err
 	}
 
-	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, sender, msg.Coin)
+	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, msg, sender, msg.Coin)
 	if err != nil {
 		err = errorsmod.Wrap(err, "unable to collect gasfree fees")
 		return nil, err
@@ -615,7 +615,7 @@ func (k Keeper) SendERC20ToCosmos(goCtx context.Context, msg *types.MsgSendERC20
 		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "no token pair for ERC20 %s, this should be impossible since the token was already converted", msg.Erc20)
 	}
 	coin := sdk.NewCoin(pair.Denom, msg.Amount)
-	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, senderAccAddress, coin)
+	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, msg, senderAccAddress, coin)
 	if err != nil {
 		err = errorsmod.Wrap(err, "unable to collect gasfree fees")
 		return nil, err
@@ -681,7 +681,7 @@ func (k Keeper) SendERC20ToCosmosAndIBCTransfer(goCtx context.Context, msg *type
 		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "no token pair for ERC20 %s, this should be impossible since the token was already converted", msg.Erc20)
 	}
 	coin := sdk.NewCoin(pair.Denom, msg.Amount)
-	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, senderAccAddress, coin)
+	feesCollected, err := k.DeductGasfreeErc20Fee(ctx, msg, senderAccAddress, coin)
 	if err != nil {
 		err = errorsmod.Wrap(err, "unable to collect gasfree fees")
 		return nil, err
@@ -755,7 +755,8 @@ func (k Keeper) initiateIBCTransfer(ctx sdk.Context, msg *types.MsgSendERC20ToCo
 
 // DeductGasfreeErc20Fee will check and deduct the fee for the given sendAmount, based on the gasfree module's GasfreeErc20InteropFeeBasisPoints param value
 // If the amount is insufficient for a fee to be collected (and feeBasisPoints > 0), an error is returned
-func (k Keeper) DeductGasfreeErc20Fee(ctx sdk.Context, sender sdk.AccAddress, sendAmount sdk.Coin) (feeCollected *sdk.Coin, err error) {
+// The collected fee is recorded in the gasfree module's fee accounting under the type of msg
+func (k Keeper) DeductGasfreeErc20Fee(ctx sdk.Context, msg sdk.Msg, sender sdk.AccAddress, sendAmount sdk.Coin) (feeCollected *sdk.Coin, err error) {
 	// Compute the minimum fees which must be paid
 	feeBasisPoints, err := k.gasfreeKeeper.GetGasfreeErc20InteropFeeBasisPoints(ctx)
 	if err != nil {
@@ -769,6 +770,7 @@ func (k Keeper) DeductGasfreeErc20Fee(ctx sdk.Context, sender sdk.AccAddress, se
 	if collectedFee.IsZero() && feeBasisPoints > 0 {
 		return nil, types.ErrInsufficientAmount
 	}
+	k.gasfreeKeeper.RecordFeesCollected(ctx, sdk.MsgTypeURL(msg), sdk.NewCoins(collectedFee))
 
 	return &collectedFee, nil
 }
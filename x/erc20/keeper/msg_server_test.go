@@ -1509,6 +1509,7 @@ func (m MockGasfreeKeeper) GetGasfreeErc20InteropFeeBasisPoints(ctx sdk.Context)
 func (m MockGasfreeKeeper) GetGasfreeErc20InteropTokens(ctx sdk.Context) ([]string, error) {
 	return m.tokens, nil
 }
+func (m MockGasfreeKeeper) RecordFeesCollected(ctx sdk.Context, msgTypeUrl string, fees sdk.Coins) {}
 
 // helper to compute expected fee (mirrors keeper.getGasfreeFeeForAmount logic)
 func calcFee(amount sdkmath.Int, basisPoints uint64) sdkmath.Int {
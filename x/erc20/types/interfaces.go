@@ -53,6 +53,8 @@ type GasfreeKeeper interface {
 	GetGasfreeErc20InteropFeeBasisPoints(ctx sdk.Context) (uint64, error)
 	// GetGasfreeErc20InteropTokens returns the list of ERC20 interop token denoms that are subject to gasfree handling.
 	GetGasfreeErc20InteropTokens(ctx sdk.Context) ([]string, error)
+	// RecordFeesCollected adds fees collected on a gasfree msg to the gasfree module's fee accounting.
+	RecordFeesCollected(ctx sdk.Context, msgTypeUrl string, fees sdk.Coins)
 }
 
 // IBCTransferKeeper defines the subset of the ibc-transfer keeper needed.
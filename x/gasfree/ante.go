@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/AltheaFoundation/althea-L1/x/gasfree/keeper"
 )
@@ -43,6 +44,82 @@ func (sbd SelectiveBypassDecorator) AnteHandle(
 	}
 }
 
+// NewGasfreePriorityDecorator returns an AnteDecorator which assigns a mempool priority to Txs which **only** contain
+// messages of types in the GasFreeMessageTypes set. These Txs skip the usual fee deduction, which is where regular
+// Txs have their priority set, so without this decorator they would always be ordered last and starve under load.
+func NewGasfreePriorityDecorator(gasfreeKeeper keeper.Keeper) GasfreePriorityDecorator {
+	return GasfreePriorityDecorator{gasfreeKeeper}
+}
+
+// GasfreePriorityDecorator sets the priority of gasfree Txs from the native value of the in-token fees they pay
+type GasfreePriorityDecorator struct {
+	gasfreeKeeper keeper.Keeper
+}
+
+// AnteHandle computes the priority of a gasfree Tx as the native value of its msg fees per unit of gas wanted,
+// Txs which are not gasfree are passed along untouched. The priority is only a mempool ordering heuristic, so a Tx whose
+// fees cannot be estimated keeps the default priority instead of being rejected; its msgs still charge their fees.
+func (gpd GasfreePriorityDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	gasFree, err := gpd.gasfreeKeeper.IsGasFreeTx(ctx, gpd.gasfreeKeeper, tx)
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to check gasfree tx priority")
+	}
+	if !gasFree {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	priority, err := gpd.gasfreeKeeper.GetGasFreeTxPriority(ctx, tx.GetMsgs(), feeTx.GetGas())
+	if err != nil {
+		gpd.gasfreeKeeper.Logger(ctx).Debug("unable to compute gasfree tx priority, using the default", "error", err)
+	} else if priority > ctx.Priority() {
+		ctx = ctx.WithPriority(priority)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// NewAlternativeFeeBypassDecorator returns an AnteDecorator which will not execute the bypassable decorator for any
+// Txs which pay their fee entirely in one of the AlternativeFeeDenoms. The native token fee checks and deduction reject
+// such fees, so these Txs are validated and charged by a dedicated alternative fee AnteDecorator instead.
+func NewAlternativeFeeBypassDecorator(gasfreeKeeper keeper.Keeper, bypassable sdk.AnteDecorator) AlternativeFeeBypassDecorator {
+	return AlternativeFeeBypassDecorator{gasfreeKeeper, bypassable}
+}
+
+// AlternativeFeeBypassDecorator enables AnteHandler bypassing for Txs paying their fee in an alternative fee denom
+type AlternativeFeeBypassDecorator struct {
+	gasfreeKeeper keeper.Keeper
+	bypassable    sdk.AnteDecorator
+}
+
+// AnteHandle skips calling the bypassable AnteDecorator if the tx fee is paid in a single alternative fee denom,
+// otherwise the bypassable AnteDecorator will be called as normal.
+func (afbd AlternativeFeeBypassDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if _, isAltFee := afbd.gasfreeKeeper.GetAlternativeFeeDenomForFee(ctx, feeTx.GetFee()); isAltFee {
+		return next(ctx, tx, simulate)
+	}
+	return afbd.bypassable.AnteHandle(ctx, tx, simulate, next)
+}
+
 /* TODO: Handle ICA messages (not mission critical, they will just not be supported by the gasfree module if not considered)
 
 var data icatypes.InterchainAccountPacketData
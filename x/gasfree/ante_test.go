@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
 	"github.com/AltheaFoundation/althea-L1/x/gasfree"
 	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
 	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
@@ -167,3 +169,92 @@ func (suite *GasfreeTestSuite) TestSelectiveBypassAnteDecorator() {
 	}
 
 }
+
+// PriorityIndicatorDecorator is a test antehandler which records the priority of the ctx it receives
+type PriorityIndicatorDecorator struct {
+	Priority *int64
+}
+
+// AnteHandle simply records the ctx priority
+func (pid PriorityIndicatorDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	*pid.Priority = ctx.Priority()
+	return next(ctx, tx, simulate)
+}
+
+func (suite *GasfreeTestSuite) TestGasfreePriorityDecorator() {
+	suite.SetupTest()
+
+	// Default params charge 10% on MsgMicrotx and 1% on the erc20 interop msgs
+	amount := sdk.NewInt(1000)
+	gasLimit := uint64(10)
+	microtx := &microtxtypes.MsgMicrotx{
+		Sender:   "",
+		Receiver: "",
+		Amount:   sdk.NewCoin(altheacfg.BaseDenom, amount),
+	}
+	sendToEvm := &erc20types.MsgSendCoinToEVM{
+		Sender: "",
+		Coin:   sdk.NewCoin("ibc/test", amount),
+	}
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := suite.app.EncodingConfig.TxConfig.NewTxBuilder()
+		suite.Require().NoError(builder.SetMsgs(msgs...))
+		builder.SetGasLimit(gasLimit)
+		return builder.GetTx()
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		tx          sdk.Tx
+		expPriority int64
+	}{
+		{
+			name:        "native fee microtx",
+			malleate:    func(ctx sdk.Context) {},
+			tx:          buildTx(microtx),
+			expPriority: 10, // 100 aalthea fee / 10 gas
+		},
+		{
+			name:        "unpriced fee denom",
+			malleate:    func(ctx sdk.Context) {},
+			tx:          buildTx(sendToEvm),
+			expPriority: 0,
+		},
+		{
+			name: "priced fee denom",
+			malleate: func(ctx sdk.Context) {
+				suite.app.GasfreeKeeper.SetGasFreePriorityPrices(ctx, []types.DenomPrice{{Denom: "ibc/test", NativePrice: sdk.NewDec(2)}})
+			},
+			tx:          buildTx(sendToEvm),
+			expPriority: 2, // 10 ibc/test fee * 2 / 10 gas
+		},
+		{
+			name:        "non-gasfree tx untouched",
+			malleate:    func(ctx sdk.Context) {},
+			tx:          buildTx(microtx, &banktypes.MsgSend{FromAddress: "", ToAddress: "", Amount: nil}),
+			expPriority: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			tc.malleate(ctx)
+
+			var priority int64
+			anteHandler := sdk.ChainAnteDecorators(
+				gasfree.NewGasfreePriorityDecorator(*suite.app.GasfreeKeeper),
+				PriorityIndicatorDecorator{&priority},
+			)
+			_, err := anteHandler(ctx, tc.tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPriority, priority)
+		})
+	}
+}
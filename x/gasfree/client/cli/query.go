@@ -3,6 +3,7 @@ package cli
 import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/spf13/cobra"
 
 	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
@@ -20,6 +21,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 	gasfreeQueryCmd.AddCommand([]*cobra.Command{
 		CmdQueryParams(),
+		CmdQueryEstimateTxFees(),
+		CmdQueryFeesCollected(),
+		CmdQueryFeesCollectedByMsgType(),
+		CmdQueryFeesCollectedByEpoch(),
 	}...)
 
 	return gasfreeQueryCmd
@@ -51,3 +56,134 @@ func CmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryEstimateTxFees estimates the fees which will be charged for a tx, e.g. one generated with --generate-only
+func CmdQueryEstimateTxFees() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "estimate-tx-fees [tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Estimate the fees charged for a JSON encoded tx, including any gasfree message fees",
+		Long: `Estimate the fees charged for a JSON encoded tx, such as one generated with the --generate-only flag.
+The response indicates whether the tx is gasfree, the total fees per denom, and the fees charged on each message.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			// nolint: exhaustruct
+			res, err := queryClient.EstimateTxFees(cmd.Context(), &types.QueryEstimateTxFeesRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryFeesCollected fetches the cumulative fees collected on gasfree msgs in every denom
+func CmdQueryFeesCollected() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "fees-collected",
+		Args:  cobra.NoArgs,
+		Short: "Query the total fees collected on gasfree msgs in every denom",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeesCollected(cmd.Context(), &types.QueryFeesCollectedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryFeesCollectedByMsgType fetches the cumulative fees collected on gasfree msgs, grouped by msg type
+func CmdQueryFeesCollectedByMsgType() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "fees-collected-by-msg-type [optional msg-type-url]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the total fees collected on gasfree msgs for each msg type, or for a single msg type if provided",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			msgTypeUrl := ""
+			if len(args) > 0 {
+				msgTypeUrl = args[0]
+			}
+
+			res, err := queryClient.FeesCollectedByMsgType(cmd.Context(), &types.QueryFeesCollectedByMsgTypeRequest{MsgTypeUrl: msgTypeUrl})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryFeesCollectedByEpoch fetches the fees collected on gasfree msgs in each fee accounting epoch
+func CmdQueryFeesCollectedByEpoch() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "fees-collected-by-epoch",
+		Args:  cobra.NoArgs,
+		Short: "Query the fees collected on gasfree msgs in each fee accounting epoch",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeesCollectedByEpoch(cmd.Context(), &types.QueryFeesCollectedByEpochRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fees-collected-by-epoch")
+	return cmd
+}
@@ -0,0 +1,112 @@
+package gasfree_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
+)
+
+// TestEstimateTxFees checks the fee estimates for gasfree and non-gasfree txs and msgs
+func (suite *GasfreeTestSuite) TestEstimateTxFees() {
+	suite.SetupTest()
+
+	// Default params charge 10% on MsgMicrotx and 1% on the erc20 interop msgs
+	amount := sdk.NewInt(1000)
+	microtx := &microtxtypes.MsgMicrotx{
+		Sender:   "",
+		Receiver: "",
+		Amount:   sdk.NewCoin(altheacfg.BaseDenom, amount),
+	}
+	microtxFee := sdk.NewCoins(sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(100)))
+	txFee := sdk.NewCoins(sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(5)))
+
+	encodeTx := func(msgs ...sdk.Msg) []byte {
+		builder := suite.app.EncodingConfig.TxConfig.NewTxBuilder()
+		suite.Require().NoError(builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(txFee)
+		bz, err := suite.app.EncodingConfig.TxConfig.TxEncoder()(builder.GetTx())
+		suite.Require().NoError(err)
+		return bz
+	}
+	packMsgs := func(msgs ...sdk.Msg) []*codectypes.Any {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			any, err := codectypes.NewAnyWithValue(msg)
+			suite.Require().NoError(err)
+			anys[i] = any
+		}
+		return anys
+	}
+
+	testCases := []struct {
+		name         string
+		req          *types.QueryEstimateTxFeesRequest
+		expPass      bool
+		expGasFree   bool
+		expTotalFees sdk.Coins
+		expMsgFees   int
+	}{
+		{
+			name:         "gasfree microtx tx ignores tx fee",
+			req:          &types.QueryEstimateTxFeesRequest{TxBytes: encodeTx(microtx), Msgs: nil},
+			expPass:      true,
+			expGasFree:   true,
+			expTotalFees: microtxFee,
+			expMsgFees:   1,
+		},
+		{
+			name: "microtx and send tx pays tx fee",
+			// nolint: exhaustruct
+			req:          &types.QueryEstimateTxFeesRequest{TxBytes: encodeTx(microtx, &banktypes.MsgSend{})},
+			expPass:      true,
+			expGasFree:   false,
+			expTotalFees: microtxFee.Add(txFee...),
+			expMsgFees:   2,
+		},
+		{
+			name: "send coin to evm msgs",
+			req: &types.QueryEstimateTxFeesRequest{TxBytes: nil, Msgs: packMsgs(&erc20types.MsgSendCoinToEVM{
+				Sender: "",
+				Coin:   sdk.NewCoin("ibc/test", amount),
+			})},
+			expPass:      true,
+			expGasFree:   true,
+			expTotalFees: sdk.NewCoins(sdk.NewCoin("ibc/test", sdk.NewInt(10))),
+			expMsgFees:   1,
+		},
+		{
+			name: "unregistered erc20 fails",
+			req: &types.QueryEstimateTxFeesRequest{TxBytes: nil, Msgs: packMsgs(&erc20types.MsgSendERC20ToCosmos{
+				Sender: "",
+				Erc20:  "0x0000000000000000000000000000000000000001",
+				Amount: amount,
+			})},
+			expPass: false,
+		},
+		{
+			name:    "empty request fails",
+			req:     &types.QueryEstimateTxFeesRequest{TxBytes: nil, Msgs: nil},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			res, err := suite.app.GasfreeKeeper.EstimateTxFees(sdk.WrapSDKContext(ctx), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasFree, res.GasFree)
+			suite.Require().Equal(tc.expTotalFees.String(), res.TotalFees.String())
+			suite.Require().Len(res.MsgFees, tc.expMsgFees)
+		})
+	}
+}
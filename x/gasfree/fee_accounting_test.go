@@ -0,0 +1,68 @@
+package gasfree_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	gasfreekeeper "github.com/AltheaFoundation/althea-L1/x/gasfree/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
+)
+
+// TestFeeAccounting checks that recorded fees are accumulated per denom, msg type, and epoch, and survive genesis export
+func (suite *GasfreeTestSuite) TestFeeAccounting() {
+	suite.SetupTest()
+	keeper := suite.app.GasfreeKeeper
+
+	// nolint: exhaustruct
+	microtxType := sdk.MsgTypeURL(&microtxtypes.MsgMicrotx{})
+	// nolint: exhaustruct
+	sendToEvmType := sdk.MsgTypeURL(&erc20types.MsgSendCoinToEVM{})
+	epochLength := keeper.GetFeeAccountingEpochLength(suite.ctx)
+	suite.Require().Equal(types.DefaultParams().FeeAccountingEpochLength, epochLength)
+
+	nativeFee := sdk.NewCoins(sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(100)))
+	ibcFee := sdk.NewCoins(sdk.NewCoin("ibc/test", sdk.NewInt(10)))
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	keeper.RecordFeesCollected(ctx, microtxType, nativeFee)
+	keeper.RecordFeesCollected(ctx, sendToEvmType, ibcFee)
+	// Zero fees are ignored entirely
+	keeper.RecordFeesCollected(ctx, sendToEvmType, sdk.NewCoins())
+
+	ctx = suite.ctx.WithBlockHeight(int64(epochLength) + 1)
+	keeper.RecordFeesCollected(ctx, microtxType, nativeFee)
+
+	// Totals by denom
+	res, err := keeper.FeesCollected(sdk.WrapSDKContext(ctx), &types.QueryFeesCollectedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(nativeFee.Add(nativeFee...).Add(ibcFee...).String(), res.Fees.String())
+
+	// Totals by msg type
+	msgTypeRes, err := keeper.FeesCollectedByMsgType(sdk.WrapSDKContext(ctx), &types.QueryFeesCollectedByMsgTypeRequest{MsgTypeUrl: ""})
+	suite.Require().NoError(err)
+	suite.Require().Len(msgTypeRes.Fees, 2)
+	msgTypeRes, err = keeper.FeesCollectedByMsgType(sdk.WrapSDKContext(ctx), &types.QueryFeesCollectedByMsgTypeRequest{MsgTypeUrl: microtxType})
+	suite.Require().NoError(err)
+	suite.Require().Len(msgTypeRes.Fees, 1)
+	suite.Require().Equal(nativeFee.Add(nativeFee...).String(), msgTypeRes.Fees[0].Fees.String())
+
+	// Totals by epoch
+	// nolint: exhaustruct
+	epochRes, err := keeper.FeesCollectedByEpoch(sdk.WrapSDKContext(ctx), &types.QueryFeesCollectedByEpochRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), epochRes.CurrentEpoch)
+	suite.Require().Len(epochRes.Fees, 2)
+	suite.Require().Equal(uint64(0), epochRes.Fees[0].Epoch)
+	suite.Require().Equal(nativeFee.Add(ibcFee...).String(), epochRes.Fees[0].Fees.String())
+	suite.Require().Equal(uint64(1), epochRes.Fees[1].Epoch)
+	suite.Require().Equal(nativeFee.String(), epochRes.Fees[1].Fees.String())
+
+	// Genesis export includes all of the fee accounting
+	genesis := gasfreekeeper.ExportGenesis(ctx, *keeper)
+	suite.Require().NoError(genesis.ValidateBasic())
+	suite.Require().Equal(res.Fees.String(), genesis.FeesByDenom.String())
+	suite.Require().Len(genesis.FeesByMsgType, 2)
+	suite.Require().Len(genesis.FeesByEpoch, 2)
+}
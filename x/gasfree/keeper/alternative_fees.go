@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAlternativeFeeDenoms returns the governance approved denoms which may be used to pay tx fees.
+// If the param is not set yet (e.g. before the v4 migration), the default param value is used
+func (k Keeper) GetAlternativeFeeDenoms(ctx sdk.Context) []types.AlternativeFeeDenom {
+	denoms := types.DefaultParams().AlternativeFeeDenoms
+	k.paramSpace.GetIfExists(ctx, types.AlternativeFeeDenomsKey, &denoms)
+	return denoms
+}
+
+func (k Keeper) SetAlternativeFeeDenoms(ctx sdk.Context, denoms []types.AlternativeFeeDenom) {
+	k.paramSpace.Set(ctx, types.AlternativeFeeDenomsKey, &denoms)
+}
+
+// GetAlternativeFeeDenom returns the AlternativeFeeDenom config for denom, and false if denom is not an alternative fee denom
+func (k Keeper) GetAlternativeFeeDenom(ctx sdk.Context, denom string) (types.AlternativeFeeDenom, bool) {
+	for _, altDenom := range k.GetAlternativeFeeDenoms(ctx) {
+		if altDenom.Denom == denom {
+			return altDenom, true
+		}
+	}
+	return types.AlternativeFeeDenom{}, false
+}
+
+// GetAlternativeFeeDenomForFee returns the AlternativeFeeDenom config if the given tx fee is paid entirely in a single
+// alternative fee denom. Fees paid in the native token, or mixing several denoms, are left to the regular fee handling.
+func (k Keeper) GetAlternativeFeeDenomForFee(ctx sdk.Context, fee sdk.Coins) (types.AlternativeFeeDenom, bool) {
+	if len(fee) != 1 {
+		return types.AlternativeFeeDenom{}, false
+	}
+	return k.GetAlternativeFeeDenom(ctx, fee[0].Denom)
+}
+
+// GetAlternativeFeeRate returns the value of one base unit of the alternative fee denom in native token base units.
+// Denoms with a DEX pool are priced by the price oracle's TWAP, which unlike the pool's spot price cannot be pushed
+// within a block. The TWAP is stored at the end of each block so that pricing a fee is a single store read, and the
+// static rate (if any) is used when the oracle has no recent price.
+func (k Keeper) GetAlternativeFeeRate(ctx sdk.Context, altDenom types.AlternativeFeeDenom) (sdk.Dec, error) {
+	if altDenom.DexPoolIdx == 0 {
+		return altDenom.StaticRate, nil
+	}
+
+	if price, ok := k.GetOraclePrice(ctx, altDenom.Denom); ok {
+		return price, nil
+	}
+	if altDenom.StaticRate.IsPositive() {
+		return altDenom.StaticRate, nil
+	}
+	return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidAlternativeFee, "the price oracle has no recent price for %s", altDenom.Denom)
+}
+
+// getAlternativeFeeOraclePrice reads the native token value of the alternative fee denom from the price oracle
+func (k Keeper) getAlternativeFeeOraclePrice(ctx sdk.Context, altDenom types.AlternativeFeeDenom) (sdk.Dec, error) {
+	if k.priceOracle == nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidAlternativeFee, "no price oracle to price %s", altDenom.Denom)
+	}
+	price, ok := k.priceOracle.GetNativePrice(ctx, altDenom.Denom)
+	if !ok || !price.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidAlternativeFee, "the price oracle has no recent price for %s", altDenom.Denom)
+	}
+	return price, nil
+}
+
+// getAlternativeFeeErc20 returns the ERC20 contract address registered for denom with the erc20 module
+func (k Keeper) getAlternativeFeeErc20(ctx sdk.Context, denom string) (common.Address, error) {
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return common.Address{}, errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "alternative fee denom %s has no registered ERC20", denom)
+	}
+	return common.HexToAddress(pair.Erc20Address), nil
+}
+
+// GetAlternativeFeeNativeValue converts an alternative fee into its value in native token base units
+func (k Keeper) GetAlternativeFeeNativeValue(ctx sdk.Context, altDenom types.AlternativeFeeDenom, fee sdk.Coin) (sdk.Int, error) {
+	if fee.Denom != altDenom.Denom {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidAlternativeFee, "fee denom %s does not match %s", fee.Denom, altDenom.Denom)
+	}
+	rate, err := k.GetAlternativeFeeRate(ctx, altDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return rate.MulInt(fee.Amount).TruncateInt(), nil
+}
+
+// CollectAlternativeFee takes an alternative fee from payer. Fees in denoms which are swapped to the native token are
+// held by the gasfree module until the EndBlocker, all others are sent directly to the fee collector. The distribution
+// module allocates every denom held by the fee collector, so those fees are paid to validators, delegators, and the
+// community pool in their own denom. Alternative fees are regular tx fees, and are not part of the gasfree module's
+// fee accounting.
+func (k Keeper) CollectAlternativeFee(ctx sdk.Context, payer sdk.AccAddress, altDenom types.AlternativeFeeDenom, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if !fee.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+
+	recipient := authtypes.FeeCollectorName
+	if altDenom.SwapToNative {
+		recipient = types.ModuleName
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, recipient, fee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	return nil
+}
+
+// SwapAlternativeFeesToNative swaps the alternative fees held by the gasfree module for the native token on the DEX,
+// forwarding the proceeds to the fee collector. A failed swap forwards the unswapped fees to the fee collector instead,
+// so no fees are ever stuck in the gasfree module, while fees left unsold by a partial fill are retried next block.
+func (k Keeper) SwapAlternativeFeesToNative(ctx sdk.Context) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, altDenom := range k.GetAlternativeFeeDenoms(ctx) {
+		if !altDenom.SwapToNative {
+			continue
+		}
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddr, altDenom.Denom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		// Swap in a cache context so that a failure part way through leaves no partial conversion behind
+		cacheCtx, commit := ctx.CacheContext()
+		amountPaid, amountOut, err := k.swapAlternativeFee(cacheCtx, altDenom, balance)
+		if err != nil {
+			k.Logger(ctx).Error("unable to swap alternative fees", "denom", altDenom.Denom, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeAlternativeFeeSwapFailed,
+				sdk.NewAttribute(types.AlternativeFeeSwapFailedKeyAmount, balance.String()),
+				sdk.NewAttribute(types.AlternativeFeeSwapFailedKeyError, err.Error()),
+			))
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(balance)); err != nil {
+				k.Logger(ctx).Error("unable to forward unswapped alternative fees", "denom", altDenom.Denom, "error", err)
+			}
+			continue
+		}
+		commit()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAlternativeFeeSwap,
+			sdk.NewAttribute(types.AlternativeFeeSwapKeyAmountIn, sdk.NewCoin(altDenom.Denom, amountPaid).String()),
+			sdk.NewAttribute(types.AlternativeFeeSwapKeyAmountOut, sdk.NewCoin(altheacfg.BaseDenom, amountOut).String()),
+		))
+	}
+}
+
+// swapAlternativeFee sells the gasfree module's balance of an alternative fee denom on the DEX for the native token,
+// forwarding the proceeds to the fee collector and returning the amounts sold and received. The gasfree module account
+// is blocked from receiving the native token the DEX pays out, so the fees are moved to the nativedex module account
+// and sold as ERC20s from its EVM address. Any amount left unsold by a partial fill is returned to the gasfree module.
+func (k Keeper) swapAlternativeFee(ctx sdk.Context, altDenom types.AlternativeFeeDenom, amount sdk.Coin) (sdk.Int, sdk.Int, error) {
+	if altDenom.DexPoolIdx == 0 {
+		return sdk.Int{}, sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidAlternativeFee, "alternative fee denom %s has no DEX pool", altDenom.Denom)
+	}
+	token, err := k.getAlternativeFeeErc20(ctx, altDenom.Denom)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	price, err := k.getAlternativeFeeOraclePrice(ctx, altDenom)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, nativedextypes.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Int{}, sdk.Int{}, errorsmod.Wrap(err, "unable to move alternative fees to the nativedex module")
+	}
+	res, err := k.erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), &erc20types.MsgConvertCoin{
+		Coin:     amount,
+		Receiver: nativedextypes.ModuleEVMAddress.Hex(),
+		Sender:   nativedextypes.ModuleAddress.String(),
+	})
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, errorsmod.Wrap(err, "unable to convert alternative fees to ERC20")
+	}
+	if res == nil {
+		return sdk.Int{}, sdk.Int{}, errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "token pair for %s has been removed", altDenom.Denom)
+	}
+
+	// Protect against a manipulated pool by requiring the swap to fill within the configured slippage of the oracle price
+	maxSlippage := sdk.NewDecWithPrec(int64(altDenom.MaxSwapSlippageBasisPoints), 4)
+	minOut := price.MulInt(amount.Amount).Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+
+	amountPaid, amountOut, err := k.nativedexKeeper.SwapExactIn(
+		ctx, nativedextypes.ModuleEVMAddress, common.Address{}, token, altDenom.DexPoolIdx, false, amount.Amount.BigInt(), minOut.BigInt(),
+	)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	paid := sdk.NewIntFromBigInt(amountPaid)
+	proceeds := sdk.NewCoin(altheacfg.BaseDenom, sdk.NewIntFromBigInt(amountOut))
+
+	if unsold := amount.Amount.Sub(paid); unsold.IsPositive() {
+		_, err := k.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), &erc20types.MsgConvertERC20{
+			ContractAddress: token.Hex(),
+			Amount:          unsold,
+			Receiver:        nativedextypes.ModuleAddress.String(),
+			Sender:          nativedextypes.ModuleEVMAddress.Hex(),
+		})
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, errorsmod.Wrap(err, "unable to convert unsold alternative fees from ERC20")
+		}
+		unsoldCoins := sdk.NewCoins(sdk.NewCoin(altDenom.Denom, unsold))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, nativedextypes.ModuleName, types.ModuleName, unsoldCoins); err != nil {
+			return sdk.Int{}, sdk.Int{}, errorsmod.Wrap(err, "unable to return unsold alternative fees")
+		}
+	}
+	if proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, nativedextypes.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(proceeds)); err != nil {
+			return sdk.Int{}, sdk.Int{}, errorsmod.Wrap(err, "unable to forward swapped alternative fees")
+		}
+	}
+	return paid, proceeds.Amount, nil
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// GetFeeAccountingEpochLength returns the number of blocks in each fee accounting epoch.
+// If the param is not set yet (e.g. before the v2 migration), the default param value is used
+func (k Keeper) GetFeeAccountingEpochLength(ctx sdk.Context) uint64 {
+	epochLength := types.DefaultParams().FeeAccountingEpochLength
+	k.paramSpace.GetIfExists(ctx, types.FeeAccountingEpochLengthKey, &epochLength)
+	return epochLength
+}
+
+func (k Keeper) SetFeeAccountingEpochLength(ctx sdk.Context, epochLength uint64) {
+	k.paramSpace.Set(ctx, types.FeeAccountingEpochLengthKey, &epochLength)
+}
+
+// GetCurrentFeeAccountingEpoch returns the fee accounting epoch containing the current block
+func (k Keeper) GetCurrentFeeAccountingEpoch(ctx sdk.Context) uint64 {
+	epochLength := k.GetFeeAccountingEpochLength(ctx)
+	if epochLength == 0 {
+		return 0
+	}
+	return uint64(ctx.BlockHeight()) / epochLength
+}
+
+// RecordFeesCollected adds fees collected by one of the gasfree payment paths to the cumulative totals per denom,
+// per msg type, and for the current epoch. Call this wherever a gasfree fee has been successfully deducted.
+func (k Keeper) RecordFeesCollected(ctx sdk.Context, msgTypeUrl string, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+
+	for _, fee := range fees {
+		k.setFeesByDenom(ctx, k.GetFeesByDenom(ctx, fee.Denom).Add(fee))
+	}
+
+	msgTypeFees := k.GetFeesByMsgType(ctx, msgTypeUrl)
+	msgTypeFees.Fees = msgTypeFees.Fees.Add(fees...)
+	k.setFeesByMsgType(ctx, msgTypeFees)
+
+	epochFees := k.GetFeesByEpoch(ctx, k.GetCurrentFeeAccountingEpoch(ctx))
+	epochFees.Fees = epochFees.Fees.Add(fees...)
+	k.setFeesByEpoch(ctx, epochFees)
+}
+
+// GetFeesByDenom returns the cumulative fees collected in the given denom
+func (k Keeper) GetFeesByDenom(ctx sdk.Context, denom string) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeesByDenomKey(denom))
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	var fee sdk.Coin
+	k.Cdc.MustUnmarshal(bz, &fee)
+	return fee
+}
+
+func (k Keeper) setFeesByDenom(ctx sdk.Context, fee sdk.Coin) {
+	ctx.KVStore(k.storeKey).Set(types.GetFeesByDenomKey(fee.Denom), k.Cdc.MustMarshal(&fee))
+}
+
+// GetAllFeesByDenom returns the cumulative fees collected in every denom
+func (k Keeper) GetAllFeesByDenom(ctx sdk.Context) sdk.Coins {
+	fees := sdk.NewCoins()
+	k.IterateFeesByDenom(ctx, func(fee sdk.Coin) (stop bool) {
+		fees = fees.Add(fee)
+		return false
+	})
+	return fees
+}
+
+// IterateFeesByDenom calls cb on the cumulative fees collected in every denom, stopping early if cb returns true
+func (k Keeper) IterateFeesByDenom(ctx sdk.Context, cb func(fee sdk.Coin) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesByDenomKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fee sdk.Coin
+		k.Cdc.MustUnmarshal(iter.Value(), &fee)
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+// GetFeesByMsgType returns the cumulative fees collected on the given msg type url
+func (k Keeper) GetFeesByMsgType(ctx sdk.Context, msgTypeUrl string) types.MsgTypeFees {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeesByMsgTypeKey(msgTypeUrl))
+	if len(bz) == 0 {
+		return types.MsgTypeFees{MsgTypeUrl: msgTypeUrl, Fees: sdk.NewCoins()}
+	}
+	var fees types.MsgTypeFees
+	k.Cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+func (k Keeper) setFeesByMsgType(ctx sdk.Context, fees types.MsgTypeFees) {
+	ctx.KVStore(k.storeKey).Set(types.GetFeesByMsgTypeKey(fees.MsgTypeUrl), k.Cdc.MustMarshal(&fees))
+}
+
+// GetAllFeesByMsgType returns the cumulative fees collected on every msg type
+func (k Keeper) GetAllFeesByMsgType(ctx sdk.Context) []types.MsgTypeFees {
+	var allFees []types.MsgTypeFees
+	k.IterateFeesByMsgType(ctx, func(fees types.MsgTypeFees) (stop bool) {
+		allFees = append(allFees, fees)
+		return false
+	})
+	return allFees
+}
+
+// IterateFeesByMsgType calls cb on the cumulative fees collected on every msg type, stopping early if cb returns true
+func (k Keeper) IterateFeesByMsgType(ctx sdk.Context, cb func(fees types.MsgTypeFees) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesByMsgTypeKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fees types.MsgTypeFees
+		k.Cdc.MustUnmarshal(iter.Value(), &fees)
+		if cb(fees) {
+			break
+		}
+	}
+}
+
+// GetFeesByEpoch returns the fees collected during the given epoch
+func (k Keeper) GetFeesByEpoch(ctx sdk.Context, epoch uint64) types.EpochFees {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeesByEpochKey(epoch))
+	if len(bz) == 0 {
+		return types.EpochFees{Epoch: epoch, Fees: sdk.NewCoins()}
+	}
+	var fees types.EpochFees
+	k.Cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+func (k Keeper) setFeesByEpoch(ctx sdk.Context, fees types.EpochFees) {
+	ctx.KVStore(k.storeKey).Set(types.GetFeesByEpochKey(fees.Epoch), k.Cdc.MustMarshal(&fees))
+}
+
+// GetAllFeesByEpoch returns the fees collected during every epoch, oldest first
+func (k Keeper) GetAllFeesByEpoch(ctx sdk.Context) []types.EpochFees {
+	var allFees []types.EpochFees
+	k.IterateFeesByEpoch(ctx, func(fees types.EpochFees) (stop bool) {
+		allFees = append(allFees, fees)
+		return false
+	})
+	return allFees
+}
+
+// IterateFeesByEpoch calls cb on the fees collected during every epoch, oldest first, stopping early if cb returns true
+func (k Keeper) IterateFeesByEpoch(ctx sdk.Context, cb func(fees types.EpochFees) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesByEpochKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fees types.EpochFees
+		k.Cdc.MustUnmarshal(iter.Value(), &fees)
+		if cb(fees) {
+			break
+		}
+	}
+}
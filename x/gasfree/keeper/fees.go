@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	altheacommon "github.com/AltheaFoundation/althea-L1/x/common"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
+)
+
+// nolint: exhaustruct
+var microtxMsgType string = sdk.MsgTypeURL(&microtxtypes.MsgMicrotx{})
+
+// EstimateMsgFees computes the in-token fees which will be charged when the given msgs execute, returning the total
+// per denom and a breakdown per top-level msg. The usual tx fee is not considered here, see EstimateTxFees.
+//
+// The fees mirror the chain's collection logic:
+// * MsgMicrotx is charged MicrotxFeeBasisPoints of the amount, either in the AnteHandler or in the Msg handler
+// * MsgSendCoinToEVM, MsgSendERC20ToCosmos, and MsgSendERC20ToCosmosAndIBCTransfer are charged
+// GasFreeErc20InteropFeeBasisPoints of the amount in the Msg handler
+func (k Keeper) EstimateMsgFees(ctx sdk.Context, msgs []sdk.Msg) (totalFees sdk.Coins, msgFees []types.MsgFeeEstimate, err error) {
+	gasFreeMessageSet := k.GetGasFreeMessageTypesSet(ctx)
+	totalFees = sdk.NewCoins()
+	msgFees = make([]types.MsgFeeEstimate, 0, len(msgs))
+
+	for _, msg := range msgs {
+		var fees sdk.Coins
+		switch msg := msg.(type) {
+		// Since authz MsgExec holds Msgs inside of it, the fees of those inner Msgs must be estimated
+		case *authz.MsgExec:
+			fees = sdk.NewCoins()
+			for _, m := range msg.Msgs {
+				var inner sdk.Msg
+				err := k.Cdc.UnpackAny(m, &inner)
+				if err != nil {
+					return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unpack authz msgexec message: %v", err)
+				}
+				innerFees, err := k.estimateMsgFee(ctx, gasFreeMessageSet, inner, false)
+				if err != nil {
+					return nil, nil, err
+				}
+				fees = fees.Add(innerFees...)
+			}
+		default:
+			fees, err = k.estimateMsgFee(ctx, gasFreeMessageSet, msg, true)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		totalFees = totalFees.Add(fees...)
+		msgFees = append(msgFees, types.MsgFeeEstimate{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			GasFree:    k.IsGasFreeMsg(gasFreeMessageSet, msg),
+			Fees:       fees,
+		})
+	}
+
+	return totalFees, msgFees, nil
+}
+
+// estimateMsgFee computes the in-token fee for a single msg, topLevel should be false for msgs nested in an authz MsgExec
+func (k Keeper) estimateMsgFee(ctx sdk.Context, gasFreeMessageSet map[string]struct{}, msg sdk.Msg, topLevel bool) (sdk.Coins, error) {
+	switch msg := msg.(type) {
+	case *microtxtypes.MsgMicrotx:
+		// A gasfree MsgMicrotx has its fee collected in the AnteHandler, which only inspects top-level msgs,
+		// otherwise the fee is collected in the Msg handler
+		if !topLevel && inSet(gasFreeMessageSet, microtxMsgType) {
+			return sdk.NewCoins(), nil
+		}
+		// Mirror DeductMicrotxFee, which charges no fee when the params are not set
+		basisPoints, err := k.microtxKeeper.GetMicrotxFeeBasisPoints(ctx)
+		if err != nil {
+			basisPoints = 0
+		}
+		return basisPointFee(msg.Amount, basisPoints), nil
+
+	case *erc20types.MsgSendCoinToEVM:
+		basisPoints, err := k.GetGasfreeErc20InteropFeeBasisPoints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return basisPointFee(msg.Coin, basisPoints), nil
+
+	case *erc20types.MsgSendERC20ToCosmos:
+		return k.estimateErc20InteropFee(ctx, msg.Erc20, msg.Amount)
+
+	case *erc20types.MsgSendERC20ToCosmosAndIBCTransfer:
+		return k.estimateErc20InteropFee(ctx, msg.Erc20, msg.Amount)
+
+	default:
+		return sdk.NewCoins(), nil
+	}
+}
+
+// estimateErc20InteropFee computes the fee charged when converting amount of the erc20 token to its Cosmos coin,
+// the fee is charged in the Cosmos coin
+func (k Keeper) estimateErc20InteropFee(ctx sdk.Context, erc20 string, amount sdk.Int) (sdk.Coins, error) {
+	basisPoints, err := k.GetGasfreeErc20InteropFeeBasisPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, erc20))
+	if !found {
+		return nil, errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "no token pair for ERC20 %s", erc20)
+	}
+	return basisPointFee(sdk.NewCoin(pair.Denom, amount), basisPoints), nil
+}
+
+// basisPointFee computes the fee on the given coin, dropping zero fees
+func basisPointFee(coin sdk.Coin, basisPoints uint64) sdk.Coins {
+	fee := altheacommon.CalculateBasisPointFee(coin.Amount, basisPoints)
+	return sdk.NewCoins(sdk.NewCoin(coin.Denom, fee))
+}
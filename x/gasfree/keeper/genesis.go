@@ -12,6 +12,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetGasFreeMessageTypes(ctx, params.GetGasFreeMessageTypes())
 	k.SetGasfreeErc20InteropTokens(ctx, params.GetGasFreeErc20InteropTokens())
 	k.SetGasfreeErc20InteropFeeBasisPoints(ctx, params.GetGasFreeErc20InteropFeeBasisPoints())
+	k.SetFeeAccountingEpochLength(ctx, params.GetFeeAccountingEpochLength())
+	k.SetGasFreePriorityPrices(ctx, params.GetGasFreePriorityPrices())
+	k.SetAlternativeFeeDenoms(ctx, params.GetAlternativeFeeDenoms())
+
+	for _, fee := range data.FeesByDenom {
+		k.setFeesByDenom(ctx, fee)
+	}
+	for _, fees := range data.FeesByMsgType {
+		k.setFeesByMsgType(ctx, fees)
+	}
+	for _, fees := range data.FeesByEpoch {
+		k.setFeesByEpoch(ctx, fees)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -22,6 +35,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		panic(err)
 	}
 	return types.GenesisState{
-		Params: &params,
+		Params:        &params,
+		FeesByDenom:   k.GetAllFeesByDenom(ctx),
+		FeesByMsgType: k.GetAllFeesByMsgType(ctx),
+		FeesByEpoch:   k.GetAllFeesByEpoch(ctx),
 	}
 }
@@ -3,7 +3,13 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
 )
@@ -20,3 +26,130 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	}
 	return &types.QueryParamsResponse{Params: p}, nil
 }
+
+// EstimateTxFees computes the fees which will be charged for the given tx bytes or msgs
+func (k Keeper) EstimateTxFees(c context.Context, req *types.QueryEstimateTxFeesRequest) (*types.QueryEstimateTxFeesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		msgs  []sdk.Msg
+		txFee sdk.Coins
+		err   error
+	)
+	if len(req.TxBytes) > 0 {
+		msgs, txFee, err = k.decodeTx(req.TxBytes)
+	} else {
+		msgs, err = k.unpackMsgs(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no msgs provided")
+	}
+
+	gasFree, err := k.IsGasFreeMsgs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	totalFees, msgFees, err := k.EstimateMsgFees(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	// Gasfree txs bypass the usual fee deduction, so only non-gasfree txs pay the declared tx fee
+	if !gasFree {
+		totalFees = totalFees.Add(txFee...)
+	}
+
+	return &types.QueryEstimateTxFeesResponse{
+		GasFree:   gasFree,
+		TotalFees: totalFees,
+		TxFee:     txFee,
+		MsgFees:   msgFees,
+	}, nil
+}
+
+// decodeTx decodes protobuf encoded tx bytes into the tx's msgs and declared fee
+func (k Keeper) decodeTx(txBytes []byte) ([]sdk.Msg, sdk.Coins, error) {
+	var raw txtypes.TxRaw
+	if err := k.Cdc.Unmarshal(txBytes, &raw); err != nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	var body txtypes.TxBody
+	if err := k.Cdc.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	var authInfo txtypes.AuthInfo
+	if err := k.Cdc.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	// nolint: exhaustruct
+	tx := txtypes.Tx{Body: &body, AuthInfo: &authInfo}
+	return tx.GetMsgs(), authInfo.GetFee().GetAmount(), nil
+}
+
+// unpackMsgs resolves the Any encoded msgs in the request
+func (k Keeper) unpackMsgs(req *types.QueryEstimateTxFeesRequest) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, any := range req.Msgs {
+		var msg sdk.Msg
+		if err := k.Cdc.UnpackAny(any, &msg); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unpack msg %d: %v", i, err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// FeesCollected returns the cumulative fees collected by the gasfree payment paths, per denom
+func (k Keeper) FeesCollected(c context.Context, req *types.QueryFeesCollectedRequest) (*types.QueryFeesCollectedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeesCollectedResponse{Fees: k.GetAllFeesByDenom(ctx)}, nil
+}
+
+// FeesCollectedByMsgType returns the cumulative fees collected by the gasfree payment paths, per msg type
+func (k Keeper) FeesCollectedByMsgType(c context.Context, req *types.QueryFeesCollectedByMsgTypeRequest) (*types.QueryFeesCollectedByMsgTypeResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.MsgTypeUrl != "" {
+		return &types.QueryFeesCollectedByMsgTypeResponse{
+			Fees: []types.MsgTypeFees{k.GetFeesByMsgType(ctx, req.MsgTypeUrl)},
+		}, nil
+	}
+	return &types.QueryFeesCollectedByMsgTypeResponse{Fees: k.GetAllFeesByMsgType(ctx)}, nil
+}
+
+// FeesCollectedByEpoch returns the fees collected by the gasfree payment paths in each epoch
+func (k Keeper) FeesCollectedByEpoch(c context.Context, req *types.QueryFeesCollectedByEpochRequest) (*types.QueryFeesCollectedByEpochResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var epochFees []types.EpochFees
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeesByEpochKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fees types.EpochFees
+		if err := k.Cdc.Unmarshal(value, &fees); err != nil {
+			return err
+		}
+		epochFees = append(epochFees, fees)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeesCollectedByEpochResponse{
+		CurrentEpoch: k.GetCurrentFeeAccountingEpoch(ctx),
+		Fees:         epochFees,
+		Pagination:   pageRes,
+	}, nil
+}
@@ -17,23 +17,99 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 	Cdc        codec.Codec
+	bankKeeper types.BankKeeper
+
+	microtxKeeper   types.MicrotxKeeper   // to be set later via SetMicrotxKeeper
+	erc20Keeper     types.Erc20Keeper     // to be set later via SetErc20Keeper
+	nativedexKeeper types.NativedexKeeper // to be set later via SetNativedexKeeper
+	priceOracle     types.PriceOracle     // to be set later via SetPriceOracle
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace, bankKeeper types.BankKeeper) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	k := Keeper{
-		paramSpace: paramSpace,
-		storeKey:   storeKey,
-		Cdc:        cdc,
+		paramSpace:      paramSpace,
+		storeKey:        storeKey,
+		Cdc:             cdc,
+		bankKeeper:      bankKeeper,
+		microtxKeeper:   nil,
+		erc20Keeper:     nil,
+		nativedexKeeper: nil,
+		priceOracle:     nil,
 	}
 
 	return k
 }
 
+// SetMicrotxKeeper injects the microtx keeper after it has been constructed, since microtx depends on gasfree.
+// It panics if called more than once or with a nil argument.
+func (k *Keeper) SetMicrotxKeeper(microtxKeeper types.MicrotxKeeper) {
+	if microtxKeeper == nil {
+		panic("attempted to set a nil microtxKeeper on gasfree keeper")
+	}
+	if k.microtxKeeper != nil {
+		panic("microtxKeeper already set on gasfree keeper")
+	}
+	k.microtxKeeper = microtxKeeper
+}
+
+// SetErc20Keeper injects the erc20 keeper after it has been constructed, since erc20 depends on gasfree.
+// It panics if called more than once or with a nil argument.
+func (k *Keeper) SetErc20Keeper(erc20Keeper types.Erc20Keeper) {
+	if erc20Keeper == nil {
+		panic("attempted to set a nil erc20Keeper on gasfree keeper")
+	}
+	if k.erc20Keeper != nil {
+		panic("erc20Keeper already set on gasfree keeper")
+	}
+	k.erc20Keeper = erc20Keeper
+}
+
+// SetNativedexKeeper injects the nativedex keeper after it has been constructed, since nativedex is built after gasfree.
+// It panics if called more than once or with a nil argument.
+func (k *Keeper) SetNativedexKeeper(nativedexKeeper types.NativedexKeeper) {
+	if nativedexKeeper == nil {
+		panic("attempted to set a nil nativedexKeeper on gasfree keeper")
+	}
+	if k.nativedexKeeper != nil {
+		panic("nativedexKeeper already set on gasfree keeper")
+	}
+	k.nativedexKeeper = nativedexKeeper
+}
+
+// SetPriceOracle injects the market price source used to price alternative fees and assign mempool priority to
+// gasfree txs.
+// It panics if called more than once or with a nil argument.
+func (k *Keeper) SetPriceOracle(priceOracle types.PriceOracle) {
+	if priceOracle == nil {
+		panic("attempted to set a nil priceOracle on gasfree keeper")
+	}
+	if k.priceOracle != nil {
+		panic("priceOracle already set on gasfree keeper")
+	}
+	k.priceOracle = priceOracle
+}
+
+// ValidateDependencies ensures all late-bound dependencies have been set; call at end of app constructor.
+func (k Keeper) ValidateDependencies() {
+	if k.microtxKeeper == nil {
+		panic("gasfree keeper dependency not set: microtxKeeper")
+	}
+	if k.erc20Keeper == nil {
+		panic("gasfree keeper dependency not set: erc20Keeper")
+	}
+	if k.nativedexKeeper == nil {
+		panic("gasfree keeper dependency not set: nativedexKeeper")
+	}
+	if k.priceOracle == nil {
+		panic("gasfree keeper dependency not set: priceOracle")
+	}
+}
+
 // GetParamsIfSet will return the current params, but will return an error if the
 // chain is still initializing. By error checking this function is safe to use in
 // handling genesis transactions.
@@ -79,7 +155,12 @@ func createSet(strings []string) map[string]struct{} {
 
 // Checks if the given Tx contains only messages in the GasFreeMessageTypes set
 func (k Keeper) IsGasFreeTx(ctx sdk.Context, keeper Keeper, tx sdk.Tx) (bool, error) {
-	msgs := tx.GetMsgs()
+	return k.IsGasFreeMsgs(ctx, tx.GetMsgs())
+}
+
+// IsGasFreeMsgs checks if the given msgs are all in the GasFreeMessageTypes set, inspecting the inner
+// msgs of any authz MsgExec
+func (k Keeper) IsGasFreeMsgs(ctx sdk.Context, msgs []sdk.Msg) (bool, error) {
 	if len(msgs) == 0 {
 		return false, nil
 	}
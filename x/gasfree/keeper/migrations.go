@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v2 "github.com/AltheaFoundation/althea-L1/x/gasfree/migrations/v2"
+	v3 "github.com/AltheaFoundation/althea-L1/x/gasfree/migrations/v3"
+	v4 "github.com/AltheaFoundation/althea-L1/x/gasfree/migrations/v4"
+)
+
+// nolint: exhaustruct
+var _ module.MigrationHandler = Migrator{}.Migrate1to2
+
+// nolint: exhaustruct
+var _ module.MigrationHandler = Migrator{}.Migrate2to3
+
+// nolint: exhaustruct
+var _ module.MigrationHandler = Migrator{}.Migrate3to4
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.UpdateParams(ctx, &m.keeper.paramSpace)
+}
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.UpdateParams(ctx, &m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.UpdateParams(ctx, &m.keeper.paramSpace)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// RefreshOraclePrices stores the price oracle's native price of every alternative fee denom with a DEX pool and every
+// GasFreePriorityPrices denom. The AnteHandler then reads a single stored price per fee instead of computing a TWAP
+// for each tx, keeping its work bounded. A denom the oracle has no price for is left unpriced, so its static price
+// is used instead
+func (k Keeper) RefreshOraclePrices(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OraclePriceKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	var stale [][]byte
+	for ; iter.Valid(); iter.Next() {
+		stale = append(stale, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	if k.priceOracle == nil {
+		return
+	}
+	denoms := []string{}
+	for _, altDenom := range k.GetAlternativeFeeDenoms(ctx) {
+		if altDenom.DexPoolIdx != 0 {
+			denoms = append(denoms, altDenom.Denom)
+		}
+	}
+	for _, price := range k.GetGasFreePriorityPrices(ctx) {
+		denoms = append(denoms, price.Denom)
+	}
+	for _, denom := range denoms {
+		price, ok := k.priceOracle.GetNativePrice(ctx, denom)
+		if !ok || !price.IsPositive() {
+			continue
+		}
+		k.setOraclePrice(ctx, types.DenomPrice{Denom: denom, NativePrice: price})
+	}
+}
+
+// GetOraclePrice returns the native price of denom stored from the price oracle at the end of the last block, and
+// false if the oracle had no price for it
+func (k Keeper) GetOraclePrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOraclePriceKey(denom))
+	if len(bz) == 0 {
+		return sdk.Dec{}, false
+	}
+	var price types.DenomPrice
+	k.Cdc.MustUnmarshal(bz, &price)
+	return price.NativePrice, true
+}
+
+func (k Keeper) setOraclePrice(ctx sdk.Context, price types.DenomPrice) {
+	ctx.KVStore(k.storeKey).Set(types.GetOraclePriceKey(price.Denom), k.Cdc.MustMarshal(&price))
+}
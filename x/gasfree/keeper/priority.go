@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// GetGasFreePriorityPrices returns the governance-set prices used to value gasfree fees for tx priority.
+// If the param is not set yet (e.g. before the v3 migration), the default param value is used
+func (k Keeper) GetGasFreePriorityPrices(ctx sdk.Context) []types.DenomPrice {
+	prices := types.DefaultParams().GasFreePriorityPrices
+	k.paramSpace.GetIfExists(ctx, types.GasFreePriorityPricesKey, &prices)
+	return prices
+}
+
+func (k Keeper) SetGasFreePriorityPrices(ctx sdk.Context, prices []types.DenomPrice) {
+	k.paramSpace.Set(ctx, types.GasFreePriorityPricesKey, &prices)
+}
+
+// GetNativePrice returns the value of one base unit of denom in native token base units, preferring the
+// price oracle's price stored at the end of the last block and falling back to the GasFreePriorityPrices param.
+// Returns false if no price is known.
+func (k Keeper) GetNativePrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if denom == altheacfg.BaseDenom {
+		return sdk.OneDec(), true
+	}
+	if price, ok := k.GetOraclePrice(ctx, denom); ok {
+		return price, true
+	}
+	for _, price := range k.GetGasFreePriorityPrices(ctx) {
+		if price.Denom == denom {
+			return price.NativePrice, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// GetNativeValue converts the given coins into their total value in native token base units,
+// ignoring any coins without a known price
+func (k Keeper) GetNativeValue(ctx sdk.Context, coins sdk.Coins) sdk.Int {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		price, ok := k.GetNativePrice(ctx, coin.Denom)
+		if !ok {
+			continue
+		}
+		value = value.Add(price.MulInt(coin.Amount))
+	}
+	return value.TruncateInt()
+}
+
+// GetGasFreeTxPriority computes the mempool priority of a gasfree tx containing msgs which wants gasWanted gas.
+// Gasfree txs pay no tx fee, so the priority is the native value of the in-token fees charged on the msgs
+// divided by the gas wanted, which makes it comparable to the gas price priority given to regular Cosmos txs.
+func (k Keeper) GetGasFreeTxPriority(ctx sdk.Context, msgs []sdk.Msg, gasWanted uint64) (int64, error) {
+	fees, _, err := k.EstimateMsgFees(ctx, msgs)
+	if err != nil {
+		return 0, err
+	}
+	if gasWanted == 0 {
+		gasWanted = 1
+	}
+
+	priority := k.GetNativeValue(ctx, fees).Quo(sdk.NewIntFromUint64(gasWanted))
+	if !priority.IsInt64() {
+		return math.MaxInt64, nil
+	}
+	return priority.Int64(), nil
+}
@@ -0,0 +1,20 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+// UpdateParams sets the params introduced in consensus version 3 to their default values
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	defaults := types.DefaultParams()
+	paramstore.Set(ctx, types.GasFreePriorityPricesKey, defaults.GasFreePriorityPrices)
+	return nil
+}
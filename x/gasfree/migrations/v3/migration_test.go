@@ -0,0 +1,46 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	v3 "github.com/AltheaFoundation/althea-L1/x/gasfree/migrations/v3"
+	gasfreetypes "github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	gasfreeKey := sdk.NewKVStoreKey(gasfreetypes.StoreKey)
+	tGasfreeKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", gasfreetypes.StoreKey))
+	ctx := testutil.DefaultContext(gasfreeKey, tGasfreeKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, gasfreeKey, tGasfreeKey, "gasfree",
+	)
+	paramstore = paramstore.WithKeyTable(gasfreetypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, gasfreetypes.GasFreePriorityPricesKey))
+
+	// Run migrations
+	err := v3.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, gasfreetypes.GasFreePriorityPricesKey))
+
+	var prices []gasfreetypes.DenomPrice
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, gasfreetypes.GasFreePriorityPricesKey, &prices)
+	})
+	require.Empty(t, prices)
+}
@@ -0,0 +1,59 @@
+package gasfree_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/gasfree/types"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// TestOraclePrices checks that the price oracle's TWAP is stored at the end of the block and used to value alternative
+// fees, falling back to the static rate when the oracle has no price
+func (suite *GasfreeTestSuite) TestOraclePrices() {
+	suite.SetupTest()
+	ctx := suite.ctx
+	keeper := suite.app.GasfreeKeeper
+
+	denom := "ibc/oracle"
+	token := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	pair := erc20types.NewTokenPair(token, denom, true, erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(ctx, denom, pair.GetID())
+	suite.app.Erc20Keeper.SetERC20Map(ctx, token, pair.GetID())
+
+	altDenom := types.AlternativeFeeDenom{Denom: denom, StaticRate: sdk.NewDec(1), DexPoolIdx: 36000, SwapToNative: false, MaxSwapSlippageBasisPoints: 0}
+	keeper.SetAlternativeFeeDenoms(ctx, []types.AlternativeFeeDenom{altDenom})
+
+	// Without observations the oracle has no price, so the static rate applies
+	keeper.RefreshOraclePrices(ctx)
+	_, found := keeper.GetOraclePrice(ctx, denom)
+	suite.Require().False(found)
+	rate, err := keeper.GetAlternativeFeeRate(ctx, altDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(1), rate)
+
+	params := suite.app.NativedexKeeper.GetParams(ctx)
+	params.OraclePools = []nativedextypes.OraclePool{nativedextypes.NewOraclePool(common.Address{}, token, 36000)}
+	suite.app.NativedexKeeper.SetParams(ctx, params)
+	observation := nativedextypes.PriceObservation{Height: uint64(ctx.BlockHeight()), Time: ctx.BlockTime(), Price: sdk.NewDec(3)}
+	suite.app.NativedexKeeper.SetPriceObservation(ctx, common.Address{}, token, 36000, observation)
+
+	keeper.RefreshOraclePrices(ctx)
+	price, found := keeper.GetOraclePrice(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(3), price)
+	rate, err = keeper.GetAlternativeFeeRate(ctx, altDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3), rate)
+	price, found = keeper.GetNativePrice(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(3), price)
+
+	// The stored price is removed once the denom is no longer priced by the oracle
+	keeper.SetAlternativeFeeDenoms(ctx, []types.AlternativeFeeDenom{})
+	keeper.RefreshOraclePrices(ctx)
+	_, found = keeper.GetOraclePrice(ctx, denom)
+	suite.Require().False(found)
+}
@@ -7,5 +7,6 @@ import (
 const RootCodespace = "gasfree"
 
 var (
-	ErrInvalidParams = sdkerrors.Register(RootCodespace, 1, "invalid params")
+	ErrInvalidParams         = sdkerrors.Register(RootCodespace, 1, "invalid params")
+	ErrInvalidAlternativeFee = sdkerrors.Register(RootCodespace, 2, "invalid alternative fee")
 )
@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeAlternativeFeeSwap = "alternative-fee-swap"
+
+	AlternativeFeeSwapKeyAmountIn  = "amount_in"
+	AlternativeFeeSwapKeyAmountOut = "amount_out"
+
+	EventTypeAlternativeFeeSwapFailed = "alternative-fee-swap-failed"
+
+	AlternativeFeeSwapFailedKeyAmount = "amount"
+	AlternativeFeeSwapFailedKeyError  = "error"
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+)
+
+// MicrotxKeeper defines only the methods of the microtx module needed by gasfree.
+// Narrow methods avoid an import cycle with microtx/keeper, which depends on gasfree.
+type MicrotxKeeper interface {
+	// GetMicrotxFeeBasisPoints returns the fee basis points charged on MsgMicrotx
+	GetMicrotxFeeBasisPoints(ctx sdk.Context) (uint64, error)
+}
+
+// Erc20Keeper defines only the methods of the erc20 module needed by gasfree.
+// Narrow methods avoid an import cycle with erc20/keeper, which depends on gasfree.
+type Erc20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	ConvertCoin(goCtx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error)
+	ConvertERC20(goCtx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
+}
+
+// BankKeeper defines the methods of the bank module used to collect and forward alternative fees
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+}
+
+// NativedexKeeper defines the methods of the nativedex module used to swap alternative fee denoms.
+// Narrow methods avoid an import cycle, since nativedex is constructed after gasfree.
+type NativedexKeeper interface {
+	// SwapExactIn sells up to amountIn of one side of the (base, quote, poolIdx) pool and returns the amounts paid and
+	// received
+	SwapExactIn(
+		ctx sdk.Context, from common.Address, base, quote common.Address, poolIdx uint64, sellBase bool, amountIn, minOut *big.Int,
+	) (amountPaid, amountOut *big.Int, err error)
+}
+
+// PriceOracle provides market prices (e.g. a DEX TWAP) of the gasfree priority and alternative fee denoms in native
+// token value. The oracle is only consulted in EndBlock: its prices are stored for the fees of the next block to read
+// (see Keeper.GetOraclePrice), and the alternative fee swap uses it to bound slippage. Fees never query the oracle
+// directly, and fall back to the GasFreePriorityPrices param or a static rate when no price was stored.
+type PriceOracle interface {
+	// GetNativePrice returns the value of one base unit of denom in base units of the native token,
+	// and false if no price is available
+	GetNativePrice(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
@@ -0,0 +1,1177 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: althea/gasfree/v1/fees.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgTypeFees holds the cumulative fees collected from gasfree payment paths for a single message type
+type MsgTypeFees struct {
+	// msg_type_url is the type url of the message the fees were collected on, e.g. /microtx.v1.MsgMicrotx
+	MsgTypeUrl string                                   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Fees       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *MsgTypeFees) Reset()         { *m = MsgTypeFees{} }
+func (m *MsgTypeFees) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeFees) ProtoMessage()    {}
+func (*MsgTypeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eb19d9f3208a66, []int{0}
+}
+func (m *MsgTypeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeFees.Merge(m, src)
+}
+func (m *MsgTypeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeFees proto.InternalMessageInfo
+
+func (m *MsgTypeFees) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// EpochFees holds the cumulative fees collected from gasfree payment paths during a single epoch,
+// epochs are FeeAccountingEpochLength blocks long and epoch N starts at block N * FeeAccountingEpochLength
+type EpochFees struct {
+	Epoch uint64                                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Fees  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *EpochFees) Reset()         { *m = EpochFees{} }
+func (m *EpochFees) String() string { return proto.CompactTextString(m) }
+func (*EpochFees) ProtoMessage()    {}
+func (*EpochFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eb19d9f3208a66, []int{1}
+}
+func (m *EpochFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFees.Merge(m, src)
+}
+func (m *EpochFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFees proto.InternalMessageInfo
+
+func (m *EpochFees) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// DenomPrice is a governance-set price for a non-native denom, used to convert in-token gasfree fees to native
+// value when assigning mempool priority to gasfree txs
+type DenomPrice struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// native_price is the value of one base unit of denom in base units of the native token (aalthea)
+	NativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=native_price,json=nativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"native_price"`
+}
+
+func (m *DenomPrice) Reset()         { *m = DenomPrice{} }
+func (m *DenomPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomPrice) ProtoMessage()    {}
+func (*DenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eb19d9f3208a66, []int{2}
+}
+func (m *DenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPrice.Merge(m, src)
+}
+func (m *DenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPrice proto.InternalMessageInfo
+
+func (m *DenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AlternativeFeeDenom is a governance approved denom which may be used to pay regular Cosmos tx fees in place of the
+// native token, for example a stablecoin registered with the erc20 module or an IBC denom
+type AlternativeFeeDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// static_rate is the value of one base unit of denom in base units of the native token, used when dex_pool_idx is 0
+	StaticRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=static_rate,json=staticRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"static_rate"`
+	// dex_pool_idx when non-zero prices denom with the nativedex price oracle's TWAP of a pool pairing the native token
+	// with the ERC20 representation of denom, and is the pool index (template) fees are swapped through
+	DexPoolIdx uint64 `protobuf:"varint,3,opt,name=dex_pool_idx,json=dexPoolIdx,proto3" json:"dex_pool_idx,omitempty"`
+	// swap_to_native when true swaps the fees collected in denom to the native token through the dex_pool_idx pool
+	// at the end of each block, otherwise the fees are sent to the fee collector in denom and the distribution module
+	// pays them to validators, delegators, and the community pool in denom
+	SwapToNative bool `protobuf:"varint,4,opt,name=swap_to_native,json=swapToNative,proto3" json:"swap_to_native,omitempty"`
+	// max_swap_slippage_basis_points is the largest shortfall from the pool price tolerated when swapping to native
+	MaxSwapSlippageBasisPoints uint64 `protobuf:"varint,5,opt,name=max_swap_slippage_basis_points,json=maxSwapSlippageBasisPoints,proto3" json:"max_swap_slippage_basis_points,omitempty"`
+}
+
+func (m *AlternativeFeeDenom) Reset()         { *m = AlternativeFeeDenom{} }
+func (m *AlternativeFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*AlternativeFeeDenom) ProtoMessage()    {}
+func (*AlternativeFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64eb19d9f3208a66, []int{3}
+}
+func (m *AlternativeFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlternativeFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlternativeFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlternativeFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlternativeFeeDenom.Merge(m, src)
+}
+func (m *AlternativeFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlternativeFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlternativeFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlternativeFeeDenom proto.InternalMessageInfo
+
+func (m *AlternativeFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AlternativeFeeDenom) GetDexPoolIdx() uint64 {
+	if m != nil {
+		return m.DexPoolIdx
+	}
+	return 0
+}
+
+func (m *AlternativeFeeDenom) GetSwapToNative() bool {
+	if m != nil {
+		return m.SwapToNative
+	}
+	return false
+}
+
+func (m *AlternativeFeeDenom) GetMaxSwapSlippageBasisPoints() uint64 {
+	if m != nil {
+		return m.MaxSwapSlippageBasisPoints
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgTypeFees)(nil), "althea.gasfree.v1.MsgTypeFees")
+	proto.RegisterType((*EpochFees)(nil), "althea.gasfree.v1.EpochFees")
+	proto.RegisterType((*DenomPrice)(nil), "althea.gasfree.v1.DenomPrice")
+	proto.RegisterType((*AlternativeFeeDenom)(nil), "althea.gasfree.v1.AlternativeFeeDenom")
+}
+
+func init() { proto.RegisterFile("althea/gasfree/v1/fees.proto", fileDescriptor_64eb19d9f3208a66) }
+
+var fileDescriptor_64eb19d9f3208a66 = []byte{
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0x14, 0xd1, 0x4d, 0x84, 0x84, 0xe9, 0xc1, 0x44, 0xc8, 0x89, 0x22, 0x84, 0x72,
+	0xa9, 0x97, 0x80, 0xf8, 0x80, 0x86, 0x12, 0x09, 0x09, 0x68, 0x70, 0xcb, 0x85, 0xcb, 0x6a, 0x63,
+	0x4f, 0x9d, 0x15, 0xb6, 0x67, 0xe5, 0xdd, 0xa4, 0xee, 0x95, 0x13, 0xc7, 0x7e, 0x07, 0x5f, 0xd2,
+	0x63, 0x8f, 0x88, 0x43, 0x41, 0xc9, 0x8f, 0xa0, 0xdd, 0xb5, 0x10, 0x17, 0x24, 0x84, 0xd4, 0x93,
+	0x3d, 0xf3, 0x9e, 0xdf, 0x7b, 0x3b, 0xde, 0x21, 0x8f, 0x78, 0xae, 0x97, 0xc0, 0x69, 0xc6, 0xd5,
+	0x59, 0x05, 0x40, 0xd7, 0x13, 0x7a, 0x06, 0xa0, 0x22, 0x59, 0xa1, 0x46, 0xff, 0xbe, 0x43, 0xa3,
+	0x06, 0x8d, 0xd6, 0x93, 0xfe, 0x7e, 0x86, 0x19, 0x5a, 0x94, 0x9a, 0x37, 0x47, 0xec, 0x87, 0x09,
+	0xaa, 0x02, 0x15, 0x5d, 0x70, 0x65, 0x34, 0x16, 0xa0, 0xf9, 0x84, 0x26, 0x28, 0x4a, 0x87, 0x8f,
+	0x2e, 0x3d, 0xd2, 0x7d, 0xab, 0xb2, 0xd3, 0x0b, 0x09, 0x33, 0x00, 0xe5, 0x0f, 0x49, 0xaf, 0x50,
+	0x19, 0xd3, 0x17, 0x12, 0xd8, 0xaa, 0xca, 0x03, 0x6f, 0xe8, 0x8d, 0xf7, 0x62, 0x52, 0x38, 0xca,
+	0x87, 0x2a, 0xf7, 0x19, 0xe9, 0x98, 0x20, 0x41, 0x7b, 0xb8, 0x33, 0xee, 0x3e, 0x7b, 0x18, 0x39,
+	0x83, 0xc8, 0x18, 0x44, 0x8d, 0x41, 0xf4, 0x12, 0x45, 0x39, 0x7d, 0x7a, 0x75, 0x33, 0x68, 0x7d,
+	0xfd, 0x31, 0x18, 0x67, 0x42, 0x2f, 0x57, 0x8b, 0x28, 0xc1, 0x82, 0x36, 0x69, 0xdc, 0xe3, 0x40,
+	0xa5, 0x9f, 0xa8, 0x71, 0x52, 0xf6, 0x03, 0x15, 0x5b, 0xe1, 0xd1, 0x67, 0x8f, 0xec, 0xbd, 0x92,
+	0x98, 0x2c, 0x6d, 0xa0, 0x7d, 0xb2, 0x0b, 0xa6, 0xb0, 0x49, 0x3a, 0xb1, 0x2b, 0x6e, 0x3f, 0xc4,
+	0x8a, 0x90, 0x23, 0x28, 0xb1, 0x98, 0x57, 0x22, 0x01, 0x13, 0x22, 0x35, 0x55, 0x33, 0x0e, 0x57,
+	0xf8, 0xef, 0x49, 0xaf, 0xe4, 0x5a, 0xac, 0x81, 0x49, 0xc3, 0x0a, 0xda, 0x06, 0x9c, 0x46, 0xc6,
+	0xf1, 0xfb, 0xcd, 0xe0, 0xc9, 0x3f, 0x38, 0x1e, 0x41, 0x12, 0x77, 0x9d, 0x86, 0x35, 0x1a, 0x7d,
+	0x69, 0x93, 0x07, 0x87, 0xb9, 0x86, 0xca, 0x35, 0x67, 0x00, 0x36, 0xc5, 0x5f, 0x02, 0x1c, 0x93,
+	0xae, 0xd2, 0x5c, 0x8b, 0x84, 0x55, 0x5c, 0xff, 0xaf, 0x3f, 0x71, 0x12, 0x31, 0xd7, 0x60, 0xfe,
+	0x7e, 0x0a, 0x35, 0x93, 0x88, 0x39, 0x13, 0x69, 0x1d, 0xec, 0xd8, 0x99, 0x93, 0x14, 0xea, 0x39,
+	0x62, 0xfe, 0x3a, 0xad, 0xfd, 0xc7, 0xe4, 0x9e, 0x3a, 0xe7, 0x92, 0x69, 0x64, 0x2e, 0x62, 0xd0,
+	0x19, 0x7a, 0xe3, 0xbb, 0x71, 0xcf, 0x74, 0x4f, 0xf1, 0x9d, 0xed, 0xf9, 0x53, 0x12, 0x16, 0xbc,
+	0x66, 0x96, 0xa9, 0x72, 0x21, 0x25, 0xcf, 0x80, 0x2d, 0xb8, 0x12, 0x8a, 0x49, 0x14, 0xa5, 0x56,
+	0xc1, 0xae, 0x55, 0xee, 0x17, 0xbc, 0x3e, 0x39, 0xe7, 0xf2, 0xa4, 0xe1, 0x4c, 0x0d, 0x65, 0x6e,
+	0x19, 0xd3, 0xe3, 0xab, 0x4d, 0xe8, 0x5d, 0x6f, 0x42, 0xef, 0xe7, 0x26, 0xf4, 0x2e, 0xb7, 0x61,
+	0xeb, 0x7a, 0x1b, 0xb6, 0xbe, 0x6d, 0xc3, 0xd6, 0xc7, 0x17, 0x7f, 0x9c, 0xec, 0xd0, 0xee, 0xc1,
+	0x0c, 0x57, 0x65, 0xca, 0xb5, 0xc0, 0x92, 0xba, 0xc5, 0x38, 0x78, 0x33, 0xa1, 0xf5, 0xef, 0xdd,
+	0xb1, 0x87, 0x5d, 0xdc, 0xb1, 0x37, 0xfe, 0xf9, 0xaf, 0x01, 0x00, 0x7e, 0x65, 0x38, 0xa1, 0x5a,
+	0x03, 0x00, 0x00,
+}
+
+func (m *MsgTypeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NativePrice.Size()
+		i -= size
+		if _, err := m.NativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlternativeFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlternativeFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlternativeFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSwapSlippageBasisPoints != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.MaxSwapSlippageBasisPoints))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SwapToNative {
+		i--
+		if m.SwapToNative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DexPoolIdx != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.DexPoolIdx))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StaticRate.Size()
+		i -= size
+		if _, err := m.StaticRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTypeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovFees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovFees(uint64(m.Epoch))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovFees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	l = m.NativePrice.Size()
+	n += 1 + l + sovFees(uint64(l))
+	return n
+}
+
+func (m *AlternativeFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	l = m.StaticRate.Size()
+	n += 1 + l + sovFees(uint64(l))
+	if m.DexPoolIdx != 0 {
+		n += 1 + sovFees(uint64(m.DexPoolIdx))
+	}
+	if m.SwapToNative {
+		n += 2
+	}
+	if m.MaxSwapSlippageBasisPoints != 0 {
+		n += 1 + sovFees(uint64(m.MaxSwapSlippageBasisPoints))
+	}
+	return n
+}
+
+func sovFees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFees(x uint64) (n int) {
+	return sovFees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTypeFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlternativeFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlternativeFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlternativeFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexPoolIdx", wireType)
+			}
+			m.DexPoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DexPoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapToNative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapToNative = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapSlippageBasisPoints", wireType)
+			}
+			m.MaxSwapSlippageBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSwapSlippageBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFees(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFees
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFees
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFees
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFees        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFees          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFees = fmt.Errorf("proto: unexpected end of group")
+)
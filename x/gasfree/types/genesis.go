@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
 	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
 	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
 )
@@ -17,7 +18,10 @@ import (
 // DefaultGenesisState creates a simple GenesisState suitible for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:        DefaultParams(),
+		FeesByDenom:   sdk.Coins{},
+		FeesByMsgType: []MsgTypeFees{},
+		FeesByEpoch:   []EpochFees{},
 	}
 }
 
@@ -34,7 +38,10 @@ func DefaultParams() *Params {
 			sdk.MsgTypeURL(&erc20types.MsgSendERC20ToCosmosAndIBCTransfer{}),
 		},
 		GasFreeErc20InteropTokens:         []string{},
-		GasFreeErc20InteropFeeBasisPoints: 100, // 1%
+		GasFreeErc20InteropFeeBasisPoints: 100,   // 1%
+		FeeAccountingEpochLength:          14400, // roughly one day of blocks
+		GasFreePriorityPrices:             []DenomPrice{},
+		AlternativeFeeDenoms:              []AlternativeFeeDenom{},
 	}
 }
 
@@ -45,6 +52,41 @@ func (s GenesisState) ValidateBasic() error {
 	if err := ValidateGasFreeMessageTypes(s.Params.GasFreeMessageTypes); err != nil {
 		return errorsmod.Wrap(err, "Invalid GasFreeMessageTypes GenesisState")
 	}
+	if err := ValidateFeeAccountingEpochLength(s.Params.FeeAccountingEpochLength); err != nil {
+		return errorsmod.Wrap(err, "Invalid FeeAccountingEpochLength GenesisState")
+	}
+	if err := ValidateGasFreePriorityPrices(s.Params.GasFreePriorityPrices); err != nil {
+		return errorsmod.Wrap(err, "Invalid GasFreePriorityPrices GenesisState")
+	}
+	if err := ValidateAlternativeFeeDenoms(s.Params.AlternativeFeeDenoms); err != nil {
+		return errorsmod.Wrap(err, "Invalid AlternativeFeeDenoms GenesisState")
+	}
+	if err := s.FeesByDenom.Validate(); err != nil {
+		return errorsmod.Wrap(err, "Invalid FeesByDenom GenesisState")
+	}
+	seenMsgTypes := make(map[string]bool, len(s.FeesByMsgType))
+	for _, msgTypeFees := range s.FeesByMsgType {
+		if msgTypeFees.MsgTypeUrl == "" {
+			return fmt.Errorf("invalid FeesByMsgType GenesisState: empty msg type url")
+		}
+		if seenMsgTypes[msgTypeFees.MsgTypeUrl] {
+			return fmt.Errorf("invalid FeesByMsgType GenesisState: duplicate msg type url %s", msgTypeFees.MsgTypeUrl)
+		}
+		seenMsgTypes[msgTypeFees.MsgTypeUrl] = true
+		if err := msgTypeFees.Fees.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "Invalid FeesByMsgType GenesisState for %s", msgTypeFees.MsgTypeUrl)
+		}
+	}
+	seenEpochs := make(map[uint64]bool, len(s.FeesByEpoch))
+	for _, epochFees := range s.FeesByEpoch {
+		if seenEpochs[epochFees.Epoch] {
+			return fmt.Errorf("invalid FeesByEpoch GenesisState: duplicate epoch %d", epochFees.Epoch)
+		}
+		seenEpochs[epochFees.Epoch] = true
+		if err := epochFees.Fees.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "Invalid FeesByEpoch GenesisState for epoch %d", epochFees.Epoch)
+		}
+	}
 	return nil
 }
 
@@ -88,12 +130,84 @@ func ValidateGasFreeErc20InteropFeeBasisPoints(i interface{}) error {
 	return nil
 }
 
+func ValidateFeeAccountingEpochLength(i interface{}) error {
+	epochLength, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid fee accounting epoch length type (expect uint64): %T", i)
+	}
+	if epochLength == 0 {
+		return fmt.Errorf("fee accounting epoch length must be positive")
+	}
+	return nil
+}
+
+func ValidateGasFreePriorityPrices(i interface{}) error {
+	prices, ok := i.([]DenomPrice)
+	if !ok {
+		return fmt.Errorf("invalid gas free priority prices type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(prices))
+	for _, price := range prices {
+		if err := sdk.ValidateDenom(price.Denom); err != nil {
+			return errorsmod.Wrapf(err, "invalid gas free priority price denom %s", price.Denom)
+		}
+		if seen[price.Denom] {
+			return fmt.Errorf("duplicate gas free priority price for denom %s", price.Denom)
+		}
+		seen[price.Denom] = true
+		if price.NativePrice.IsNil() || !price.NativePrice.IsPositive() {
+			return fmt.Errorf("gas free priority price for denom %s must be positive", price.Denom)
+		}
+	}
+	return nil
+}
+
+func ValidateAlternativeFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]AlternativeFeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid alternative fee denoms type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return errorsmod.Wrapf(err, "invalid alternative fee denom %s", feeDenom.Denom)
+		}
+		if feeDenom.Denom == altheacfg.BaseDenom {
+			return fmt.Errorf("the native token %s cannot be an alternative fee denom", feeDenom.Denom)
+		}
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate alternative fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+
+		if feeDenom.DexPoolIdx == 0 {
+			if feeDenom.StaticRate.IsNil() || !feeDenom.StaticRate.IsPositive() {
+				return fmt.Errorf("alternative fee denom %s must have a positive static rate or a dex pool index", feeDenom.Denom)
+			}
+			if feeDenom.SwapToNative {
+				return fmt.Errorf("alternative fee denom %s must have a dex pool index to swap to native", feeDenom.Denom)
+			}
+		} else if !feeDenom.StaticRate.IsNil() && feeDenom.StaticRate.IsNegative() {
+			return fmt.Errorf("alternative fee denom %s static rate cannot be negative", feeDenom.Denom)
+		}
+		if feeDenom.MaxSwapSlippageBasisPoints > 10000 {
+			return fmt.Errorf("alternative fee denom %s max swap slippage cannot be greater than 10000 (100%%), got %d", feeDenom.Denom, feeDenom.MaxSwapSlippageBasisPoints)
+		}
+	}
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{
 		GasFreeMessageTypes:               []string{},
 		GasFreeErc20InteropTokens:         []string{},
 		GasFreeErc20InteropFeeBasisPoints: 100,
+		FeeAccountingEpochLength:          14400,
+		GasFreePriorityPrices:             []DenomPrice{},
+		AlternativeFeeDenoms:              []AlternativeFeeDenom{},
 	})
 }
 
@@ -102,5 +216,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(GasFreeMessageTypesKey, &p.GasFreeMessageTypes, ValidateGasFreeMessageTypes),
 		paramtypes.NewParamSetPair(GasFreeErc20InteropTokensKey, &p.GasFreeErc20InteropTokens, ValidateGasFreeErc20InteropTokens),
 		paramtypes.NewParamSetPair(GasFreeErc20InteropFeeBasisPointsKey, &p.GasFreeErc20InteropFeeBasisPoints, ValidateGasFreeErc20InteropFeeBasisPoints),
+		paramtypes.NewParamSetPair(FeeAccountingEpochLengthKey, &p.FeeAccountingEpochLength, ValidateFeeAccountingEpochLength),
+		paramtypes.NewParamSetPair(GasFreePriorityPricesKey, &p.GasFreePriorityPrices, ValidateGasFreePriorityPrices),
+		paramtypes.NewParamSetPair(AlternativeFeeDenomsKey, &p.AlternativeFeeDenoms, ValidateAlternativeFeeDenoms),
 	}
 }
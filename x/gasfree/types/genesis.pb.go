@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -38,6 +41,17 @@ type Params struct {
 	// fee of 1% for each gasfree erc20 transaction.
 	// The gasfree erc20 module messages are: MsgSendCoinToEVM, MsgSendERC20ToCosmos, and MsgSendERC20ToCosmosAndIBCTransfer
 	GasFreeErc20InteropFeeBasisPoints uint64 `protobuf:"varint,3,opt,name=gas_free_erc20_interop_fee_basis_points,json=gasFreeErc20InteropFeeBasisPoints,proto3" json:"gas_free_erc20_interop_fee_basis_points,omitempty"`
+	// The number of blocks in each fee accounting epoch, the fees collected by the
+	// gasfree payment paths are totalled per epoch for governance review
+	FeeAccountingEpochLength uint64 `protobuf:"varint,4,opt,name=fee_accounting_epoch_length,json=feeAccountingEpochLength,proto3" json:"fee_accounting_epoch_length,omitempty"`
+	// Prices used to convert the in-token fees paid by gasfree txs into native token value, the
+	// resulting value per unit of gas is used as the tx priority in the mempool. The native token
+	// does not need an entry, and fees paid in denoms without a price contribute no priority unless
+	// a price oracle (e.g. a DEX TWAP) has been configured for them
+	GasFreePriorityPrices []DenomPrice `protobuf:"bytes,5,rep,name=gas_free_priority_prices,json=gasFreePriorityPrices,proto3" json:"gas_free_priority_prices"`
+	// Denoms other than the native token which may be used to pay regular Cosmos and EIP-712 tx fees, the fee
+	// amount is converted to native value using each denom's rate before checking it against the min gas price
+	AlternativeFeeDenoms []AlternativeFeeDenom `protobuf:"bytes,6,rep,name=alternative_fee_denoms,json=alternativeFeeDenoms,proto3" json:"alternative_fee_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,8 +108,35 @@ func (m *Params) GetGasFreeErc20InteropFeeBasisPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeAccountingEpochLength() uint64 {
+	if m != nil {
+		return m.FeeAccountingEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetGasFreePriorityPrices() []DenomPrice {
+	if m != nil {
+		return m.GasFreePriorityPrices
+	}
+	return nil
+}
+
+func (m *Params) GetAlternativeFeeDenoms() []AlternativeFeeDenom {
+	if m != nil {
+		return m.AlternativeFeeDenoms
+	}
+	return nil
+}
+
 type GenesisState struct {
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// The cumulative fees collected by the gasfree payment paths, per denom
+	FeesByDenom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees_by_denom,json=feesByDenom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_by_denom"`
+	// The cumulative fees collected by the gasfree payment paths, per message type
+	FeesByMsgType []MsgTypeFees `protobuf:"bytes,3,rep,name=fees_by_msg_type,json=feesByMsgType,proto3" json:"fees_by_msg_type"`
+	// The cumulative fees collected by the gasfree payment paths, per epoch
+	FeesByEpoch []EpochFees `protobuf:"bytes,4,rep,name=fees_by_epoch,json=feesByEpoch,proto3" json:"fees_by_epoch"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -138,6 +179,27 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetFeesByDenom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesByDenom
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeesByMsgType() []MsgTypeFees {
+	if m != nil {
+		return m.FeesByMsgType
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeesByEpoch() []EpochFees {
+	if m != nil {
+		return m.FeesByEpoch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "althea.gasfree.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "althea.gasfree.v1.GenesisState")
@@ -146,27 +208,43 @@ func init() {
 func init() { proto.RegisterFile("althea/gasfree/v1/genesis.proto", fileDescriptor_1e21bc10ce13ce59) }
 
 var fileDescriptor_1e21bc10ce13ce59 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4b, 0x02, 0x41,
-	0x18, 0x86, 0x9d, 0x0c, 0xa1, 0xa9, 0x4b, 0x1b, 0xc4, 0x7a, 0x68, 0x33, 0x2f, 0x79, 0x69, 0xb7,
-	0x55, 0xba, 0xa7, 0x90, 0x11, 0x14, 0xc9, 0xe6, 0xa9, 0xcb, 0x30, 0xda, 0xe7, 0x38, 0x94, 0x33,
-	0xcb, 0x7c, 0xa3, 0xd4, 0xbf, 0xe8, 0x67, 0x75, 0x14, 0xba, 0x74, 0x0c, 0xfd, 0x23, 0xb1, 0x33,
-	0x8b, 0x97, 0xea, 0xfc, 0x3e, 0xef, 0x03, 0xdf, 0xfb, 0xd1, 0x63, 0xfe, 0x62, 0xa7, 0xc0, 0x13,
-	0xc1, 0x71, 0x62, 0x00, 0x92, 0x45, 0x9a, 0x08, 0x50, 0x80, 0x12, 0xe3, 0xdc, 0x68, 0xab, 0x83,
-	0x7d, 0x0f, 0xc4, 0x25, 0x10, 0x2f, 0xd2, 0xe6, 0x27, 0xa1, 0xb5, 0x01, 0x37, 0x7c, 0x86, 0x41,
-	0x87, 0x1e, 0x0a, 0x8e, 0xac, 0x48, 0xd8, 0x0c, 0x10, 0xb9, 0x00, 0x66, 0xdf, 0x72, 0xc0, 0x90,
-	0x34, 0xaa, 0xad, 0x9d, 0xec, 0x40, 0x70, 0xec, 0x1b, 0x80, 0x3b, 0x9f, 0x0d, 0x8b, 0x28, 0xb8,
-	0xa4, 0x47, 0x9b, 0x12, 0x98, 0x71, 0xfb, 0x9c, 0x49, 0x65, 0xc1, 0xe8, 0x9c, 0x59, 0xfd, 0x0c,
-	0x0a, 0xc3, 0x2d, 0xd7, 0xad, 0x97, 0xdd, 0xab, 0x02, 0xb9, 0xf1, 0xc4, 0xd0, 0x01, 0x41, 0x46,
-	0x4f, 0xff, 0x31, 0x4c, 0x00, 0xd8, 0x88, 0xa3, 0x44, 0x96, 0x6b, 0xa9, 0x2c, 0x86, 0xd5, 0x06,
-	0x69, 0x6d, 0x67, 0x27, 0x7f, 0xb8, 0xfa, 0x00, 0xbd, 0x82, 0x1c, 0x38, 0xb0, 0xd9, 0xa5, 0x7b,
-	0xd7, 0xfe, 0xf2, 0x07, 0xcb, 0x2d, 0x04, 0x29, 0xad, 0xe5, 0xee, 0xc8, 0x90, 0x34, 0x48, 0x6b,
-	0xb7, 0x5d, 0x8f, 0x7f, 0x2d, 0x11, 0xfb, 0x15, 0xb2, 0x12, 0xec, 0xdd, 0x7f, 0xac, 0x22, 0xb2,
-	0x5c, 0x45, 0xe4, 0x7b, 0x15, 0x91, 0xf7, 0x75, 0x54, 0x59, 0xae, 0xa3, 0xca, 0xd7, 0x3a, 0xaa,
-	0x3c, 0x5e, 0x08, 0x69, 0xa7, 0xf3, 0x51, 0x3c, 0xd6, 0xb3, 0xa4, 0xeb, 0x34, 0x7d, 0x3d, 0x57,
-	0x4f, 0xdc, 0x4a, 0xad, 0x12, 0xef, 0x3d, 0xbb, 0x4d, 0x93, 0xd7, 0xcd, 0x1f, 0xdc, 0x88, 0xa3,
-	0x9a, 0xfb, 0x41, 0xe7, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x83, 0x40, 0xb6, 0xa6, 0x01, 0x00,
-	0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0xd2, 0xda, 0xc4, 0x41, 0x12, 0x5d, 0x91, 0x2c, 0x08, 0x4b, 0xe5, 0xa0, 0xbd, 0xb0,
+	0x4b, 0x21, 0x1e, 0x4d, 0xa4, 0x4a, 0x8d, 0x09, 0xc4, 0xa6, 0x72, 0x32, 0x26, 0x9b, 0xd9, 0xe5,
+	0x75, 0x3a, 0x81, 0xce, 0x6c, 0xf6, 0x0d, 0xc4, 0x7e, 0x0b, 0x3f, 0x87, 0x9f, 0x04, 0x6f, 0x1c,
+	0x3c, 0x78, 0x52, 0x03, 0x5f, 0xc4, 0xcc, 0x9b, 0xb1, 0x90, 0xb4, 0x9e, 0x3a, 0xe9, 0xfb, 0xfd,
+	0x9b, 0x7d, 0xbf, 0x61, 0x9b, 0xfc, 0xcc, 0x8c, 0x80, 0xa7, 0x82, 0xe3, 0xb0, 0x02, 0x48, 0x2f,
+	0x3a, 0xa9, 0x00, 0x05, 0x28, 0x31, 0x29, 0x2b, 0x6d, 0x74, 0xf8, 0xc8, 0x01, 0x12, 0x0f, 0x48,
+	0x2e, 0x3a, 0x6b, 0xcb, 0x42, 0x0b, 0x4d, 0xd3, 0xd4, 0x9e, 0x1c, 0x70, 0x2d, 0x2e, 0x34, 0x8e,
+	0x35, 0xa6, 0x39, 0x47, 0x2b, 0x93, 0x83, 0xe1, 0x9d, 0xb4, 0xd0, 0x52, 0xf9, 0xf9, 0xfa, 0xac,
+	0xd3, 0x10, 0xc0, 0xdb, 0x6c, 0xfd, 0xa8, 0xb3, 0x66, 0x9f, 0x57, 0x7c, 0x8c, 0xe1, 0x1e, 0x5b,
+	0x11, 0x1c, 0x33, 0x0b, 0xca, 0xc6, 0x80, 0xc8, 0x05, 0x64, 0x66, 0x52, 0x02, 0x46, 0x41, 0xab,
+	0xde, 0xbe, 0x3f, 0x78, 0x2c, 0x38, 0xf6, 0x2a, 0x80, 0x23, 0x37, 0x3b, 0xb6, 0xa3, 0xf0, 0x35,
+	0xdb, 0x98, 0x92, 0xa0, 0x2a, 0x76, 0x77, 0x32, 0xa9, 0x0c, 0x54, 0xba, 0xcc, 0x8c, 0x3e, 0x05,
+	0x85, 0xd1, 0x02, 0x71, 0x57, 0x3d, 0xf7, 0xc0, 0x42, 0xde, 0x3b, 0xc4, 0x31, 0x01, 0xc2, 0x01,
+	0x7b, 0xf1, 0x1f, 0x85, 0x21, 0x40, 0x96, 0x73, 0x94, 0x98, 0x95, 0x5a, 0x2a, 0x83, 0x51, 0xbd,
+	0x15, 0xb4, 0x1b, 0x83, 0x67, 0x73, 0xb4, 0x7a, 0x00, 0x5d, 0x8b, 0xec, 0x13, 0x30, 0x7c, 0xc5,
+	0x9e, 0x5a, 0x32, 0x2f, 0x0a, 0x7d, 0xae, 0x8c, 0x54, 0x22, 0x83, 0x52, 0x17, 0xa3, 0xec, 0x0c,
+	0x94, 0x30, 0xa3, 0xa8, 0x41, 0x3a, 0xd1, 0x10, 0x60, 0x7f, 0x8a, 0x38, 0xb0, 0x80, 0x43, 0x9a,
+	0x87, 0x9f, 0x59, 0x34, 0x8d, 0x54, 0x56, 0x52, 0x57, 0xd2, 0x4c, 0xec, 0xa1, 0x00, 0x8c, 0xee,
+	0xb5, 0xea, 0xed, 0xc5, 0xdd, 0x8d, 0x64, 0x66, 0x3d, 0xc9, 0x5b, 0x50, 0x7a, 0xdc, 0xb7, 0xa8,
+	0x6e, 0xe3, 0xf2, 0xd7, 0x66, 0x6d, 0xf0, 0xc4, 0x07, 0xed, 0x7b, 0x09, 0x9a, 0x61, 0x98, 0xb3,
+	0x15, 0x7e, 0x66, 0xa0, 0x52, 0xdc, 0xc8, 0x0b, 0xa0, 0x5b, 0x9e, 0x58, 0x2a, 0x46, 0x4d, 0xd2,
+	0x7e, 0x3e, 0x47, 0x7b, 0xff, 0x96, 0xd0, 0x03, 0x20, 0x27, 0x6f, 0xb2, 0xcc, 0x67, 0x47, 0xb8,
+	0xf5, 0x7d, 0x81, 0x3d, 0x78, 0xe7, 0xfa, 0xf4, 0xd1, 0x70, 0x03, 0x61, 0x87, 0x35, 0x4b, 0x5a,
+	0x73, 0x14, 0xb4, 0x82, 0xf6, 0xe2, 0xee, 0xea, 0x1c, 0x13, 0xd7, 0x83, 0x81, 0x07, 0x86, 0x9a,
+	0x2d, 0x0d, 0x01, 0x30, 0xcb, 0x27, 0x2e, 0x1f, 0xad, 0xd2, 0x32, 0x5d, 0xe1, 0x12, 0x5b, 0xb8,
+	0xc4, 0x17, 0x2e, 0x79, 0xa3, 0xa5, 0xea, 0xee, 0xd8, 0x44, 0xdf, 0x7e, 0x6f, 0xb6, 0x85, 0x34,
+	0xa3, 0xf3, 0x3c, 0x29, 0xf4, 0x38, 0xf5, 0xed, 0x74, 0x3f, 0xdb, 0x78, 0x72, 0x9a, 0x52, 0xa7,
+	0x88, 0x80, 0x83, 0x45, 0xeb, 0xd0, 0x9d, 0x50, 0xea, 0xf0, 0x88, 0x3d, 0xfc, 0x67, 0x38, 0x46,
+	0x41, 0xdd, 0x8b, 0xea, 0xe4, 0x19, 0xcf, 0x49, 0x7b, 0x84, 0xc2, 0x56, 0xb0, 0x67, 0x05, 0xdc,
+	0xa7, 0x58, 0x72, 0x62, 0x7e, 0x10, 0xf6, 0x6e, 0xf3, 0xd3, 0xf6, 0xa3, 0x06, 0x69, 0xad, 0xcf,
+	0xd1, 0xa2, 0xe5, 0xdf, 0x51, 0xf2, 0xb1, 0xe8, 0xef, 0xee, 0x87, 0xcb, 0xeb, 0x38, 0xb8, 0xba,
+	0x8e, 0x83, 0x3f, 0xd7, 0x71, 0xf0, 0xf5, 0x26, 0xae, 0x5d, 0xdd, 0xc4, 0xb5, 0x9f, 0x37, 0x71,
+	0xed, 0xd3, 0xcb, 0x3b, 0xf7, 0xdc, 0x27, 0xd1, 0x9e, 0x3e, 0x57, 0x27, 0xdc, 0x48, 0xad, 0x52,
+	0xe7, 0xb2, 0x7d, 0xd8, 0x49, 0xbf, 0x4c, 0xdf, 0x1e, 0x5d, 0x3d, 0x6f, 0xd2, 0xd3, 0xdb, 0xfb,
+	0x3b, 0x00, 0x49, 0xeb, 0x89, 0x5e, 0x04, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -189,6 +267,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AlternativeFeeDenoms) > 0 {
+		for iNdEx := len(m.AlternativeFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlternativeFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GasFreePriorityPrices) > 0 {
+		for iNdEx := len(m.GasFreePriorityPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasFreePriorityPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.FeeAccountingEpochLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeAccountingEpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.GasFreeErc20InteropFeeBasisPoints != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.GasFreeErc20InteropFeeBasisPoints))
 		i--
@@ -235,6 +346,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeesByEpoch) > 0 {
+		for iNdEx := len(m.FeesByEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeesByMsgType) > 0 {
+		for iNdEx := len(m.FeesByMsgType) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesByMsgType[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeesByDenom) > 0 {
+		for iNdEx := len(m.FeesByDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesByDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -282,6 +435,21 @@ func (m *Params) Size() (n int) {
 	if m.GasFreeErc20InteropFeeBasisPoints != 0 {
 		n += 1 + sovGenesis(uint64(m.GasFreeErc20InteropFeeBasisPoints))
 	}
+	if m.FeeAccountingEpochLength != 0 {
+		n += 1 + sovGenesis(uint64(m.FeeAccountingEpochLength))
+	}
+	if len(m.GasFreePriorityPrices) > 0 {
+		for _, e := range m.GasFreePriorityPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AlternativeFeeDenoms) > 0 {
+		for _, e := range m.AlternativeFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -295,6 +463,24 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.FeesByDenom) > 0 {
+		for _, e := range m.FeesByDenom {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeesByMsgType) > 0 {
+		for _, e := range m.FeesByMsgType {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeesByEpoch) > 0 {
+		for _, e := range m.FeesByEpoch {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +602,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccountingEpochLength", wireType)
+			}
+			m.FeeAccountingEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAccountingEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFreePriorityPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFreePriorityPrices = append(m.GasFreePriorityPrices, DenomPrice{})
+			if err := m.GasFreePriorityPrices[len(m.GasFreePriorityPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternativeFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternativeFeeDenoms = append(m.AlternativeFeeDenoms, AlternativeFeeDenom{})
+			if err := m.AlternativeFeeDenoms[len(m.AlternativeFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -502,6 +775,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesByDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesByDenom = append(m.FeesByDenom, types.Coin{})
+			if err := m.FeesByDenom[len(m.FeesByDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesByMsgType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesByMsgType = append(m.FeesByMsgType, MsgTypeFees{})
+			if err := m.FeesByMsgType[len(m.FeesByMsgType)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesByEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesByEpoch = append(m.FeesByEpoch, EpochFees{})
+			if err := m.FeesByEpoch[len(m.FeesByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
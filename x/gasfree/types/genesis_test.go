@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestValidation(t *testing.T) {
@@ -24,3 +26,47 @@ func TestValidation(t *testing.T) {
 	err = badGenesis.ValidateBasic()
 	assert.NotNil(t, err, "badGenesis did not produce an error in validation fn")
 }
+
+func TestValidateGasFreePriorityPrices(t *testing.T) {
+	err := ValidateGasFreePriorityPrices([]DenomPrice{{Denom: "ibc/test", NativePrice: sdk.NewDecWithPrec(5, 1)}})
+	assert.Nil(t, err, "error produced from valid priority prices %v", err)
+
+	err = ValidateGasFreePriorityPrices([]DenomPrice{{Denom: "ibc/test", NativePrice: sdk.ZeroDec()}})
+	assert.NotNil(t, err, "zero priority price did not produce an error")
+
+	err = ValidateGasFreePriorityPrices([]DenomPrice{{Denom: "ibc/test", NativePrice: sdk.OneDec()}, {Denom: "ibc/test", NativePrice: sdk.OneDec()}})
+	assert.NotNil(t, err, "duplicate priority price did not produce an error")
+
+	err = ValidateGasFreePriorityPrices([]DenomPrice{{Denom: "", NativePrice: sdk.OneDec()}})
+	assert.NotNil(t, err, "empty priority price denom did not produce an error")
+}
+
+func TestValidateAlternativeFeeDenoms(t *testing.T) {
+	staticDenom := AlternativeFeeDenom{Denom: "ibc/test", StaticRate: sdk.NewDecWithPrec(5, 1), DexPoolIdx: 0, SwapToNative: false, MaxSwapSlippageBasisPoints: 0}
+	poolDenom := AlternativeFeeDenom{Denom: "ibc/pool", StaticRate: sdk.ZeroDec(), DexPoolIdx: 36000, SwapToNative: true, MaxSwapSlippageBasisPoints: 100}
+	err := ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{staticDenom, poolDenom})
+	assert.Nil(t, err, "error produced from valid alternative fee denoms %v", err)
+
+	err = ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{staticDenom, staticDenom})
+	assert.NotNil(t, err, "duplicate alternative fee denom did not produce an error")
+
+	native := staticDenom
+	native.Denom = "aalthea"
+	err = ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{native})
+	assert.NotNil(t, err, "native alternative fee denom did not produce an error")
+
+	noRate := staticDenom
+	noRate.StaticRate = sdk.ZeroDec()
+	err = ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{noRate})
+	assert.NotNil(t, err, "alternative fee denom without a rate or pool did not produce an error")
+
+	swapWithoutPool := staticDenom
+	swapWithoutPool.SwapToNative = true
+	err = ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{swapWithoutPool})
+	assert.NotNil(t, err, "swapping alternative fee denom without a pool did not produce an error")
+
+	badSlippage := poolDenom
+	badSlippage.MaxSwapSlippageBasisPoints = 10001
+	err = ValidateAlternativeFeeDenoms([]AlternativeFeeDenom{badSlippage})
+	assert.NotNil(t, err, "excessive alternative fee slippage did not produce an error")
+}
@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,212 +116,2299 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "althea.gasfree.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "althea.gasfree.v1.QueryParamsResponse")
+// QueryEstimateTxFeesRequest is the request type for the Query/EstimateTxFees RPC method.
+// Either tx_bytes or msgs must be provided, if tx_bytes is set then msgs is ignored
+type QueryEstimateTxFeesRequest struct {
+	// tx_bytes is a protobuf encoded tx, exactly as it would be broadcast
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// msgs are the messages of an unsigned tx, used when tx_bytes is empty
+	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func init() { proto.RegisterFile("althea/gasfree/v1/query.proto", fileDescriptor_7725dca9511d36d5) }
-
-var fileDescriptor_7725dca9511d36d5 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xcc, 0x29, 0xc9,
-	0x48, 0x4d, 0xd4, 0x4f, 0x4f, 0x2c, 0x4e, 0x2b, 0x4a, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x48, 0xeb, 0x41, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5,
-	0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x21, 0x1a, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x8f, 0x69, 0x4b, 0x7a, 0x6a, 0x5e,
-	0x6a, 0x71, 0x26, 0x54, 0x9b, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xda, 0x80, 0xc4, 0xa2, 0xc4,
-	0xdc, 0xe2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x3f, 0x2e, 0x61, 0x14, 0xd1, 0xe2,
-	0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x73, 0x2e, 0xb6, 0x02, 0xb0, 0x88, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0xb7, 0x91, 0xa4, 0x1e, 0x86, 0x2b, 0xf5, 0x20, 0x5a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67,
-	0x08, 0x82, 0x2a, 0x37, 0x6a, 0x66, 0xe4, 0x62, 0x05, 0x1b, 0x28, 0x54, 0xc5, 0xc5, 0x06, 0x51,
-	0x21, 0xa4, 0x8a, 0x45, 0x33, 0xa6, 0x53, 0xa4, 0xd4, 0x08, 0x29, 0x83, 0xb8, 0x4d, 0x49, 0xb1,
-	0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0xd2, 0x42, 0x92, 0xfa, 0x98, 0x5e, 0x86, 0xb8, 0xc2, 0xc9, 0xff,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x1d, 0xc1, 0xda, 0xdd, 0xf2, 0x4b, 0xf3, 0x52, 0xc0, 0x01,
-	0x0c, 0x35, 0x4f, 0xd7, 0xc7, 0x50, 0xbf, 0x02, 0x6e, 0x68, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0x38, 0x0c, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xa1, 0xc9, 0xec, 0xcc, 0x01,
-	0x00, 0x00,
+func (m *QueryEstimateTxFeesRequest) Reset()         { *m = QueryEstimateTxFeesRequest{} }
+func (m *QueryEstimateTxFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTxFeesRequest) ProtoMessage()    {}
+func (*QueryEstimateTxFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{2}
+}
+func (m *QueryEstimateTxFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTxFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTxFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesRequest.Merge(m, src)
+}
+func (m *QueryEstimateTxFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTxFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryEstimateTxFeesRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryEstimateTxFeesRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params retrieves the total set of onboarding parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryEstimateTxFeesRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryEstimateTxFeesResponse is the response type for the Query/EstimateTxFees RPC method.
+type QueryEstimateTxFeesResponse struct {
+	// gas_free is true when the tx contains only GasFreeMessageTypes, in which case
+	// the usual min gas price and fee deduction checks are bypassed
+	GasFree bool `protobuf:"varint,1,opt,name=gas_free,json=gasFree,proto3" json:"gas_free,omitempty"`
+	// total_fees is the sum of all fees which will be charged for the tx, per denom
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+	// tx_fee is the fee declared in the tx's auth info, which is only charged when
+	// the tx is not gas free. It is always empty when msgs were provided instead of tx_bytes
+	TxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tx_fee,json=txFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tx_fee"`
+	// msg_fees is the breakdown of fees charged on each top-level message
+	MsgFees []MsgFeeEstimate `protobuf:"bytes,4,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEstimateTxFeesResponse) Reset()         { *m = QueryEstimateTxFeesResponse{} }
+func (m *QueryEstimateTxFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTxFeesResponse) ProtoMessage()    {}
+func (*QueryEstimateTxFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{3}
+}
+func (m *QueryEstimateTxFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTxFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTxFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTxFeesResponse.Merge(m, src)
+}
+func (m *QueryEstimateTxFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTxFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTxFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimateTxFeesResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateTxFeesResponse) GetGasFree() bool {
+	if m != nil {
+		return m.GasFree
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params retrieves the total set of onboarding parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryEstimateTxFeesResponse) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEstimateTxFeesResponse) GetTxFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TxFee
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryEstimateTxFeesResponse) GetMsgFees() []MsgFeeEstimate {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// MsgFeeEstimate holds the in-token fees charged for a single message
+type MsgFeeEstimate struct {
+	// msg_type_url is the type url of the message, e.g. /microtx.v1.MsgMicrotx
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_free is true if the message type is one of the GasFreeMessageTypes
+	GasFree bool `protobuf:"varint,2,opt,name=gas_free,json=gasFree,proto3" json:"gas_free,omitempty"`
+	// fees are the in-token fees charged on the message, for an authz MsgExec
+	// these are the combined fees of the inner messages
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgFeeEstimate) Reset()         { *m = MsgFeeEstimate{} }
+func (m *MsgFeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeEstimate) ProtoMessage()    {}
+func (*MsgFeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{4}
+}
+func (m *MsgFeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *MsgFeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeEstimate.Merge(m, src)
+}
+func (m *MsgFeeEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeEstimate proto.InternalMessageInfo
+
+func (m *MsgFeeEstimate) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/althea.gasfree.v1.Query/Params",
+	return ""
+}
+
+func (m *MsgFeeEstimate) GetGasFree() bool {
+	if m != nil {
+		return m.GasFree
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return false
+}
+
+func (m *MsgFeeEstimate) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "althea.gasfree.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "althea/gasfree/v1/query.proto",
+// QueryFeesCollectedRequest is the request type for the Query/FeesCollected RPC method.
+type QueryFeesCollectedRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeesCollectedRequest) Reset()         { *m = QueryFeesCollectedRequest{} }
+func (m *QueryFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{5}
+}
+func (m *QueryFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeesCollectedRequest proto.InternalMessageInfo
+
+// QueryFeesCollectedResponse is the response type for the Query/FeesCollected RPC method.
+type QueryFeesCollectedResponse struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeesCollectedResponse) Reset()         { *m = QueryFeesCollectedResponse{} }
+func (m *QueryFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{6}
+}
+func (m *QueryFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryFeesCollectedResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeesCollectedByMsgTypeRequest is the request type for the Query/FeesCollectedByMsgType RPC method.
+type QueryFeesCollectedByMsgTypeRequest struct {
+	// msg_type_url optionally restricts the response to a single message type
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeesCollectedByMsgTypeRequest) Reset()         { *m = QueryFeesCollectedByMsgTypeRequest{} }
+func (m *QueryFeesCollectedByMsgTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedByMsgTypeRequest) ProtoMessage()    {}
+func (*QueryFeesCollectedByMsgTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{7}
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedByMsgTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedByMsgTypeRequest.Merge(m, src)
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedByMsgTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeesCollectedByMsgTypeRequest proto.InternalMessageInfo
+
+func (m *QueryFeesCollectedByMsgTypeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryFeesCollectedByMsgTypeResponse is the response type for the Query/FeesCollectedByMsgType RPC method.
+type QueryFeesCollectedByMsgTypeResponse struct {
+	Fees []MsgTypeFees `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *QueryFeesCollectedByMsgTypeResponse) Reset()         { *m = QueryFeesCollectedByMsgTypeResponse{} }
+func (m *QueryFeesCollectedByMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedByMsgTypeResponse) ProtoMessage()    {}
+func (*QueryFeesCollectedByMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{8}
+}
+func (m *QueryFeesCollectedByMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedByMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedByMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeesCollectedByMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedByMsgTypeResponse.Merge(m, src)
+}
+func (m *QueryFeesCollectedByMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedByMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedByMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeesCollectedByMsgTypeResponse proto.InternalMessageInfo
+
+func (m *QueryFeesCollectedByMsgTypeResponse) GetFees() []MsgTypeFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// QueryFeesCollectedByEpochRequest is the request type for the Query/FeesCollectedByEpoch RPC method.
+type QueryFeesCollectedByEpochRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeesCollectedByEpochRequest) Reset()         { *m = QueryFeesCollectedByEpochRequest{} }
+func (m *QueryFeesCollectedByEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedByEpochRequest) ProtoMessage()    {}
+func (*QueryFeesCollectedByEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{9}
+}
+func (m *QueryFeesCollectedByEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedByEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedByEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeesCollectedByEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedByEpochRequest.Merge(m, src)
+}
+func (m *QueryFeesCollectedByEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedByEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedByEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeesCollectedByEpochRequest proto.InternalMessageInfo
+
+func (m *QueryFeesCollectedByEpochRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeesCollectedByEpochResponse is the response type for the Query/FeesCollectedByEpoch RPC method.
+type QueryFeesCollectedByEpochResponse struct {
+	// current_epoch is the epoch containing the latest block
+	CurrentEpoch uint64      `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	Fees         []EpochFees `protobuf:"bytes,2,rep,name=fees,proto3" json:"fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeesCollectedByEpochResponse) Reset()         { *m = QueryFeesCollectedByEpochResponse{} }
+func (m *QueryFeesCollectedByEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeesCollectedByEpochResponse) ProtoMessage()    {}
+func (*QueryFeesCollectedByEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7725dca9511d36d5, []int{10}
+}
+func (m *QueryFeesCollectedByEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeesCollectedByEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeesCollectedByEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeesCollectedByEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeesCollectedByEpochResponse.Merge(m, src)
+}
+func (m *QueryFeesCollectedByEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeesCollectedByEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeesCollectedByEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeesCollectedByEpochResponse proto.InternalMessageInfo
+
+func (m *QueryFeesCollectedByEpochResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *QueryFeesCollectedByEpochResponse) GetFees() []EpochFees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *QueryFeesCollectedByEpochResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "althea.gasfree.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "althea.gasfree.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEstimateTxFeesRequest)(nil), "althea.gasfree.v1.QueryEstimateTxFeesRequest")
+	proto.RegisterType((*QueryEstimateTxFeesResponse)(nil), "althea.gasfree.v1.QueryEstimateTxFeesResponse")
+	proto.RegisterType((*MsgFeeEstimate)(nil), "althea.gasfree.v1.MsgFeeEstimate")
+	proto.RegisterType((*QueryFeesCollectedRequest)(nil), "althea.gasfree.v1.QueryFeesCollectedRequest")
+	proto.RegisterType((*QueryFeesCollectedResponse)(nil), "althea.gasfree.v1.QueryFeesCollectedResponse")
+	proto.RegisterType((*QueryFeesCollectedByMsgTypeRequest)(nil), "althea.gasfree.v1.QueryFeesCollectedByMsgTypeRequest")
+	proto.RegisterType((*QueryFeesCollectedByMsgTypeResponse)(nil), "althea.gasfree.v1.QueryFeesCollectedByMsgTypeResponse")
+	proto.RegisterType((*QueryFeesCollectedByEpochRequest)(nil), "althea.gasfree.v1.QueryFeesCollectedByEpochRequest")
+	proto.RegisterType((*QueryFeesCollectedByEpochResponse)(nil), "althea.gasfree.v1.QueryFeesCollectedByEpochResponse")
+}
+
+func init() { proto.RegisterFile("althea/gasfree/v1/query.proto", fileDescriptor_7725dca9511d36d5) }
+
+var fileDescriptor_7725dca9511d36d5 = []byte{
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0xae, 0xe3, 0xbe, 0xa6, 0x95, 0x18, 0x2c, 0x14, 0x6f, 0xc2, 0xc6, 0x5e, 0x8b,
+	0x62, 0x02, 0xde, 0xad, 0x13, 0x5a, 0x10, 0xb7, 0xba, 0xaa, 0xb9, 0x50, 0x28, 0xab, 0x72, 0xe1,
+	0xb2, 0x1a, 0x3b, 0x93, 0xcd, 0x16, 0xef, 0xce, 0x76, 0x67, 0x5c, 0xd9, 0x48, 0x5c, 0xf8, 0x04,
+	0x48, 0x1c, 0x38, 0x72, 0xe7, 0xc0, 0x05, 0x8e, 0x7c, 0x80, 0x22, 0x71, 0xa8, 0xc4, 0x85, 0x13,
+	0xa0, 0x84, 0x0f, 0x82, 0xe6, 0xcf, 0x06, 0x6f, 0xbc, 0x89, 0x1d, 0x89, 0x9c, 0xb2, 0x3b, 0xf3,
+	0xde, 0xfb, 0xfd, 0x79, 0xfb, 0x5e, 0x0c, 0xaf, 0xe3, 0x31, 0x3f, 0x22, 0xd8, 0x0d, 0x30, 0x3b,
+	0x4c, 0x09, 0x71, 0x9f, 0xf7, 0xdc, 0x67, 0x13, 0x92, 0xce, 0x9c, 0x24, 0xa5, 0x9c, 0xa2, 0x57,
+	0xd4, 0xb5, 0xa3, 0xaf, 0x9d, 0xe7, 0x3d, 0x73, 0x3b, 0xa0, 0x34, 0x18, 0x13, 0x17, 0x27, 0xa1,
+	0x8b, 0xe3, 0x98, 0x72, 0xcc, 0x43, 0x1a, 0x33, 0x95, 0x60, 0x36, 0xf4, 0xad, 0x7c, 0x1b, 0x4e,
+	0x0e, 0x5d, 0x1c, 0xeb, 0x5a, 0x66, 0x3d, 0xa0, 0x01, 0x95, 0x8f, 0xae, 0x78, 0xd2, 0xa7, 0xd6,
+	0x88, 0xb2, 0x88, 0x32, 0x77, 0x88, 0x99, 0x40, 0x1f, 0x12, 0x8e, 0x7b, 0xee, 0x88, 0x86, 0xb1,
+	0xbe, 0xdf, 0x9d, 0xbf, 0x97, 0xd4, 0x4e, 0xa3, 0x12, 0x1c, 0x84, 0xb1, 0x44, 0xd7, 0xb1, 0x3b,
+	0x8b, 0x62, 0x02, 0x12, 0x13, 0x16, 0x66, 0xec, 0xb6, 0x17, 0x03, 0x0e, 0x09, 0xd1, 0xb7, 0x76,
+	0x1d, 0xd0, 0xa7, 0x02, 0xe0, 0x31, 0x4e, 0x71, 0xc4, 0x3c, 0xf2, 0x6c, 0x42, 0x18, 0xb7, 0x3f,
+	0x86, 0x57, 0x73, 0xa7, 0x2c, 0xa1, 0x31, 0x23, 0xe8, 0x3d, 0xa8, 0x26, 0xf2, 0x64, 0xd3, 0x68,
+	0x1a, 0x9d, 0x1b, 0x7b, 0x0d, 0x67, 0xc1, 0x2a, 0x47, 0xa5, 0xf4, 0x2b, 0x2f, 0xfe, 0xdc, 0x29,
+	0x79, 0x3a, 0xdc, 0xc6, 0x60, 0xca, 0x7a, 0x0f, 0x19, 0x0f, 0x23, 0xcc, 0xc9, 0x93, 0xe9, 0x80,
+	0x90, 0x0c, 0x0d, 0x35, 0xa0, 0xc6, 0xa7, 0xfe, 0x70, 0xc6, 0x89, 0x2a, 0xbc, 0xe1, 0xad, 0xf3,
+	0x69, 0x5f, 0xbc, 0xa2, 0x0e, 0x54, 0x22, 0x16, 0xb0, 0xcd, 0x72, 0x73, 0xad, 0x73, 0x63, 0xaf,
+	0xee, 0x28, 0xa7, 0x9d, 0xcc, 0x69, 0xe7, 0x7e, 0x3c, 0xf3, 0x64, 0x84, 0xfd, 0x5b, 0x19, 0xb6,
+	0x0a, 0x31, 0x34, 0xf7, 0x06, 0xd4, 0x02, 0xcc, 0x7c, 0x41, 0x53, 0x82, 0xd4, 0xbc, 0xf5, 0x00,
+	0xb3, 0x41, 0x4a, 0x08, 0x7a, 0x0a, 0xc0, 0x29, 0xc7, 0x63, 0x5f, 0xf8, 0xa2, 0xa1, 0x1a, 0x8e,
+	0xea, 0x81, 0x23, 0x7a, 0xe0, 0x68, 0xf7, 0x9d, 0x07, 0x34, 0x8c, 0xfb, 0x77, 0x84, 0xb4, 0x1f,
+	0xfe, 0xda, 0xe9, 0x04, 0x21, 0x3f, 0x9a, 0x0c, 0x9d, 0x11, 0x8d, 0x5c, 0xdd, 0x30, 0xf5, 0xa7,
+	0xcb, 0x0e, 0xbe, 0x70, 0xf9, 0x2c, 0x21, 0x4c, 0x26, 0x30, 0xef, 0xba, 0x2c, 0x2f, 0xe8, 0xa0,
+	0x21, 0x54, 0xf9, 0x54, 0x00, 0x6d, 0xae, 0xfd, 0xff, 0x38, 0xd7, 0xb8, 0xd0, 0x8c, 0xfa, 0x50,
+	0x8b, 0x58, 0xa0, 0xd4, 0x54, 0x24, 0x4a, 0xab, 0xa0, 0x51, 0x8f, 0x58, 0x30, 0x20, 0x24, 0x73,
+	0x4b, 0x37, 0x6c, 0x3d, 0x92, 0xa7, 0xcc, 0xfe, 0xd1, 0x80, 0x5b, 0xf9, 0x08, 0xd4, 0x84, 0x0d,
+	0x51, 0x56, 0x00, 0xfa, 0x93, 0x74, 0x2c, 0x5d, 0xbc, 0xee, 0x41, 0xc4, 0x82, 0x27, 0xb3, 0x84,
+	0x7c, 0x96, 0x8e, 0x73, 0x1e, 0x97, 0xf3, 0x1e, 0xfb, 0x50, 0x91, 0x7c, 0xae, 0x40, 0xb5, 0x2c,
+	0x6c, 0x6f, 0x41, 0x43, 0xb6, 0x5f, 0xb0, 0x7f, 0x40, 0xc7, 0x63, 0x32, 0xe2, 0xe4, 0x20, 0xfb,
+	0x9e, 0xbf, 0x02, 0xb3, 0xe8, 0x52, 0x7f, 0x1a, 0x19, 0x37, 0xe3, 0xaa, 0xb8, 0x0d, 0xc0, 0x5e,
+	0x84, 0xef, 0xcf, 0x1e, 0x29, 0xe7, 0xb2, 0x31, 0x58, 0xea, 0xaf, 0xed, 0x43, 0xfb, 0xc2, 0x3a,
+	0x5a, 0xcf, 0xfb, 0x39, 0x3d, 0x56, 0x71, 0xef, 0x45, 0x86, 0xa8, 0xa3, 0x1b, 0xaf, 0x88, 0x3e,
+	0x85, 0x66, 0x11, 0xc0, 0xc3, 0x84, 0x8e, 0x8e, 0x32, 0x9a, 0x03, 0x80, 0xff, 0x96, 0x90, 0x5e,
+	0x04, 0xb7, 0x73, 0x9e, 0xa9, 0x65, 0x9a, 0x39, 0xf7, 0x18, 0x07, 0x99, 0x44, 0x6f, 0x2e, 0xd3,
+	0xfe, 0xd5, 0x80, 0xd6, 0x05, 0x60, 0x5a, 0x4b, 0x1b, 0x6e, 0x8e, 0x26, 0x69, 0x4a, 0x62, 0xee,
+	0x13, 0x71, 0x21, 0x01, 0x2b, 0xde, 0x86, 0x3e, 0x94, 0xc1, 0xe8, 0x1e, 0x54, 0xe6, 0x46, 0x77,
+	0xbb, 0x40, 0xb0, 0x8c, 0x3b, 0x2b, 0x17, 0x7d, 0x98, 0x93, 0xb2, 0x26, 0xa5, 0xbc, 0xb9, 0x54,
+	0x8a, 0x62, 0x36, 0xaf, 0x65, 0xef, 0xe7, 0x2a, 0x5c, 0x93, 0x5a, 0xd0, 0x97, 0x50, 0x55, 0x1b,
+	0x10, 0xbd, 0x51, 0x40, 0x63, 0x71, 0xd5, 0x9a, 0xb7, 0x97, 0x85, 0x29, 0x38, 0xbb, 0xf5, 0xf5,
+	0xef, 0xff, 0x7c, 0x5b, 0xde, 0x42, 0x0d, 0x77, 0x71, 0x9f, 0xab, 0x2d, 0x8b, 0xbe, 0x37, 0xe0,
+	0x56, 0x7e, 0xfb, 0xa1, 0xee, 0x79, 0xd5, 0x0b, 0x37, 0xb1, 0xe9, 0xac, 0x1a, 0xae, 0x49, 0x39,
+	0x92, 0x54, 0xc7, 0x6e, 0x17, 0x90, 0x22, 0x3a, 0xc5, 0x57, 0xfb, 0x8e, 0x7d, 0x60, 0xec, 0xa2,
+	0xef, 0x0c, 0xb8, 0x99, 0x6b, 0x37, 0x7a, 0xe7, 0x3c, 0xc4, 0xa2, 0x39, 0x36, 0xbb, 0x2b, 0x46,
+	0x6b, 0x7a, 0x6f, 0x49, 0x7a, 0x6d, 0xd4, 0x72, 0x8b, 0xff, 0x07, 0xfa, 0xa3, 0x53, 0x1e, 0xbf,
+	0x18, 0xf0, 0x5a, 0xf1, 0x58, 0xa1, 0xbb, 0x2b, 0x81, 0x9e, 0x1d, 0x67, 0xf3, 0xde, 0x65, 0xd3,
+	0x34, 0xe9, 0x7d, 0x49, 0xba, 0x8b, 0xde, 0x5e, 0x4a, 0xda, 0xcd, 0xd6, 0x05, 0x43, 0x3f, 0x19,
+	0x50, 0x2f, 0x9a, 0x23, 0xb4, 0xbf, 0x22, 0x8b, 0xf9, 0x11, 0x37, 0xdf, 0xbd, 0x5c, 0x92, 0x26,
+	0x7e, 0x47, 0x12, 0xdf, 0x45, 0x9d, 0xe5, 0xc4, 0xe5, 0x28, 0xb3, 0xfe, 0x27, 0x2f, 0x8e, 0x2d,
+	0xe3, 0xe5, 0xb1, 0x65, 0xfc, 0x7d, 0x6c, 0x19, 0xdf, 0x9c, 0x58, 0xa5, 0x97, 0x27, 0x56, 0xe9,
+	0x8f, 0x13, 0xab, 0xf4, 0xf9, 0xdd, 0xb9, 0x0d, 0x7b, 0x5f, 0x56, 0x1b, 0xd0, 0x49, 0x7c, 0x20,
+	0xa7, 0x4d, 0x97, 0xef, 0x7e, 0xd4, 0x73, 0xa7, 0xa7, 0x18, 0xd2, 0x86, 0x61, 0x55, 0xfe, 0x30,
+	0xd8, 0xff, 0x77, 0x00, 0x10, 0xfc, 0x4f, 0x80, 0xe2, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params retrieves the total set of onboarding parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateTxFees computes the fees which will be charged for a tx, including
+	// the in-token fees charged on gasfree messages (e.g. MsgMicrotx and the erc20
+	// interop messages) and whether the tx will bypass the usual fee deduction
+	EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error)
+	// FeesCollected retrieves the cumulative fees collected by the gasfree payment paths, per denom
+	FeesCollected(ctx context.Context, in *QueryFeesCollectedRequest, opts ...grpc.CallOption) (*QueryFeesCollectedResponse, error)
+	// FeesCollectedByMsgType retrieves the cumulative fees collected by the gasfree payment paths, per message type
+	FeesCollectedByMsgType(ctx context.Context, in *QueryFeesCollectedByMsgTypeRequest, opts ...grpc.CallOption) (*QueryFeesCollectedByMsgTypeResponse, error)
+	// FeesCollectedByEpoch retrieves the fees collected by the gasfree payment paths in each epoch, oldest first
+	// unless pagination.reverse is set
+	FeesCollectedByEpoch(ctx context.Context, in *QueryFeesCollectedByEpochRequest, opts ...grpc.CallOption) (*QueryFeesCollectedByEpochResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error) {
+	out := new(QueryEstimateTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/EstimateTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeesCollected(ctx context.Context, in *QueryFeesCollectedRequest, opts ...grpc.CallOption) (*QueryFeesCollectedResponse, error) {
+	out := new(QueryFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/FeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeesCollectedByMsgType(ctx context.Context, in *QueryFeesCollectedByMsgTypeRequest, opts ...grpc.CallOption) (*QueryFeesCollectedByMsgTypeResponse, error) {
+	out := new(QueryFeesCollectedByMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/FeesCollectedByMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeesCollectedByEpoch(ctx context.Context, in *QueryFeesCollectedByEpochRequest, opts ...grpc.CallOption) (*QueryFeesCollectedByEpochResponse, error) {
+	out := new(QueryFeesCollectedByEpochResponse)
+	err := c.cc.Invoke(ctx, "/althea.gasfree.v1.Query/FeesCollectedByEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params retrieves the total set of onboarding parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateTxFees computes the fees which will be charged for a tx, including
+	// the in-token fees charged on gasfree messages (e.g. MsgMicrotx and the erc20
+	// interop messages) and whether the tx will bypass the usual fee deduction
+	EstimateTxFees(context.Context, *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error)
+	// FeesCollected retrieves the cumulative fees collected by the gasfree payment paths, per denom
+	FeesCollected(context.Context, *QueryFeesCollectedRequest) (*QueryFeesCollectedResponse, error)
+	// FeesCollectedByMsgType retrieves the cumulative fees collected by the gasfree payment paths, per message type
+	FeesCollectedByMsgType(context.Context, *QueryFeesCollectedByMsgTypeRequest) (*QueryFeesCollectedByMsgTypeResponse, error)
+	// FeesCollectedByEpoch retrieves the fees collected by the gasfree payment paths in each epoch, oldest first
+	// unless pagination.reverse is set
+	FeesCollectedByEpoch(context.Context, *QueryFeesCollectedByEpochRequest) (*QueryFeesCollectedByEpochResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTxFees(ctx context.Context, req *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFees not implemented")
+}
+func (*UnimplementedQueryServer) FeesCollected(ctx context.Context, req *QueryFeesCollectedRequest) (*QueryFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeesCollected not implemented")
+}
+func (*UnimplementedQueryServer) FeesCollectedByMsgType(ctx context.Context, req *QueryFeesCollectedByMsgTypeRequest) (*QueryFeesCollectedByMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeesCollectedByMsgType not implemented")
+}
+func (*UnimplementedQueryServer) FeesCollectedByEpoch(ctx context.Context, req *QueryFeesCollectedByEpochRequest) (*QueryFeesCollectedByEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeesCollectedByEpoch not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.gasfree.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.gasfree.v1.Query/EstimateTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTxFees(ctx, req.(*QueryEstimateTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.gasfree.v1.Query/FeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeesCollected(ctx, req.(*QueryFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeesCollectedByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeesCollectedByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeesCollectedByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.gasfree.v1.Query/FeesCollectedByMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeesCollectedByMsgType(ctx, req.(*QueryFeesCollectedByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeesCollectedByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeesCollectedByEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeesCollectedByEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.gasfree.v1.Query/FeesCollectedByEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeesCollectedByEpoch(ctx, req.(*QueryFeesCollectedByEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "althea.gasfree.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateTxFees",
+			Handler:    _Query_EstimateTxFees_Handler,
+		},
+		{
+			MethodName: "FeesCollected",
+			Handler:    _Query_FeesCollected_Handler,
+		},
+		{
+			MethodName: "FeesCollectedByMsgType",
+			Handler:    _Query_FeesCollectedByMsgType_Handler,
+		},
+		{
+			MethodName: "FeesCollectedByEpoch",
+			Handler:    _Query_FeesCollectedByEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "althea/gasfree/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TxFee) > 0 {
+		for iNdEx := len(m.TxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasFree {
+		i--
+		if m.GasFree {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasFree {
+		i--
+		if m.GasFree {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedByMsgTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedByMsgTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedByMsgTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedByMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedByMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedByMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedByEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeesCollectedByEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeesCollectedByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeesCollectedByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasFree {
+		n += 2
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TxFee) > 0 {
+		for _, e := range m.TxFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasFree {
+		n += 2
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeesCollectedByMsgTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeesCollectedByMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeesCollectedByEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeesCollectedByEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFree", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasFree = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types1.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxFee = append(m.TxFee, types1.Coin{})
+			if err := m.TxFee[len(m.TxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFeeEstimate{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFree", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasFree = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types1.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeesCollectedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeesCollectedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeesCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeesCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types1.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeesCollectedByMsgTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeesCollectedByMsgTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeesCollectedByMsgTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeesCollectedByMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeesCollectedByMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeesCollectedByMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, MsgTypeFees{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeesCollectedByEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -340,12 +2431,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeesCollectedByEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeesCollectedByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -367,7 +2494,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeesCollectedByEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -390,15 +2517,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeesCollectedByEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeesCollectedByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -425,7 +2571,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, EpochFees{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
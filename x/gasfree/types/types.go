@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "gasfree"
@@ -23,4 +27,55 @@ var (
 	// erc20 module's gasfree messages. The fee is a percentage of the amount of tokens converted in the message.
 	// The erc20 module's gasfree messages are MsgSendCoinToEVM, MsgSendERC20ToCosmos, and MsgSendERC20ToCosmosAndIBCTransfer
 	GasFreeErc20InteropFeeBasisPointsKey = []byte("gasFreeErc20InteropFeeBasisPoints")
+
+	// FeeAccountingEpochLengthKey indexes the number of blocks in each fee accounting epoch, the fees collected
+	// by the gasfree payment paths are totalled per epoch in addition to the all-time totals
+	FeeAccountingEpochLengthKey = []byte("feeAccountingEpochLength")
+
+	// GasFreePriorityPricesKey indexes the governance-set prices used to convert the in-token fees paid by
+	// gasfree txs into native token value, which determines the mempool priority of those txs
+	GasFreePriorityPricesKey = []byte("gasFreePriorityPrices")
+
+	// AlternativeFeeDenomsKey indexes the governance approved denoms which may be used to pay regular tx fees
+	// instead of the native token, along with how each is converted to native value
+	AlternativeFeeDenomsKey = []byte("alternativeFeeDenoms")
 )
+
+// Store key prefixes
+var (
+	// FeesByDenomKeyPrefix indexes the cumulative fees collected by the gasfree payment paths, per denom
+	// [0x1][denom] => sdk.Coin
+	FeesByDenomKeyPrefix = []byte{0x1}
+
+	// FeesByMsgTypeKeyPrefix indexes the cumulative fees collected by the gasfree payment paths, per msg type url
+	// [0x2][msg type url] => MsgTypeFees
+	FeesByMsgTypeKeyPrefix = []byte{0x2}
+
+	// FeesByEpochKeyPrefix indexes the fees collected by the gasfree payment paths, per fee accounting epoch
+	// [0x3][big endian epoch] => EpochFees
+	FeesByEpochKeyPrefix = []byte{0x3}
+
+	// OraclePriceKeyPrefix indexes the native prices read from the price oracle at the end of the last block
+	// [0x4][denom] => DenomPrice
+	OraclePriceKeyPrefix = []byte{0x4}
+)
+
+// GetFeesByDenomKey returns the key for the fees collected in the given denom
+func GetFeesByDenomKey(denom string) []byte {
+	return append(FeesByDenomKeyPrefix, []byte(denom)...)
+}
+
+// GetFeesByMsgTypeKey returns the key for the fees collected on the given msg type url
+func GetFeesByMsgTypeKey(msgTypeUrl string) []byte {
+	return append(FeesByMsgTypeKeyPrefix, []byte(msgTypeUrl)...)
+}
+
+// GetOraclePriceKey returns the key for the stored oracle price of the given denom
+func GetOraclePriceKey(denom string) []byte {
+	return append(OraclePriceKeyPrefix, []byte(denom)...)
+}
+
+// GetFeesByEpochKey returns the key for the fees collected during the given epoch
+func GetFeesByEpochKey(epoch uint64) []byte {
+	return append(FeesByEpochKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
@@ -0,0 +1,77 @@
+package lockup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// BeginBlocker is called at the start of every block, applying any scheduled lockup changes which have come due
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	applyScheduledLockedTokenDenoms(ctx, k)
+	applyScheduledUnlock(ctx, k)
+}
+
+// applyScheduledUnlock unlocks the chain once the UnlockHeight or UnlockTime has been reached. Both params are cleared
+// once used, so that governance may lock the chain again later without it being immediately unlocked
+func applyScheduledUnlock(ctx sdk.Context, k keeper.Keeper) {
+	unlockHeight := k.GetUnlockHeight(ctx)
+	unlockTime := k.GetUnlockTime(ctx)
+	if !scheduleReached(ctx, unlockHeight, unlockTime) {
+		return
+	}
+
+	k.SetUnlockHeight(ctx, 0)
+	k.SetUnlockTime(ctx, time.Time{})
+	if !k.GetChainLocked(ctx) {
+		return
+	}
+
+	k.SetChainLocked(ctx, false)
+	k.Logger(ctx).Info("Chain unlocked by schedule", "unlock-height", unlockHeight, "unlock-time", unlockTime)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChainUnlocked,
+		sdk.NewAttribute(types.ChainUnlockedKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+		sdk.NewAttribute(types.ChainUnlockedKeyTime, ctx.BlockTime().UTC().Format(time.RFC3339)),
+	))
+}
+
+// applyScheduledLockedTokenDenoms replaces LockedTokenDenoms with every scheduled change which has come due, in the
+// order they were scheduled, and removes the applied changes from the schedule
+func applyScheduledLockedTokenDenoms(ctx sdk.Context, k keeper.Keeper) {
+	scheduled := k.GetScheduledLockedTokenDenoms(ctx)
+	if len(scheduled) == 0 {
+		return
+	}
+
+	pending := make([]types.ScheduledLockedTokenDenoms, 0, len(scheduled))
+	for _, change := range scheduled {
+		if !scheduleReached(ctx, change.Height, change.Time) {
+			pending = append(pending, change)
+			continue
+		}
+
+		k.SetLockedTokenDenoms(ctx, change.LockedTokenDenoms)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLockedTokenDenomsChanged,
+			sdk.NewAttribute(types.LockedTokenDenomsChangedKeyDenoms, strings.Join(change.LockedTokenDenoms, ",")),
+		))
+	}
+
+	if len(pending) != len(scheduled) {
+		k.SetScheduledLockedTokenDenoms(ctx, pending)
+	}
+}
+
+// scheduleReached returns true if a nonzero height or nonzero time has been reached by the current block
+func scheduleReached(ctx sdk.Context, height uint64, t time.Time) bool {
+	if height != 0 && ctx.BlockHeight() >= 0 && uint64(ctx.BlockHeight()) >= height {
+		return true
+	}
+	return !t.IsZero() && !ctx.BlockTime().Before(t)
+}
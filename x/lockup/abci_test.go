@@ -0,0 +1,65 @@
+package lockup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+func TestBeginBlockerScheduledUnlock(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	k := input.LockupKeeper
+
+	// Unlock by height
+	k.SetChainLocked(ctx, true)
+	k.SetUnlockHeight(ctx, 11)
+	BeginBlocker(ctx, k)
+	require.True(t, k.GetChainLocked(ctx))
+	ctx = ctx.WithBlockHeight(11)
+	BeginBlocker(ctx, k)
+	require.False(t, k.GetChainLocked(ctx))
+	require.Equal(t, uint64(0), k.GetUnlockHeight(ctx))
+
+	// A chain locked again by governance is not unlocked by the consumed schedule
+	k.SetChainLocked(ctx, true)
+	BeginBlocker(ctx.WithBlockHeight(12), k)
+	require.True(t, k.GetChainLocked(ctx))
+
+	// Unlock by time
+	k.SetUnlockTime(ctx, time.Unix(2000, 0))
+	BeginBlocker(ctx.WithBlockTime(time.Unix(1999, 0)), k)
+	require.True(t, k.GetChainLocked(ctx))
+	BeginBlocker(ctx.WithBlockTime(time.Unix(2000, 0)), k)
+	require.False(t, k.GetChainLocked(ctx))
+	require.True(t, k.GetUnlockTime(ctx).IsZero())
+}
+
+func TestBeginBlockerScheduledLockedTokenDenoms(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	k := input.LockupKeeper
+
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+	byHeight := types.ScheduledLockedTokenDenoms{Height: 11, Time: time.Time{}, LockedTokenDenoms: []string{"aalthea", "ibc/test"}}
+	byTime := types.ScheduledLockedTokenDenoms{Height: 0, Time: time.Unix(2000, 0), LockedTokenDenoms: []string{"ibc/test"}}
+	k.SetScheduledLockedTokenDenoms(ctx, []types.ScheduledLockedTokenDenoms{byHeight, byTime})
+
+	BeginBlocker(ctx, k)
+	require.Equal(t, []string{"aalthea"}, k.GetLockedTokenDenoms(ctx))
+	require.Len(t, k.GetScheduledLockedTokenDenoms(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(11)
+	BeginBlocker(ctx, k)
+	require.Equal(t, byHeight.LockedTokenDenoms, k.GetLockedTokenDenoms(ctx))
+	require.Len(t, k.GetScheduledLockedTokenDenoms(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(2001, 0))
+	BeginBlocker(ctx, k)
+	require.Equal(t, byTime.LockedTokenDenoms, k.GetLockedTokenDenoms(ctx))
+	require.Empty(t, k.GetScheduledLockedTokenDenoms(ctx))
+}
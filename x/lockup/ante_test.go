@@ -30,11 +30,7 @@ func TestLockAnteHandler(t *testing.T) {
 	ctx := input.Context
 	appCodec := keeper.MakeTestMarshaler()
 	txCfg := authtx.NewTxConfig(appCodec, authtx.DefaultSignModes)
-	keys := sdk.NewKVStoreKeys(types.StoreKey)
-	subspace, _ := input.ParamsKeeper.GetSubspace(types.ModuleName)
-	keeper := keeper.NewKeeper(
-		appCodec, keys[types.StoreKey], subspace,
-	)
+	keeper := input.LockupKeeper
 	handler := NewLockupAnteHandler(keeper, appCodec)
 	txFct := tx.Factory{}.WithTxConfig(txCfg).WithChainID("Gold-Chain")
 
@@ -99,6 +95,13 @@ func AnteHandlerLockedHappy(t *testing.T, handler sdk.AnteHandler, keeper keeper
 	assert.Equal(t, ctx, allEvmCtx)
 	assert.Nil(t, allEvmErr)
 	t.Log("Successful good MsgEthereumTx")
+
+	// MsgEthereumTxs without value may be sent by anyone, their ERC20 transfers are checked by the lockup EVM hook
+	valuelessMsgEthereumTx := GetValuelessMsgEthereumTxTx(keeper, ctx, txFct, txCfg)
+	valuelessEvmCtx, valuelessEvmErr := handler(ctx, valuelessMsgEthereumTx, false)
+	assert.Equal(t, ctx, valuelessEvmCtx)
+	assert.Nil(t, valuelessEvmErr)
+	t.Log("Successful valueless MsgEthereumTx")
 }
 
 // Test failing messages on a locked chain
@@ -347,6 +350,23 @@ func GetAllowedMsgEthereumTxTx(keeper keeper.Keeper, ctx sdk.Context, txFct tx.F
 	return txBld.GetTx()
 }
 
+func GetValuelessMsgEthereumTxTx(keeper keeper.Keeper, ctx sdk.Context, txFct tx.Factory, txCfg client.TxConfig) sdk.Tx {
+	fromAddr := "0x1111111111111111111111111111111111111111"
+	exemptSet := keeper.GetLockExemptAddressesSet(ctx)
+	if _, ok := exemptSet[fromAddr]; ok {
+		panic(fmt.Sprintf("The exemptSet has been changed, it MUST NOT contain %v", fromAddr))
+	}
+	toAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	msgEvmTx := *evmtypes.NewTx(big.NewInt(1234), 0, &toAddress, big.NewInt(0), 2000000, big.NewInt(1234), big.NewInt(4567), big.NewInt(7890), []byte{}, nil)
+	msgEvmTx.From = fromAddr
+	txBld, err := txFct.BuildUnsignedTx(&msgEvmTx)
+	if err != nil {
+		panic(fmt.Sprintf("Unable to build unsigned transaction containing %v: %v", msgEvmTx, err))
+	}
+
+	return txBld.GetTx()
+}
+
 func GetUnallowedMsgSendTx(keeper keeper.Keeper, ctx sdk.Context, txFct tx.Factory, txCfg client.TxConfig) sdk.Tx {
 	fromAddr := "0x1111111111111111111111111111111111111111"
 	exemptSet := keeper.GetLockExemptAddressesSet(ctx)
@@ -479,3 +499,62 @@ func GetUnallowedMsgEthereumTxTx(keeper keeper.Keeper, ctx sdk.Context, txFct tx
 
 	return txBld.GetTx()
 }
+
+// Checks that governance may lock any Msg type with a registered MsgInspector, and that Msg types without one are
+// rejected once locked
+func TestLockAnteHandlerMsgInspectors(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("althea", "altheapub")
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	appCodec := keeper.MakeTestMarshaler()
+	txCfg := authtx.NewTxConfig(appCodec, authtx.DefaultSignModes)
+	k := input.LockupKeeper
+	handler := NewLockupAnteHandler(k, appCodec)
+	txFct := tx.Factory{}.WithTxConfig(txCfg).WithChainID("Gold-Chain")
+	buildTx := func(msg sdk.Msg) sdk.Tx {
+		txBld, err := txFct.BuildUnsignedTx(msg)
+		if err != nil {
+			panic(fmt.Sprintf("Unable to build unsigned transaction containing %v: %v", msg, err))
+		}
+		return txBld.GetTx()
+	}
+
+	exempt := "althea1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq8p93tc"
+	nonExempt := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	k.SetChainLocked(ctx, true)
+	k.SetLockExemptAddresses(ctx, []string{exempt})
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+	// nolint: exhaustruct
+	delegateType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	// nolint: exhaustruct
+	createValidatorType := sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})
+	k.SetLockedMessageTypes(ctx, []string{delegateType, createValidatorType})
+
+	delegate := func(delegator string, denom string) sdk.Tx {
+		return buildTx(&stakingtypes.MsgDelegate{
+			DelegatorAddress: delegator,
+			ValidatorAddress: "altheavaloper1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqeax9y6",
+			Amount:           sdk.NewCoin(denom, sdk.NewInt(1000)),
+		})
+	}
+
+	_, err := handler(ctx, delegate(nonExempt, "aalthea"), false)
+	assert.ErrorIs(t, err, types.ErrLocked)
+	_, err = handler(ctx, delegate(nonExempt, "unlocked"), false)
+	assert.Nil(t, err)
+	_, err = handler(ctx, delegate(exempt, "aalthea"), false)
+	assert.Nil(t, err)
+
+	// A locked Msg type without an inspector cannot be handled
+	unimportantTx := GetUnimportantTx(txFct, txCfg)
+	_, err = handler(ctx, unimportantTx, false)
+	assert.ErrorIs(t, err, types.ErrUnhandled)
+
+	// nolint: exhaustruct
+	k.RegisterMsgInspector(&stakingtypes.MsgCreateValidator{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgCreate := msg.(*stakingtypes.MsgCreateValidator)
+		return []types.MovedFunds{{Sender: msgCreate.DelegatorAddress, Coins: sdk.Coins{msgCreate.Value}}}, nil
+	})
+	_, err = handler(ctx, unimportantTx, false)
+	assert.Nil(t, err)
+}
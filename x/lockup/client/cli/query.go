@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/spf13/cobra"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// GetQueryCmd bundles all the query subcmds together so they appear under the `query` or `q` subcommand
+func GetQueryCmd() *cobra.Command {
+	// nolint: exhaustruct
+	lockupQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the lockup module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	lockupQueryCmd.AddCommand([]*cobra.Command{
+		CmdQueryParams(),
+		CmdQueryIsExempt(),
+		CmdQueryIsDenomLocked(),
+		CmdQueryCheckTx(),
+		CmdQueryReleaseSchedules(),
+		CmdQueryAccountRelease(),
+	}...)
+
+	return lockupQueryCmd
+}
+
+// CmdQueryParams fetches the current lockup params
+func CmdQueryParams() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query lockup params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryIsExempt checks if an address may move locked tokens while the chain is locked
+func CmdQueryIsExempt() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "is-exempt [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query if a bech32 or hex address is exempt from the lockup",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsExempt(cmd.Context(), &types.QueryIsExemptRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryIsDenomLocked checks if non-exempt addresses are currently unable to move a denom
+func CmdQueryIsDenomLocked() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "is-denom-locked [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query if a denom is currently locked for non-exempt addresses",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsDenomLocked(cmd.Context(), &types.QueryIsDenomLockedRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCheckTx checks a tx against the lockup rules, e.g. one generated with --generate-only
+func CmdQueryCheckTx() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "check-tx [tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Check if a JSON encoded tx would be blocked by the lockup",
+		Long: `Check if a JSON encoded tx, such as one generated with the --generate-only flag, would be blocked by the lockup.
+The response indicates whether the tx is allowed, and the verdict for each message with the reason it is blocked.
+The ERC20 transfers made by EVM txs are only checked when they execute, so they are not covered by this query.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			// nolint: exhaustruct
+			res, err := queryClient.CheckTx(cmd.Context(), &types.QueryCheckTxRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryReleaseSchedules fetches every release schedule
+func CmdQueryReleaseSchedules() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "release-schedules",
+		Args:  cobra.NoArgs,
+		Short: "Query every release schedule of a locked denom",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReleaseSchedules(cmd.Context(), &types.QueryReleaseSchedulesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAccountRelease fetches the released and locked amounts of an account's release schedules
+func CmdQueryAccountRelease() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "account-release [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the released and still locked balance of each release schedule of a bech32 or hex address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRelease(cmd.Context(), &types.QueryAccountReleaseRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/AltheaFoundation/althea-L1/config"
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+)
+
+// nolint: exhaustruct
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for lockup keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. While the chain is locked, the hook reverts any EVM tx which
+// moved a locked token out of a non-exempt account, either by sending native value with the tx or by an ERC20
+// `Transfer` of a locked token's registered ERC20 representation. An account with a release schedule for the token
+// may move it so long as the total it has moved stays within the released amount of its schedule, the amounts moved by
+// the tx are recorded against the schedule. All other EVM txs (e.g. DEX swaps of unlocked tokens) are allowed to
+// execute.
+//
+// Note that native value moved by internal CALLs (or SELFDESTRUCT) appears neither in the msg nor in the receipt, and
+// the hook has no access to the balances from before the tx, so only the tx's own value is checked. An externally
+// owned account can only move its native tokens with the tx's value, so they remain locked, but native
+// tokens held by a contract may be moved by the contract while the chain is locked.
+func (h Hooks) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	if !h.k.GetChainLocked(ctx) {
+		return nil
+	}
+
+	lockedTokenDenomsSet := h.k.GetLockedTokenDenomsSet(ctx)
+
+	if msg.Value() != nil && msg.Value().Sign() == 1 {
+		if _, locked := lockedTokenDenomsSet[config.BaseDenom]; locked && !h.k.isExemptEVMAddress(ctx, msg.From()) {
+			value := sdk.NewCoin(config.BaseDenom, sdk.NewIntFromBigInt(msg.Value()))
+			if err := h.k.spendReleasedFunds(ctx, sdk.AccAddress(msg.From().Bytes()), value); err != nil {
+				return errorsmod.Wrapf(err, "unable to send %s", config.BaseDenom)
+			}
+		}
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	for _, log := range receipt.Logs {
+		// Note: the `Transfer` event contains 3 topics (id, from, to)
+		if len(log.Topics) != 3 {
+			continue
+		}
+
+		event, err := erc20.EventByID(log.Topics[0])
+		if err != nil || event.Name != erc20types.ERC20EventTransfer {
+			continue
+		}
+
+		transferEvent, err := erc20.Unpack(event.Name, log.Data)
+		if err != nil || len(transferEvent) == 0 {
+			continue
+		}
+		tokens, ok := transferEvent[0].(*big.Int)
+		if !ok || tokens == nil || tokens.Sign() != 1 {
+			continue
+		}
+
+		// Mints come from the zero address and never move funds out of an account
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		if from == (common.Address{}) || h.k.isExemptEVMAddress(ctx, from) {
+			continue
+		}
+
+		denom, found := h.k.getErc20Denom(ctx, log.Address)
+		if !found {
+			continue
+		}
+		if _, locked := lockedTokenDenomsSet[denom]; locked {
+			if err := h.k.spendReleasedFunds(ctx, sdk.AccAddress(from.Bytes()), sdk.NewCoin(denom, sdk.NewIntFromBigInt(tokens))); err != nil {
+				return errorsmod.Wrapf(err, "unable to transfer the ERC20 representation of %s", denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getErc20Denom returns the Cosmos denom of the token pair registered for the given ERC20 contract, if any
+func (k Keeper) getErc20Denom(ctx sdk.Context, contract common.Address) (string, bool) {
+	id := k.erc20Keeper.GetTokenPairID(ctx, contract.Hex())
+	if len(id) == 0 {
+		return "", false
+	}
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, id)
+	if !found {
+		return "", false
+	}
+	return pair.Denom, true
+}
+
+// isExemptEVMAddress checks if the bech32 form of addr is one of the lock exempt addresses
+func (k Keeper) isExemptEVMAddress(ctx sdk.Context, addr common.Address) bool {
+	return k.IsLockExempt(ctx, sdk.AccAddress(addr.Bytes()).String())
+}
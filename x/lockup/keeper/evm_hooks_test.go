@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// mockErc20Keeper registers a single token pair for the lockup EVM hook tests
+type mockErc20Keeper struct {
+	pair erc20types.TokenPair
+}
+
+func (m mockErc20Keeper) GetTokenPairID(_ sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) && common.HexToAddress(token) == common.HexToAddress(m.pair.Erc20Address) {
+		return m.pair.GetID()
+	}
+	return nil
+}
+
+func (m mockErc20Keeper) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	if id == nil {
+		// nolint: exhaustruct
+		return erc20types.TokenPair{}, false
+	}
+	return m.pair, true
+}
+
+// Checks that the lockup EVM hook only rejects txs moving locked tokens out of non-exempt accounts
+func TestPostTxProcessing(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.LockupKeeper
+
+	lockedToken := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	unlockedToken := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	k.SetErc20Keeper(mockErc20Keeper{pair: erc20types.NewTokenPair(lockedToken, "locked", true, erc20types.OWNER_MODULE)})
+
+	exempt := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	k.SetChainLocked(ctx, true)
+	k.SetLockExemptAddresses(ctx, []string{sdk.AccAddress(exempt.Bytes()).String()})
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea", "locked"})
+
+	newMsg := func(from common.Address, value int64) ethtypes.Message {
+		return ethtypes.NewMessage(
+			from, &common.Address{}, 0, big.NewInt(value), 0, big.NewInt(0), big.NewInt(0), big.NewInt(0), []byte{}, ethtypes.AccessList{}, true,
+		)
+	}
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"]
+	transferData, err := transferEvent.Inputs.NonIndexed().Pack(big.NewInt(10))
+	require.NoError(t, err)
+	transferReceipt := func(token common.Address, from common.Address) *ethtypes.Receipt {
+		// nolint: exhaustruct
+		return &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+			Address: token,
+			Topics:  []common.Hash{transferEvent.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(user.Bytes())},
+			Data:    transferData,
+		}}}
+	}
+	// nolint: exhaustruct
+	emptyReceipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{}}
+
+	hooks := k.Hooks()
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), emptyReceipt))
+	require.Error(t, hooks.PostTxProcessing(ctx, newMsg(user, 1), emptyReceipt))
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(exempt, 1), emptyReceipt))
+
+	// Native value sent by a contract's internal CALL is not visible to the hook, so a valueless tx calling a
+	// contract which pays out its native tokens is allowed
+	contract := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	callMsg := ethtypes.NewMessage(
+		user, &contract, 0, big.NewInt(0), 0, big.NewInt(0), big.NewInt(0), big.NewInt(0), []byte{0x1}, ethtypes.AccessList{}, true,
+	)
+	require.NoError(t, hooks.PostTxProcessing(ctx, callMsg, emptyReceipt))
+
+	// Only transfers of a locked token's ERC20 out of a non-exempt account are rejected
+	require.Error(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(lockedToken, user)))
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(unlockedToken, user)))
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(lockedToken, exempt)))
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(lockedToken, common.Address{})))
+
+	// With a release schedule, the ERC20 transfers are recorded until they reach the released amount
+	schedule := types.NewHeightReleaseSchedule(
+		sdk.AccAddress(user.Bytes()).String(), "locked", sdk.NewInt(15), types.RELEASE_TYPE_CLIFF, 0, uint64(ctx.BlockHeight()),
+	)
+	k.SetReleaseSchedule(ctx, schedule)
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(lockedToken, user)))
+	require.Error(t, hooks.PostTxProcessing(ctx, newMsg(user, 0), transferReceipt(lockedToken, user)))
+	schedule, _ = k.GetReleaseSchedule(ctx, sdk.AccAddress(user.Bytes()), "locked")
+	require.Equal(t, sdk.NewInt(10), schedule.Moved)
+
+	// Nothing is rejected once the chain is unlocked
+	k.SetChainLocked(ctx, false)
+	require.NoError(t, hooks.PostTxProcessing(ctx, newMsg(user, 1), transferReceipt(lockedToken, user)))
+}
@@ -13,17 +13,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetLockExemptAddresses(ctx, params.GetLockExempt())
 	k.SetLockedMessageTypes(ctx, params.GetLockedMessageTypes())
 	k.SetLockedTokenDenoms(ctx, params.LockedTokenDenoms)
+	k.SetUnlockHeight(ctx, params.GetUnlockHeight())
+	k.SetUnlockTime(ctx, params.GetUnlockTime())
+	k.SetScheduledLockedTokenDenoms(ctx, params.GetScheduledLockedTokenDenoms())
+	for _, schedule := range data.ReleaseSchedules {
+		k.SetReleaseSchedule(ctx, schedule)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
 // from the current state of the chain
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	params := k.GetParams(ctx)
 	return types.GenesisState{
-		Params: &types.Params{
-			Locked:             k.GetChainLocked(ctx),
-			LockExempt:         k.GetLockExemptAddresses(ctx),
-			LockedMessageTypes: k.GetLockedMessageTypes(ctx),
-			LockedTokenDenoms:  k.GetLockedTokenDenoms(ctx),
-		},
+		Params:           &params,
+		ReleaseSchedules: k.GetReleaseSchedules(ctx),
 	}
 }
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// nolint: exhaustruct
+// Enforce via type assertion that the Keeper functions as a query server
+var _ types.QueryServer = Keeper{}
+
+// Params queries the params of the lockup module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// IsExempt checks if the given bech32 or hex address is one of the lock exempt addresses
+func (k Keeper) IsExempt(c context.Context, req *types.QueryIsExemptRequest) (*types.QueryIsExemptResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryIsExemptResponse{Exempt: k.IsExemptAddress(ctx, req.Address)}, nil
+}
+
+// IsDenomLocked checks if non-exempt addresses are currently unable to move the given denom
+func (k Keeper) IsDenomLocked(c context.Context, req *types.QueryIsDenomLockedRequest) (*types.QueryIsDenomLockedResponse, error) {
+	if req == nil || req.Denom == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainLocked := k.GetChainLocked(ctx)
+	_, denomLocked := k.GetLockedTokenDenomsSet(ctx)[req.Denom]
+	return &types.QueryIsDenomLockedResponse{Locked: chainLocked && denomLocked, ChainLocked: chainLocked}, nil
+}
+
+// ReleaseSchedules returns every release schedule
+func (k Keeper) ReleaseSchedules(c context.Context, req *types.QueryReleaseSchedulesRequest) (*types.QueryReleaseSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryReleaseSchedulesResponse{ReleaseSchedules: k.GetReleaseSchedules(ctx)}, nil
+}
+
+// AccountRelease returns the released and locked amounts of every release schedule of the given bech32 or hex address
+func (k Keeper) AccountRelease(c context.Context, req *types.QueryAccountReleaseRequest) (*types.QueryAccountReleaseResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var addr sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		addr = sdk.AccAddress(common.HexToAddress(req.Address).Bytes())
+	} else {
+		bech32, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		addr = bech32
+	}
+
+	schedules := k.GetReleaseSchedulesByAddress(ctx, addr)
+	releases := make([]types.ReleaseStatus, len(schedules))
+	for i, schedule := range schedules {
+		releases[i] = k.GetReleaseStatus(ctx, schedule)
+	}
+	return &types.QueryAccountReleaseResponse{Releases: releases}, nil
+}
+
+// CheckTx returns the verdict of the lockup rules for each msg of the given tx bytes or msgs
+func (k Keeper) CheckTx(c context.Context, req *types.QueryCheckTxRequest) (*types.QueryCheckTxResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		msgs []sdk.Msg
+		err  error
+	)
+	if len(req.TxBytes) > 0 {
+		msgs, err = k.decodeTxMsgs(req.TxBytes)
+	} else {
+		msgs, err = k.unpackMsgs(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no msgs provided")
+	}
+
+	chainLocked := k.GetChainLocked(ctx)
+	allowed := true
+	verdicts := make([]types.MsgVerdict, len(msgs))
+	for i, msg := range msgs {
+		verdict := types.MsgVerdict{MsgTypeUrl: sdk.MsgTypeURL(msg), Allowed: true, Reason: ""}
+		if chainLocked {
+			if err := k.checkTxMsg(ctx, msg); err != nil {
+				verdict.Allowed = false
+				verdict.Reason = err.Error()
+				allowed = false
+			}
+		}
+		verdicts[i] = verdict
+	}
+
+	return &types.QueryCheckTxResponse{Allowed: allowed, ChainLocked: chainLocked, MsgVerdicts: verdicts}, nil
+}
+
+// checkTxMsg checks a top-level msg of a tx in the same way as the lockup AnteHandler, checking each of the msgs
+// inside of an authz MsgExec
+func (k Keeper) checkTxMsg(ctx sdk.Context, msg sdk.Msg) error {
+	exec, isExec := msg.(*authz.MsgExec)
+	if !isExec {
+		return k.CheckMsg(ctx, msg)
+	}
+
+	inner, err := exec.GetMessages()
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unpack authz msgexec message: %v", err)
+	}
+	for _, m := range inner {
+		if err := k.CheckMsg(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeTxMsgs decodes protobuf encoded tx bytes into the tx's msgs
+func (k Keeper) decodeTxMsgs(txBytes []byte) ([]sdk.Msg, error) {
+	var raw txtypes.TxRaw
+	if err := k.cdc.Unmarshal(txBytes, &raw); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	var body txtypes.TxBody
+	if err := k.cdc.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	// nolint: exhaustruct
+	tx := txtypes.Tx{Body: &body}
+	return tx.GetMsgs(), nil
+}
+
+// unpackMsgs resolves the Any encoded msgs in the request
+func (k Keeper) unpackMsgs(req *types.QueryCheckTxRequest) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, any := range req.Msgs {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(any, &msg); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unable to unpack msg %d: %v", i, err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// IsExemptAddress checks if address, given in bech32 or hex form, is one of the lock exempt addresses
+func (k Keeper) IsExemptAddress(ctx sdk.Context, address string) bool {
+	if k.IsLockExempt(ctx, address) {
+		return true
+	}
+	// Hex addresses are exempt when their bech32 form is, as checked for EVM txs
+	if common.IsHexAddress(address) {
+		return k.IsLockExempt(ctx, sdk.AccAddress(common.HexToAddress(address).Bytes()).String())
+	}
+	return false
+}
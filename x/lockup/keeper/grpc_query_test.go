@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// Checks the lockup queries against a locked chain with a single exempt address
+func TestQueries(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("althea", "altheapub")
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := input.LockupKeeper
+
+	exemptHex := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	exempt := sdk.AccAddress(exemptHex.Bytes()).String()
+	user := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	k.SetChainLocked(ctx, true)
+	k.SetLockExemptAddresses(ctx, []string{exempt})
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+	// nolint: exhaustruct
+	k.SetLockedMessageTypes(ctx, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+
+	params, err := k.Params(goCtx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, k.GetParams(ctx), params.Params)
+
+	for address, expected := range map[string]bool{exempt: true, exemptHex.Hex(): true, user: false} {
+		res, err := k.IsExempt(goCtx, &types.QueryIsExemptRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Exempt, address)
+	}
+
+	locked, err := k.IsDenomLocked(goCtx, &types.QueryIsDenomLockedRequest{Denom: "aalthea"})
+	require.NoError(t, err)
+	require.True(t, locked.Locked)
+	unlocked, err := k.IsDenomLocked(goCtx, &types.QueryIsDenomLockedRequest{Denom: "unlocked"})
+	require.NoError(t, err)
+	require.False(t, unlocked.Locked)
+
+	send := func(from string, denom string) *codectypes.Any {
+		msg := &banktypes.MsgSend{FromAddress: from, ToAddress: user, Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 1))}
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return any
+	}
+	// nolint: exhaustruct
+	res, err := k.CheckTx(goCtx, &types.QueryCheckTxRequest{Msgs: []*codectypes.Any{send(exempt, "aalthea"), send(user, "unlocked"), send(user, "aalthea")}})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.True(t, res.ChainLocked)
+	require.Len(t, res.MsgVerdicts, 3)
+	require.True(t, res.MsgVerdicts[0].Allowed)
+	require.True(t, res.MsgVerdicts[1].Allowed)
+	require.False(t, res.MsgVerdicts[2].Allowed)
+	require.NotEmpty(t, res.MsgVerdicts[2].Reason)
+
+	// Everything is allowed once the chain unlocks
+	k.SetChainLocked(ctx, false)
+	// nolint: exhaustruct
+	res, err = k.CheckTx(goCtx, &types.QueryCheckTxRequest{Msgs: []*codectypes.Any{send(user, "aalthea")}})
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	locked, err = k.IsDenomLocked(goCtx, &types.QueryIsDenomLockedRequest{Denom: "aalthea"})
+	require.NoError(t, err)
+	require.False(t, locked.Locked)
+}
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,23 +22,73 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 	cdc        codec.BinaryCodec
+
+	// authority is the address allowed to execute the lockup Msgs, typically the gov module account
+	authority string
+
+	// msgInspectors determines the funds moved by each Msg type which may be locked
+	msgInspectors *types.MsgInspectorRegistry
+
+	bankKeeper  types.BankKeeper
+	erc20Keeper types.Erc20Keeper // to be set later via SetErc20Keeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramstypes.Subspace) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramstypes.Subspace,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	msgInspectors := types.NewMsgInspectorRegistry()
+	registerDefaultMsgInspectors(msgInspectors)
+
 	k := Keeper{
-		cdc:        cdc,
-		paramSpace: paramSpace,
-		storeKey:   storeKey,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		storeKey:      storeKey,
+		authority:     authority,
+		msgInspectors: msgInspectors,
+		bankKeeper:    bankKeeper,
+		erc20Keeper:   nil,
 	}
 
 	return k
 }
 
+// SetErc20Keeper injects the erc20 keeper, used by the EVM hooks to identify the ERC20 representations of locked tokens.
+// It panics if called more than once or with a nil argument.
+func (k *Keeper) SetErc20Keeper(erc20Keeper types.Erc20Keeper) {
+	if erc20Keeper == nil {
+		panic("attempted to set a nil erc20Keeper on lockup keeper")
+	}
+	if k.erc20Keeper != nil {
+		panic("erc20Keeper already set on lockup keeper")
+	}
+	k.erc20Keeper = erc20Keeper
+}
+
+// ValidateDependencies ensures all late-bound dependencies have been set; call at end of app constructor.
+func (k Keeper) ValidateDependencies() {
+	if k.erc20Keeper == nil {
+		panic("lockup keeper dependency not set: erc20Keeper")
+	}
+}
+
+// GetAuthority returns the address allowed to execute the lockup Msgs
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // GetParamsIfSet will return the current params, but will return an error if the
 // chain is still initializing. By error checking this function is safe to use in
 // handling genesis transactions.
@@ -47,6 +103,19 @@ func (k Keeper) GetParamsIfSet(ctx sdk.Context) (params types.Params, err error)
 	return
 }
 
+// GetParams returns all of the lockup params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.Params{
+		Locked:                     k.GetChainLocked(ctx),
+		LockExempt:                 k.GetLockExemptAddresses(ctx),
+		LockedMessageTypes:         k.GetLockedMessageTypes(ctx),
+		LockedTokenDenoms:          k.GetLockedTokenDenoms(ctx),
+		UnlockHeight:               k.GetUnlockHeight(ctx),
+		UnlockTime:                 k.GetUnlockTime(ctx),
+		ScheduledLockedTokenDenoms: k.GetScheduledLockedTokenDenoms(ctx),
+	}
+}
+
 // TODO: Doc all these methods
 func (k Keeper) GetChainLocked(ctx sdk.Context) bool {
 	locked := types.DefaultParams().Locked
@@ -58,9 +127,13 @@ func (k Keeper) SetChainLocked(ctx sdk.Context, locked bool) {
 	k.paramSpace.Set(ctx, types.LockedKey, &locked)
 }
 
+// GetLockExemptAddresses returns every lock exempt address, in the order of the store
 func (k Keeper) GetLockExemptAddresses(ctx sdk.Context) []string {
-	lockExempt := types.DefaultParams().LockExempt
-	k.paramSpace.GetIfExists(ctx, types.LockExemptKey, &lockExempt)
+	lockExempt := []string{}
+	k.IterateLockExemptAddresses(ctx, func(address string) (stop bool) {
+		lockExempt = append(lockExempt, address)
+		return false
+	})
 	return lockExempt
 }
 
@@ -68,6 +141,32 @@ func (k Keeper) GetLockExemptAddressesSet(ctx sdk.Context) map[string]struct{} {
 	return createSet(k.GetLockExemptAddresses(ctx))
 }
 
+// IterateLockExemptAddresses calls cb on every lock exempt address until cb returns true
+func (k Keeper) IterateLockExemptAddresses(ctx sdk.Context, cb func(address string) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.LockExemptAddressKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// IsLockExempt checks if address is one of the lock exempt addresses with a single store read
+func (k Keeper) IsLockExempt(ctx sdk.Context, address string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetLockExemptAddressKey(address))
+}
+
+// AddLockExemptAddress makes address exempt from the lockup
+func (k Keeper) AddLockExemptAddress(ctx sdk.Context, address string) {
+	ctx.KVStore(k.storeKey).Set(types.GetLockExemptAddressKey(address), []byte{0x1})
+}
+
+// RemoveLockExemptAddress makes address subject to the lockup again
+func (k Keeper) RemoveLockExemptAddress(ctx sdk.Context, address string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetLockExemptAddressKey(address))
+}
+
 func (k Keeper) GetLockedTokenDenoms(ctx sdk.Context) []string {
 	lockedTokenDenoms := types.DefaultParams().LockedTokenDenoms
 	k.paramSpace.GetIfExists(ctx, types.LockedTokenDenomsKey, &lockedTokenDenoms)
@@ -78,12 +177,14 @@ func (k Keeper) GetLockedTokenDenomsSet(ctx sdk.Context) map[string]struct{} {
 	return createSet(k.GetLockedTokenDenoms(ctx))
 }
 
-// TODO: It would be nice to just store the pseudo-set instead of the string array
-// so that we get better efficiency on each read (happens each transaction in antehandler)
-// however we would need to make a custom param change proposal handler to construct the
-// set upon governance proposal before storage in keeper
+// SetLockExemptAddresses replaces all of the lock exempt addresses with lockExempt
 func (k Keeper) SetLockExemptAddresses(ctx sdk.Context, lockExempt []string) {
-	k.paramSpace.Set(ctx, types.LockExemptKey, &lockExempt)
+	for _, address := range k.GetLockExemptAddresses(ctx) {
+		k.RemoveLockExemptAddress(ctx, address)
+	}
+	for _, address := range lockExempt {
+		k.AddLockExemptAddress(ctx, address)
+	}
 }
 
 func (k Keeper) GetLockedMessageTypes(ctx sdk.Context) []string {
@@ -104,6 +205,39 @@ func (k Keeper) SetLockedTokenDenoms(ctx sdk.Context, lockedTokenDenoms []string
 	k.paramSpace.Set(ctx, types.LockedTokenDenomsKey, &lockedTokenDenoms)
 }
 
+// GetUnlockHeight returns the block height at which the chain will be automatically unlocked, zero if none is scheduled
+func (k Keeper) GetUnlockHeight(ctx sdk.Context) uint64 {
+	unlockHeight := types.DefaultParams().UnlockHeight
+	k.paramSpace.GetIfExists(ctx, types.UnlockHeightKey, &unlockHeight)
+	return unlockHeight
+}
+
+func (k Keeper) SetUnlockHeight(ctx sdk.Context, unlockHeight uint64) {
+	k.paramSpace.Set(ctx, types.UnlockHeightKey, &unlockHeight)
+}
+
+// GetUnlockTime returns the block time at which the chain will be automatically unlocked, the zero time if none is scheduled
+func (k Keeper) GetUnlockTime(ctx sdk.Context) time.Time {
+	unlockTime := types.DefaultParams().UnlockTime
+	k.paramSpace.GetIfExists(ctx, types.UnlockTimeKey, &unlockTime)
+	return unlockTime
+}
+
+func (k Keeper) SetUnlockTime(ctx sdk.Context, unlockTime time.Time) {
+	k.paramSpace.Set(ctx, types.UnlockTimeKey, &unlockTime)
+}
+
+// GetScheduledLockedTokenDenoms returns the pending changes to LockedTokenDenoms
+func (k Keeper) GetScheduledLockedTokenDenoms(ctx sdk.Context) []types.ScheduledLockedTokenDenoms {
+	scheduled := types.DefaultParams().ScheduledLockedTokenDenoms
+	k.paramSpace.GetIfExists(ctx, types.ScheduledLockedTokenDenomsKey, &scheduled)
+	return scheduled
+}
+
+func (k Keeper) SetScheduledLockedTokenDenoms(ctx sdk.Context, scheduled []types.ScheduledLockedTokenDenoms) {
+	k.paramSpace.Set(ctx, types.ScheduledLockedTokenDenomsKey, &scheduled)
+}
+
 func createSet(strings []string) map[string]struct{} {
 	type void struct{}
 	var member void
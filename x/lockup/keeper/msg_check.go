@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// CheckMsg checks if the given msg is permissible under a locked chain, returns an error if not
+// A Msg is not permissibile if it involves the transfer of a locked token via a locked Msg type from a nonexempt address,
+// beyond the amount released to that address by its release schedule
+// All of these conditions are determined by what is stored in the lockup module params
+// CheckMsg does not change any state, the released funds moved by msg are only recorded by CheckMsgExecution
+func (k Keeper) CheckMsg(ctx sdk.Context, msg sdk.Msg) error {
+	return k.checkMsg(ctx, msg, false)
+}
+
+// CheckMsgExecution checks msg like CheckMsg as it is about to execute, then records the locked funds it moves against
+// the release schedules of their senders, so that they count towards the amount released to each sender.
+// The funds moved by a MsgEthereumTx are recorded by the EVM hook once it has executed instead
+func (k Keeper) CheckMsgExecution(ctx sdk.Context, msg sdk.Msg) error {
+	return k.checkMsg(ctx, msg, true)
+}
+
+// checkMsg implements CheckMsg, recording the released funds moved by msg if record is true
+func (k Keeper) checkMsg(ctx sdk.Context, msg sdk.Msg, record bool) error {
+	lockedTokenDenomsSet := k.GetLockedTokenDenomsSet(ctx)
+	lockedMsgTypesSet := k.GetLockedMessageTypesSet(ctx)
+
+	msgType := sdk.MsgTypeURL(msg)
+	if _, typePresent := lockedMsgTypesSet[msgType]; typePresent {
+		// Check that any locked msg is permissible based on the funds its registered MsgInspector says it moves
+		if allow, err := k.allowMessage(ctx, msg, lockedTokenDenomsSet, record); !allow {
+			return errorsmod.Wrap(err, "Transaction blocked because of a message")
+		} else {
+			// The user is exempt, allow it to pass
+			return nil
+		}
+	}
+	if msgType == "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress" {
+		return errorsmod.Wrap(types.ErrLocked, "The chain is locked, only exempt addresses may submit this Msg type")
+	}
+	if msgType == "/cosmos.authz.v1beta1.MsgExec" {
+		return errorsmod.Wrap(types.ErrLocked, "The chain is locked, recursively MsgExec-wrapped Msgs are not allowed")
+	}
+	if msgType == "/ethermint.evm.v1.MsgEthereumTx" {
+		if allow, err := k.allowMessage(ctx, msg, lockedTokenDenomsSet, false); !allow {
+			return errorsmod.Wrap(err, "Transaction blocked because of a message")
+		} else {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// allowMessage checks that an input `msg` moving a token in `lockedTokenDenomsSet` moves it out of only lock exempt
+// addresses, or within the amount released to the address by its release schedule, using the MsgInspector registered with the lockup keeper to determine the funds moved by `msg`
+// Returns (true, nil) if the message should be allowed to execute, (false, non-nil) if there was an issue
+// If record is true the locked funds moved by `msg` are recorded against the release schedules of their senders
+// NOTE: THIS MUST ONLY BE CALLED **AFTER** DETERMINING BOTH THE CHAIN IS LOCKED AND THE `msg` TYPE IS LOCKED,
+// otherwise `msg` will be unnecessarily blocked
+func (k Keeper) allowMessage(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	lockedTokenDenomsSet map[string]struct{},
+	record bool,
+) (bool, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	inspector, found := k.GetMsgInspector(msgType)
+	if !found {
+		return false, errorsmod.Wrap(types.ErrUnhandled,
+			fmt.Sprintf("Message type %v does not have a registered MsgInspector, unable to handle messages like this", msgType),
+		)
+	}
+
+	movedFunds, err := inspector(ctx, msg)
+	if err != nil {
+		return false, errorsmod.Wrapf(types.ErrUnhandled, "unable to inspect %v: %v", msgType, err)
+	}
+	// The locked coins moved by each non-exempt sender, in the order they first appear
+	senders := []string{}
+	lockedCoins := make(map[string]sdk.Coins)
+	for _, funds := range movedFunds {
+		if k.IsLockExempt(ctx, funds.Sender) {
+			continue
+		}
+		// Funds moved by a non-exempt address while the chain is locked up, are any of them a locked coin?
+		for _, coin := range funds.Coins {
+			if _, present := lockedTokenDenomsSet[coin.Denom]; present {
+				if _, seen := lockedCoins[funds.Sender]; !seen {
+					senders = append(senders, funds.Sender)
+				}
+				lockedCoins[funds.Sender] = lockedCoins[funds.Sender].Add(coin)
+			}
+		}
+	}
+	// Locked coins may only be moved up to the amount released by the sender's release schedules
+	for _, sender := range senders {
+		if err := k.allowReleasedFunds(ctx, sender, lockedCoins[sender]); err != nil {
+			return false, errorsmod.Wrapf(err, "unable to move locked denoms with %v", msgType)
+		}
+	}
+	if !record {
+		return true, nil
+	}
+	for _, sender := range senders {
+		for _, coin := range lockedCoins[sender] {
+			if err := k.spendReleasedFunds(ctx, sdk.MustAccAddressFromBech32(sender), coin); err != nil {
+				return false, errorsmod.Wrapf(err, "unable to move locked denoms with %v", msgType)
+			}
+		}
+	}
+	return true, nil
+}
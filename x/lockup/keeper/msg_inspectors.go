@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/AltheaFoundation/althea-L1/config"
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+	microtxtypes "github.com/AltheaFoundation/althea-L1/x/microtx/types"
+)
+
+// RegisterMsgInspector registers inspector for the type of msg, allowing that type to be locked by governance
+func (k Keeper) RegisterMsgInspector(msg sdk.Msg, inspector types.MsgInspector) {
+	k.msgInspectors.RegisterMsgInspector(msg, inspector)
+}
+
+// RegisterMsgInspectors registers every inspector in inspectors by Msg type URL, as provided by other modules
+func (k Keeper) RegisterMsgInspectors(inspectors map[string]types.MsgInspector) {
+	for msgType, inspector := range inspectors {
+		k.msgInspectors.RegisterMsgInspectorForTypeURL(msgType, inspector)
+	}
+}
+
+// GetMsgInspector returns the MsgInspector registered for msgType, if any
+func (k Keeper) GetMsgInspector(msgType string) (types.MsgInspector, bool) {
+	return k.msgInspectors.GetMsgInspector(msgType)
+}
+
+// ValidateMsgInspectors returns an ErrUnhandled error listing the Msg types in msgTypes without a registered
+// MsgInspector, since Msgs of those types could never be checked while locked
+func (k Keeper) ValidateMsgInspectors(msgTypes []string) error {
+	missing := []string{}
+	for _, msgType := range msgTypes {
+		if _, found := k.GetMsgInspector(msgType); !found {
+			missing = append(missing, msgType)
+		}
+	}
+	if len(missing) > 0 {
+		return errorsmod.Wrapf(types.ErrUnhandled, "locked Msg types without a registered MsgInspector: %v", missing)
+	}
+	return nil
+}
+
+// registerDefaultMsgInspectors registers the MsgInspectors for the Msgs of modules which do not provide their own,
+// including every Msg type locked by default
+// nolint: exhaustruct
+func registerDefaultMsgInspectors(r *types.MsgInspectorRegistry) {
+	// ^v^v^v^v^v^v^v^v^v^v^v^v BANK MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	r.RegisterMsgInspector(&banktypes.MsgSend{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgSend, ok := msg.(*banktypes.MsgSend)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgSend.FromAddress, Coins: msgSend.Amount}}, nil
+	})
+	r.RegisterMsgInspector(&banktypes.MsgMultiSend{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgMultiSend, ok := msg.(*banktypes.MsgMultiSend)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		moved := make([]types.MovedFunds, len(msgMultiSend.Inputs))
+		for i, input := range msgMultiSend.Inputs {
+			moved[i] = types.MovedFunds{Sender: input.Address, Coins: input.Coins}
+		}
+		return moved, nil
+	})
+
+	// ^v^v^v^v^v^v^v^v^v^v^v^v IBC TRANSFER MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	r.RegisterMsgInspector(&ibctransfertypes.MsgTransfer{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgTransfer, ok := msg.(*ibctransfertypes.MsgTransfer)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgTransfer.Sender, Coins: sdk.Coins{msgTransfer.Token}}}, nil
+	})
+
+	// ^v^v^v^v^v^v^v^v^v^v^v^v MICROTX MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	r.RegisterMsgInspector(&microtxtypes.MsgMicrotx{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgMicrotx, ok := msg.(*microtxtypes.MsgMicrotx)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgMicrotx.Sender, Coins: sdk.Coins{msgMicrotx.Amount}}}, nil
+	})
+
+	// ^v^v^v^v^v^v^v^v^v^v^v^v EVM MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	// Only the native value sent with the tx is known before execution, the ERC20 transfers of locked tokens
+	// are checked by the lockup EVM hook once the tx has executed
+	r.RegisterMsgInspector(&evmtypes.MsgEthereumTx{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgEvmTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		value := msgEvmTx.AsTransaction().Value()
+		if value == nil || value.Sign() != 1 {
+			return []types.MovedFunds{}, nil
+		}
+		sender := sdk.AccAddress(common.HexToAddress(msgEvmTx.From).Bytes())
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdk.NewIntFromBigInt(value)))
+		return []types.MovedFunds{{Sender: sender.String(), Coins: coins}}, nil
+	})
+
+	// ^v^v^v^v^v^v^v^v^v^v^v^v STAKING MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	r.RegisterMsgInspector(&stakingtypes.MsgDelegate{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgDelegate, ok := msg.(*stakingtypes.MsgDelegate)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgDelegate.DelegatorAddress, Coins: sdk.Coins{msgDelegate.Amount}}}, nil
+	})
+	r.RegisterMsgInspector(&stakingtypes.MsgUndelegate{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgUndelegate, ok := msg.(*stakingtypes.MsgUndelegate)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgUndelegate.DelegatorAddress, Coins: sdk.Coins{msgUndelegate.Amount}}}, nil
+	})
+
+	// ^v^v^v^v^v^v^v^v^v^v^v^v VESTING MODULE MESSAGES ^v^v^v^v^v^v^v^v^v^v^v^v
+	r.RegisterMsgInspector(&vestingtypes.MsgCreateVestingAccount{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgCreate, ok := msg.(*vestingtypes.MsgCreateVestingAccount)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgCreate.FromAddress, Coins: msgCreate.Amount}}, nil
+	})
+	r.RegisterMsgInspector(&vestingtypes.MsgCreatePermanentLockedAccount{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgCreate, ok := msg.(*vestingtypes.MsgCreatePermanentLockedAccount)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		return []types.MovedFunds{{Sender: msgCreate.FromAddress, Coins: msgCreate.Amount}}, nil
+	})
+	r.RegisterMsgInspector(&vestingtypes.MsgCreatePeriodicVestingAccount{}, func(_ sdk.Context, msg sdk.Msg) ([]types.MovedFunds, error) {
+		msgCreate, ok := msg.(*vestingtypes.MsgCreatePeriodicVestingAccount)
+		if !ok {
+			return nil, unexpectedMsgError(msg)
+		}
+		total := sdk.NewCoins()
+		for _, period := range msgCreate.VestingPeriods {
+			total = total.Add(period.Amount...)
+		}
+		return []types.MovedFunds{{Sender: msgCreate.FromAddress, Coins: total}}, nil
+	})
+}
+
+func unexpectedMsgError(msg sdk.Msg) error {
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "lockup MsgInspector called with unexpected Msg %T", msg)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// Checks that every Msg type locked by default has an inspector, and that uninspectable Msg types are reported
+func TestValidateMsgInspectors(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.LockupKeeper
+
+	require.NoError(t, k.ValidateMsgInspectors(types.DefaultParams().LockedMessageTypes))
+	require.NoError(t, k.ValidateMsgInspectors(k.GetLockedMessageTypes(ctx)))
+
+	// nolint: exhaustruct
+	createValidator := sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})
+	err := k.ValidateMsgInspectors(append(types.DefaultParams().LockedMessageTypes, createValidator))
+	require.ErrorIs(t, err, types.ErrUnhandled)
+	require.Contains(t, err.Error(), createValidator)
+
+	// nolint: exhaustruct
+	k.RegisterMsgInspector(&stakingtypes.MsgCreateValidator{}, func(_ sdk.Context, _ sdk.Msg) ([]types.MovedFunds, error) {
+		return []types.MovedFunds{}, nil
+	})
+	require.NoError(t, k.ValidateMsgInspectors([]string{createValidator}))
+}
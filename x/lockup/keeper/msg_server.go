@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the lockup MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AddLockExempt adds the msg's addresses to the lock exempt addresses
+func (m msgServer) AddLockExempt(c context.Context, msg *types.MsgAddLockExempt) (*types.MsgAddLockExemptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, address := range msg.Addresses {
+		if m.IsLockExempt(ctx, address) {
+			continue
+		}
+		m.AddLockExemptAddress(ctx, address)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLockExemptAdded,
+			sdk.NewAttribute(types.LockExemptKeyAddress, address),
+		))
+	}
+
+	return &types.MsgAddLockExemptResponse{}, nil
+}
+
+// RemoveLockExempt removes the msg's addresses from the lock exempt addresses
+func (m msgServer) RemoveLockExempt(c context.Context, msg *types.MsgRemoveLockExempt) (*types.MsgRemoveLockExemptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, address := range msg.Addresses {
+		if !m.IsLockExempt(ctx, address) {
+			return nil, errorsmod.Wrapf(types.ErrNotLockExempt, "cannot remove %s", address)
+		}
+		m.RemoveLockExemptAddress(ctx, address)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLockExemptRemoved,
+			sdk.NewAttribute(types.LockExemptKeyAddress, address),
+		))
+	}
+
+	return &types.MsgRemoveLockExemptResponse{}, nil
+}
+
+// LockDenom adds the msg's denoms to the locked token denoms
+func (m msgServer) LockDenom(c context.Context, msg *types.MsgLockDenom) (*types.MsgLockDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	lockedTokenDenoms := m.GetLockedTokenDenoms(ctx)
+	lockedSet := createSet(lockedTokenDenoms)
+	for _, denom := range msg.Denoms {
+		if _, present := lockedSet[denom]; present {
+			continue
+		}
+		lockedTokenDenoms = append(lockedTokenDenoms, denom)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDenomLocked,
+			sdk.NewAttribute(types.LockedDenomKeyDenom, denom),
+		))
+	}
+	m.SetLockedTokenDenoms(ctx, lockedTokenDenoms)
+
+	return &types.MsgLockDenomResponse{}, nil
+}
+
+// UnlockDenom removes the msg's denoms from the locked token denoms
+func (m msgServer) UnlockDenom(c context.Context, msg *types.MsgUnlockDenom) (*types.MsgUnlockDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	unlockSet := createSet(msg.Denoms)
+	lockedSet := m.GetLockedTokenDenomsSet(ctx)
+	for _, denom := range msg.Denoms {
+		if _, present := lockedSet[denom]; !present {
+			return nil, errorsmod.Wrapf(types.ErrDenomNotLocked, "cannot unlock %s", denom)
+		}
+	}
+
+	remaining := []string{}
+	for _, denom := range m.GetLockedTokenDenoms(ctx) {
+		if _, unlock := unlockSet[denom]; !unlock {
+			remaining = append(remaining, denom)
+		}
+	}
+	// The locked token denoms may never be empty, the whole chain should be unlocked instead
+	if err := types.ValidateLockedTokenDenoms(remaining); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot unlock every denom, unlock the chain instead")
+	}
+	m.SetLockedTokenDenoms(ctx, remaining)
+
+	for _, denom := range msg.Denoms {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDenomUnlocked,
+			sdk.NewAttribute(types.LockedDenomKeyDenom, denom),
+		))
+	}
+
+	return &types.MsgUnlockDenomResponse{}, nil
+}
+
+// SetReleaseSchedule creates or replaces the release schedule of the msg's address and denom, a replacement keeps the
+// amount moved under the schedule it replaces
+func (m msgServer) SetReleaseSchedule(c context.Context, msg *types.MsgSetReleaseSchedule) (*types.MsgSetReleaseScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Schedule.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	schedule := msg.Schedule
+	schedule.Moved = sdk.ZeroInt()
+	if previous, found := m.GetReleaseSchedule(ctx, addr, schedule.Denom); found {
+		schedule.Moved = previous.GetMoved()
+	}
+	m.Keeper.SetReleaseSchedule(ctx, schedule)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseScheduleSet,
+		sdk.NewAttribute(types.ReleaseScheduleKeyAddress, msg.Schedule.Address),
+		sdk.NewAttribute(types.ReleaseScheduleKeyDenom, msg.Schedule.Denom),
+		sdk.NewAttribute(types.ReleaseScheduleKeyAmount, msg.Schedule.Amount.String()),
+	))
+
+	return &types.MsgSetReleaseScheduleResponse{}, nil
+}
+
+// RemoveReleaseSchedule removes the release schedule of the msg's address and denom
+func (m msgServer) RemoveReleaseSchedule(c context.Context, msg *types.MsgRemoveReleaseSchedule) (*types.MsgRemoveReleaseScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, found := m.GetReleaseSchedule(ctx, addr, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrNoReleaseSchedule, "cannot remove the %s schedule of %s", msg.Denom, msg.Address)
+	}
+	m.DeleteReleaseSchedule(ctx, addr, msg.Denom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseScheduleRemoved,
+		sdk.NewAttribute(types.ReleaseScheduleKeyAddress, msg.Address),
+		sdk.NewAttribute(types.ReleaseScheduleKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveReleaseScheduleResponse{}, nil
+}
+
+// checkAuthority ensures the signer of a lockup Msg is the keeper's authority
+func (m msgServer) checkAuthority(authority string) error {
+	if m.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, authority)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// Checks that the governance Msgs incrementally update the lock exempt addresses and locked token denoms
+func TestMsgServer(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := input.LockupKeeper
+	msgServer := NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	addr1 := sdk.AccAddress([]byte("exempt_address_1____")).String()
+	addr2 := sdk.AccAddress([]byte("exempt_address_2____")).String()
+
+	// Only the authority may execute the Msgs
+	_, err := msgServer.AddLockExempt(goCtx, types.NewMsgAddLockExempt(addr1, []string{addr1}))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.False(t, k.IsLockExempt(ctx, addr1))
+
+	_, err = msgServer.AddLockExempt(goCtx, types.NewMsgAddLockExempt(authority, []string{addr1, addr2}))
+	require.NoError(t, err)
+	require.True(t, k.IsLockExempt(ctx, addr1))
+	require.True(t, k.IsLockExempt(ctx, addr2))
+	require.ElementsMatch(t, []string{addr1, addr2}, k.GetLockExemptAddresses(ctx))
+
+	_, err = msgServer.RemoveLockExempt(goCtx, types.NewMsgRemoveLockExempt(authority, []string{addr1}))
+	require.NoError(t, err)
+	require.False(t, k.IsLockExempt(ctx, addr1))
+	require.True(t, k.IsLockExempt(ctx, addr2))
+	_, err = msgServer.RemoveLockExempt(goCtx, types.NewMsgRemoveLockExempt(authority, []string{addr1}))
+	require.ErrorIs(t, err, types.ErrNotLockExempt)
+
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+	_, err = msgServer.LockDenom(goCtx, types.NewMsgLockDenom(authority, []string{"aalthea", "ibc/test"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"aalthea", "ibc/test"}, k.GetLockedTokenDenoms(ctx))
+
+	_, err = msgServer.UnlockDenom(goCtx, types.NewMsgUnlockDenom(authority, []string{"ibc/test"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"aalthea"}, k.GetLockedTokenDenoms(ctx))
+	_, err = msgServer.UnlockDenom(goCtx, types.NewMsgUnlockDenom(authority, []string{"ibc/test"}))
+	require.ErrorIs(t, err, types.ErrDenomNotLocked)
+	// The last locked denom cannot be unlocked
+	_, err = msgServer.UnlockDenom(goCtx, types.NewMsgUnlockDenom(authority, []string{"aalthea"}))
+	require.Error(t, err)
+	require.Equal(t, []string{"aalthea"}, k.GetLockedTokenDenoms(ctx))
+}
+
+func TestMsgValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+	addr := sdk.AccAddress([]byte("exempt_address______")).String()
+
+	require.NoError(t, types.NewMsgAddLockExempt(authority, []string{addr}).ValidateBasic())
+	require.Error(t, types.NewMsgAddLockExempt(authority, []string{}).ValidateBasic())
+	require.Error(t, types.NewMsgAddLockExempt(authority, []string{addr, addr}).ValidateBasic())
+	require.Error(t, types.NewMsgRemoveLockExempt(authority, []string{"0x0000000000000000000000000000000000000000"}).ValidateBasic())
+	require.Error(t, types.NewMsgAddLockExempt("", []string{addr}).ValidateBasic())
+
+	require.NoError(t, types.NewMsgLockDenom(authority, []string{"aalthea", "ibc/test"}).ValidateBasic())
+	require.Error(t, types.NewMsgLockDenom(authority, []string{"!invalid"}).ValidateBasic())
+	require.Error(t, types.NewMsgUnlockDenom(authority, []string{}).ValidateBasic())
+}
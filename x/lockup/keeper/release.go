@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// GetReleaseSchedule returns the release schedule of addr for denom, if any
+func (k Keeper) GetReleaseSchedule(ctx sdk.Context, addr sdk.AccAddress, denom string) (types.ReleaseSchedule, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetReleaseScheduleKey(addr, denom))
+	if bz == nil {
+		return types.ReleaseSchedule{}, false // nolint: exhaustruct
+	}
+	var schedule types.ReleaseSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetReleaseSchedule stores schedule, replacing any schedule with the same address and denom
+func (k Keeper) SetReleaseSchedule(ctx sdk.Context, schedule types.ReleaseSchedule) {
+	addr := sdk.MustAccAddressFromBech32(schedule.Address)
+	ctx.KVStore(k.storeKey).Set(types.GetReleaseScheduleKey(addr, schedule.Denom), k.cdc.MustMarshal(&schedule))
+}
+
+// DeleteReleaseSchedule removes the release schedule of addr for denom
+func (k Keeper) DeleteReleaseSchedule(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetReleaseScheduleKey(addr, denom))
+}
+
+// IterateReleaseSchedules calls cb on every release schedule until cb returns true
+func (k Keeper) IterateReleaseSchedules(ctx sdk.Context, cb func(schedule types.ReleaseSchedule) (stop bool)) {
+	k.iterateReleaseSchedulesWithPrefix(ctx, types.ReleaseScheduleKeyPrefix, cb)
+}
+
+// GetReleaseSchedules returns every release schedule
+func (k Keeper) GetReleaseSchedules(ctx sdk.Context) []types.ReleaseSchedule {
+	schedules := []types.ReleaseSchedule{}
+	k.IterateReleaseSchedules(ctx, func(schedule types.ReleaseSchedule) (stop bool) {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	return schedules
+}
+
+// GetReleaseSchedulesByAddress returns every release schedule of addr
+func (k Keeper) GetReleaseSchedulesByAddress(ctx sdk.Context, addr sdk.AccAddress) []types.ReleaseSchedule {
+	schedules := []types.ReleaseSchedule{}
+	k.iterateReleaseSchedulesWithPrefix(ctx, types.GetReleaseSchedulesByAddressKey(addr), func(schedule types.ReleaseSchedule) (stop bool) {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	return schedules
+}
+
+func (k Keeper) iterateReleaseSchedulesWithPrefix(ctx sdk.Context, keyPrefix []byte, cb func(schedule types.ReleaseSchedule) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.ReleaseSchedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// GetReleaseStatus returns the amounts of schedule which are currently released and locked, along with the balance of
+// the schedule's address and how much of it may be moved while the chain is locked
+func (k Keeper) GetReleaseStatus(ctx sdk.Context, schedule types.ReleaseSchedule) types.ReleaseStatus {
+	height := uint64(ctx.BlockHeight())
+	released := schedule.ReleasedAmount(height, ctx.BlockTime())
+	locked := schedule.LockedAmount(height, ctx.BlockTime())
+	balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(schedule.Address), schedule.Denom).Amount
+	spendable := sdk.MinInt(schedule.UnmovedAmount(height, ctx.BlockTime()), balance)
+
+	return types.ReleaseStatus{
+		Schedule:  schedule,
+		Released:  released,
+		Locked:    locked,
+		Balance:   balance,
+		Spendable: spendable,
+	}
+}
+
+// allowReleasedFunds checks that each of the locked coins moved by sender are covered by a release schedule and do not
+// exceed the part of sender's balance which may be moved, returning an ErrLocked error if not.
+// This is checked before the funds are moved, without recording them against the schedules
+func (k Keeper) allowReleasedFunds(ctx sdk.Context, sender string, lockedCoins sdk.Coins) error {
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrLocked, "The chain is locked, invalid sender %s: %v", sender, err)
+	}
+	for _, coin := range lockedCoins {
+		schedule, found := k.GetReleaseSchedule(ctx, addr, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrLocked,
+				"The chain is locked, only exempt addresses or those with a release schedule may move %s", coin.Denom)
+		}
+		if spendable := k.GetReleaseStatus(ctx, schedule).Spendable; coin.Amount.GT(spendable) {
+			return errorsmod.Wrapf(types.ErrLocked,
+				"The chain is locked, %s exceeds the released amount %s%s", coin, spendable, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// spendReleasedFunds records coin as moved by addr under its release schedule for coin's denom, returning an ErrLocked
+// error if addr has no schedule or the total it has moved would exceed the schedule's released amount. Funds received
+// by addr never raise the amount it may move, so the schedule caps everything moved out of the account
+func (k Keeper) spendReleasedFunds(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	schedule, found := k.GetReleaseSchedule(ctx, addr, coin.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLocked,
+			"The chain is locked, only exempt addresses or those with a release schedule may move %s", coin.Denom)
+	}
+	if unmoved := schedule.UnmovedAmount(uint64(ctx.BlockHeight()), ctx.BlockTime()); coin.Amount.GT(unmoved) {
+		return errorsmod.Wrapf(types.ErrLocked,
+			"The chain is locked, %s exceeds the released amount %s%s", coin, unmoved, coin.Denom)
+	}
+	schedule.Moved = schedule.GetMoved().Add(coin.Amount)
+	k.SetReleaseSchedule(ctx, schedule)
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// Checks that a locked denom may only be moved by a non-exempt address up to the amount released by its schedule
+func TestReleaseSchedule(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.LockupKeeper
+
+	k.SetChainLocked(ctx, true)
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+
+	// The sender holds far more than its schedule will ever release
+	sender := sdk.AccAddress([]byte("release_sender______"))
+	receiver := sdk.AccAddress([]byte("release_receiver____")).String()
+	balance := sdk.NewCoins(sdk.NewInt64Coin("aalthea", 1000000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, balance))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, balance))
+
+	send := func(amount int64) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(sender, sdk.MustAccAddressFromBech32(receiver), sdk.NewCoins(sdk.NewInt64Coin("aalthea", amount)))
+	}
+
+	// Without a schedule the locked denom may not be moved at all
+	require.ErrorIs(t, k.CheckMsg(ctx, send(1)), types.ErrLocked)
+
+	// Release 1000 linearly over the next 100 blocks
+	height := uint64(ctx.BlockHeight())
+	schedule := types.NewHeightReleaseSchedule(sender.String(), "aalthea", sdk.NewInt(1000), types.RELEASE_TYPE_LINEAR, height, height+100)
+	k.SetReleaseSchedule(ctx, schedule)
+	require.ErrorIs(t, k.CheckMsg(ctx, send(1)), types.ErrLocked)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 25)
+	require.NoError(t, k.CheckMsg(ctx, send(250)))
+	require.ErrorIs(t, k.CheckMsg(ctx, send(251)), types.ErrLocked)
+
+	// Checking a Msg records nothing, executing it records the funds it moves against the schedule
+	require.NoError(t, k.CheckMsg(ctx, send(200)))
+	require.NoError(t, k.CheckMsgExecution(ctx, send(200)))
+	require.NoError(t, k.CheckMsg(ctx, send(50)))
+	require.ErrorIs(t, k.CheckMsg(ctx, send(51)), types.ErrLocked)
+	require.ErrorIs(t, k.CheckMsgExecution(ctx, send(51)), types.ErrLocked)
+
+	schedule, found := k.GetReleaseSchedule(ctx, sender, "aalthea")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(200), schedule.Moved)
+	status := k.GetReleaseStatus(ctx, schedule)
+	require.Equal(t, sdk.NewInt(250), status.Released)
+	require.Equal(t, sdk.NewInt(750), status.Locked)
+	require.Equal(t, sdk.NewInt(1000000), status.Balance)
+	require.Equal(t, sdk.NewInt(50), status.Spendable)
+
+	res, err := k.AccountRelease(sdk.WrapSDKContext(ctx), &types.QueryAccountReleaseRequest{Address: sender.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ReleaseStatus{status}, res.Releases)
+
+	// Once the schedule has ended only the rest of its amount may be moved, however much the balance holds
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.ErrorIs(t, k.CheckMsg(ctx, send(801)), types.ErrLocked)
+	require.NoError(t, k.CheckMsgExecution(ctx, send(800)))
+	require.ErrorIs(t, k.CheckMsg(ctx, send(1)), types.ErrLocked)
+	schedule, _ = k.GetReleaseSchedule(ctx, sender, "aalthea")
+	require.Equal(t, sdk.ZeroInt(), k.GetReleaseStatus(ctx, schedule).Spendable)
+
+	// Replacing the schedule keeps the amount already moved
+	msgServer := NewMsgServerImpl(k)
+	replacement := types.NewHeightReleaseSchedule(sender.String(), "aalthea", sdk.NewInt(1500), types.RELEASE_TYPE_CLIFF, 0, height)
+	_, err = msgServer.SetReleaseSchedule(sdk.WrapSDKContext(ctx), types.NewMsgSetReleaseSchedule(k.GetAuthority(), replacement))
+	require.NoError(t, err)
+	require.ErrorIs(t, k.CheckMsg(ctx, send(501)), types.ErrLocked)
+	require.NoError(t, k.CheckMsg(ctx, send(500)))
+
+	// Removing the schedule locks the denom again, and the schedules are exported in genesis
+	require.Len(t, ExportGenesis(ctx, k).ReleaseSchedules, 1)
+	k.DeleteReleaseSchedule(ctx, sender, "aalthea")
+	require.ErrorIs(t, k.CheckMsg(ctx, send(1)), types.ErrLocked)
+	require.Empty(t, k.GetReleaseSchedules(ctx))
+}
@@ -56,6 +56,7 @@ import (
 // TestInput stores the various keepers required to test lockup
 type TestInput struct {
 	ParamsKeeper paramskeeper.Keeper
+	BankKeeper   bankkeeper.BaseKeeper
 	LockupKeeper Keeper
 	Context      sdk.Context
 	Codec        codec.Codec
@@ -109,6 +110,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 	// Initialize memory database and mount stores on it
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(lockupKey, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyAcc, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, storetypes.StoreTypeIAVL, db)
@@ -221,12 +223,16 @@ func CreateTestEnv(t *testing.T) TestInput {
 	govKeeper.SetDepositParams(ctx, govv1.DefaultDepositParams())
 	govKeeper.SetVotingParams(ctx, govv1.DefaultVotingParams())
 	govKeeper.SetTallyParams(ctx, govv1.DefaultTallyParams())
-	k := NewKeeper(protoCodec, lockupKey, getSubspace(paramsKeeper, types.ModuleName))
+	k := NewKeeper(
+		protoCodec, lockupKey, getSubspace(paramsKeeper, types.ModuleName), bankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	InitGenesis(ctx, k, *types.DefaultGenesisState())
 
 	return TestInput{
 		ParamsKeeper: paramsKeeper,
+		BankKeeper:   bankKeeper,
 		LockupKeeper: k,
 		Context:      ctx,
 		Codec:        protoCodec,
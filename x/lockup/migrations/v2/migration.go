@@ -0,0 +1,22 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// UpdateParams sets the params introduced in consensus version 2 to their default values
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	defaults := types.DefaultParams()
+	paramstore.Set(ctx, types.UnlockHeightKey, defaults.UnlockHeight)
+	paramstore.Set(ctx, types.UnlockTimeKey, defaults.UnlockTime)
+	paramstore.Set(ctx, types.ScheduledLockedTokenDenomsKey, defaults.ScheduledLockedTokenDenoms)
+	return nil
+}
@@ -0,0 +1,57 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	v2 "github.com/AltheaFoundation/althea-L1/x/lockup/migrations/v2"
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	lockupKey := sdk.NewKVStoreKey(lockuptypes.StoreKey)
+	tLockupKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", lockuptypes.StoreKey))
+	ctx := testutil.DefaultContext(lockupKey, tLockupKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, lockupKey, tLockupKey, "lockup",
+	)
+	paramstore = paramstore.WithKeyTable(lockuptypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, lockuptypes.UnlockHeightKey))
+	require.False(t, paramstore.Has(ctx, lockuptypes.UnlockTimeKey))
+	require.False(t, paramstore.Has(ctx, lockuptypes.ScheduledLockedTokenDenomsKey))
+
+	// Run migrations
+	err := v2.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, lockuptypes.UnlockHeightKey))
+	require.True(t, paramstore.Has(ctx, lockuptypes.UnlockTimeKey))
+	require.True(t, paramstore.Has(ctx, lockuptypes.ScheduledLockedTokenDenomsKey))
+
+	var unlockHeight uint64
+	var unlockTime time.Time
+	var scheduled []lockuptypes.ScheduledLockedTokenDenoms
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, lockuptypes.UnlockHeightKey, &unlockHeight)
+		paramstore.Get(ctx, lockuptypes.UnlockTimeKey, &unlockTime)
+		paramstore.Get(ctx, lockuptypes.ScheduledLockedTokenDenomsKey, &scheduled)
+	})
+	require.Equal(t, uint64(0), unlockHeight)
+	require.True(t, unlockTime.IsZero())
+	require.Empty(t, scheduled)
+}
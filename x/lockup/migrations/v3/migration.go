@@ -0,0 +1,35 @@
+package v3
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// MigrateLockExempt moves the LockExempt addresses param into the lockup store, where they are held from consensus
+// version 3. The param is no longer registered in the key table so its stale value is left untouched in x/params.
+func MigrateLockExempt(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore *paramtypes.Subspace) error {
+	bz := paramstore.GetRaw(ctx, types.LockExemptKey)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	// The param store holds values as amino JSON, which is plain JSON for a list of strings
+	var lockExempt []string
+	if err := json.Unmarshal(bz, &lockExempt); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	store := ctx.KVStore(storeKey)
+	for _, address := range lockExempt {
+		store.Set(types.GetLockExemptAddressKey(address), []byte{0x1})
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	v3 "github.com/AltheaFoundation/althea-L1/x/lockup/migrations/v3"
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+func TestMigrateLockExempt(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	lockupKey := sdk.NewKVStoreKey(lockuptypes.StoreKey)
+	tLockupKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", lockuptypes.StoreKey))
+	ctx := testutil.DefaultContext(lockupKey, tLockupKey)
+	// Register the LockExempt param as it was before consensus version 3
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, lockupKey, tLockupKey, "lockup",
+	)
+	paramstore = paramstore.WithKeyTable(paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(lockuptypes.LockExemptKey, []string{}, lockuptypes.ValidateLockExempt),
+	))
+
+	exempt := []string{
+		sdk.AccAddress([]byte("exempt_address_1____")).String(),
+		sdk.AccAddress([]byte("exempt_address_2____")).String(),
+	}
+	paramstore.Set(ctx, lockuptypes.LockExemptKey, exempt)
+
+	// Run migrations
+	err := v3.MigrateLockExempt(ctx, lockupKey, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the addresses are in the store
+	store := ctx.KVStore(lockupKey)
+	for _, address := range exempt {
+		require.True(t, store.Has(lockuptypes.GetLockExemptAddressKey(address)))
+	}
+	require.False(t, store.Has(lockuptypes.GetLockExemptAddressKey(sdk.AccAddress([]byte("not_exempt_address__")).String())))
+}
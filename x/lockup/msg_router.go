@@ -0,0 +1,91 @@
+package lockup
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/keeper"
+)
+
+// MsgServiceMiddleware enforces the lockup rules at execution time for every Msg, whatever its origin.
+// The LockAnteDecorator only sees the Msgs of submitted Txs, but Msgs can also be executed later by x/group proposals,
+// x/gov proposals, or ICA host packets, which are all dispatched through the app's MsgServiceRouter.
+//
+// The middleware is a gRPC server used in place of the MsgServiceRouter when registering Msg services, it wraps
+// every Msg handler with a lockup check before registering the service with the underlying router.
+type MsgServiceMiddleware struct {
+	next         gogogrpc.Server
+	lockupKeeper keeper.Keeper
+}
+
+var _ gogogrpc.Server = MsgServiceMiddleware{}
+
+// NewMsgServiceMiddleware returns a MsgServiceMiddleware registering lockup checked Msg services with next,
+// typically the app's MsgServiceRouter
+func NewMsgServiceMiddleware(next gogogrpc.Server, lockupKeeper keeper.Keeper) MsgServiceMiddleware {
+	return MsgServiceMiddleware{next: next, lockupKeeper: lockupKeeper}
+}
+
+// RegisterService implements the gRPC Server.RegisterService method, registering sd with the underlying router
+// after wrapping each of its method handlers with the lockup check
+func (msm MsgServiceMiddleware) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	wrapped := *sd
+	wrapped.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		methodHandler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return methodHandler(srv, ctx, dec, msm.wrapInterceptor(interceptor))
+			},
+		}
+	}
+
+	msm.next.RegisterService(&wrapped, handler)
+}
+
+// wrapInterceptor returns an interceptor which runs the router's interceptor, checking the Msg against the lockup
+// rules once the router has placed the sdk.Context on the request context and before the Msg handler is called.
+// Without an interceptor the Msg handler would be called unchecked, so a nil interceptor panics. The MsgServiceRouter
+// calls every handler with an interceptor as the service is registered, so a router which does not is caught at wiring.
+func (msm MsgServiceMiddleware) wrapInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if interceptor == nil {
+		panic("lockup MsgServiceMiddleware requires the router to call Msg handlers with an interceptor")
+	}
+
+	return func(goCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		checkedHandler := func(goCtx context.Context, req interface{}) (interface{}, error) {
+			msg, ok := req.(sdk.Msg)
+			if !ok {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected sdk.Msg, got %T", req)
+			}
+			if err := msm.checkMsg(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+				return nil, err
+			}
+			return handler(goCtx, req)
+		}
+		return interceptor(goCtx, req, info, checkedHandler)
+	}
+}
+
+// checkMsg returns an error if msg may not execute on the chain in its current lockup state
+func (msm MsgServiceMiddleware) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	// The check must not change the gas used by any Msg
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !msm.lockupKeeper.GetChainLocked(ctx) {
+		return nil
+	}
+	// The Msgs inside of an authz MsgExec are dispatched through the router, so they are each checked on execution
+	if _, isExec := msg.(*authz.MsgExec); isExec {
+		return nil
+	}
+
+	return msm.lockupKeeper.CheckMsgExecution(ctx, msg)
+}
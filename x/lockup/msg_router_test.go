@@ -0,0 +1,98 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/lockup/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/lockup/types"
+)
+
+// mockBankMsgServer counts the Msgs which reach the bank Msg handlers
+type mockBankMsgServer struct {
+	calls *int
+}
+
+func (m mockBankMsgServer) Send(context.Context, *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	*m.calls++
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (m mockBankMsgServer) MultiSend(context.Context, *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	*m.calls++
+	return &banktypes.MsgMultiSendResponse{}, nil
+}
+
+// nilInterceptorRouter calls every Msg handler without an interceptor as services are registered, unlike the
+// MsgServiceRouter
+type nilInterceptorRouter struct{}
+
+func (nilInterceptorRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		_, _ = method.Handler(handler, context.Background(), func(interface{}) error { return nil }, nil)
+	}
+}
+
+// Checks that Msgs dispatched through the router, as x/group, x/gov, and ICA do, are subject to the lockup
+func TestMsgServiceMiddleware(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	appCodec := keeper.MakeTestMarshaler()
+	k := input.LockupKeeper
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(appCodec.InterfaceRegistry())
+	calls := 0
+	banktypes.RegisterMsgServer(NewMsgServiceMiddleware(router, k), mockBankMsgServer{calls: &calls})
+
+	exempt := sdk.AccAddress([]byte("exempt_address______")).String()
+	nonExempt := sdk.AccAddress([]byte("non_exempt_address__")).String()
+	k.SetChainLocked(ctx, true)
+	k.SetLockExemptAddresses(ctx, []string{exempt})
+	k.SetLockedTokenDenoms(ctx, []string{"aalthea"})
+
+	locked := sdk.NewCoins(sdk.NewInt64Coin("aalthea", 100))
+	unlocked := sdk.NewCoins(sdk.NewInt64Coin("ibc/test", 100))
+	handler := router.Handler(&banktypes.MsgSend{}) // nolint: exhaustruct
+	require.NotNil(t, handler)
+
+	// A non-exempt sender may not send a locked denom
+	_, err := handler(ctx, &banktypes.MsgSend{FromAddress: nonExempt, ToAddress: exempt, Amount: locked})
+	require.ErrorIs(t, err, types.ErrLocked)
+	require.Equal(t, 0, calls)
+
+	// But may send any other denom
+	_, err = handler(ctx, &banktypes.MsgSend{FromAddress: nonExempt, ToAddress: exempt, Amount: unlocked})
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	// Exempt senders may send locked denoms
+	_, err = handler(ctx, &banktypes.MsgSend{FromAddress: exempt, ToAddress: nonExempt, Amount: locked})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// Once unlocked anyone may send locked denoms, without the check consuming any gas
+	k.SetChainLocked(ctx, false)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = handler(ctx, &banktypes.MsgSend{FromAddress: nonExempt, ToAddress: exempt, Amount: locked})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, gasBefore, ctx.GasMeter().GasConsumed())
+}
+
+// Checks that the middleware refuses to wrap a router which would call the Msg handlers without the lockup check
+func TestMsgServiceMiddlewareNilInterceptor(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	calls := 0
+	require.Panics(t, func() {
+		banktypes.RegisterMsgServer(NewMsgServiceMiddleware(nilInterceptorRouter{}, input.LockupKeeper), mockBankMsgServer{calls: &calls})
+	})
+	require.Equal(t, 0, calls)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// ModuleCdc is the codec for the module
+var ModuleCdc = codec.NewLegacyAmino()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
+
+// RegisterInterfaces registers the interfaces for the proto stuff
+// nolint: exhaustruct
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddLockExempt{},
+		&MsgRemoveLockExempt{},
+		&MsgLockDenom{},
+		&MsgUnlockDenom{},
+		&MsgSetReleaseSchedule{},
+		&MsgRemoveReleaseSchedule{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterCodec registers concrete types on the Amino codec
+// nolint: exhaustruct
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddLockExempt{}, "lockup/MsgAddLockExempt", nil)
+	cdc.RegisterConcrete(&MsgRemoveLockExempt{}, "lockup/MsgRemoveLockExempt", nil)
+	cdc.RegisterConcrete(&MsgLockDenom{}, "lockup/MsgLockDenom", nil)
+	cdc.RegisterConcrete(&MsgUnlockDenom{}, "lockup/MsgUnlockDenom", nil)
+	cdc.RegisterConcrete(&MsgSetReleaseSchedule{}, "lockup/MsgSetReleaseSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveReleaseSchedule{}, "lockup/MsgRemoveReleaseSchedule", nil)
+}
@@ -13,4 +13,10 @@ var (
 	// ErrUnhandled the message type to be locked does not yet have logic
 	// specified for how to check it should be blocked
 	ErrUnhandled = sdkerrors.Register(RootCodespace, 2, "unhandled")
+	// ErrNotLockExempt the address to remove is not one of the lock exempt addresses
+	ErrNotLockExempt = sdkerrors.Register(RootCodespace, 3, "not lock exempt")
+	// ErrDenomNotLocked the denom to unlock is not one of the locked token denoms
+	ErrDenomNotLocked = sdkerrors.Register(RootCodespace, 4, "denom not locked")
+	// ErrNoReleaseSchedule the address does not have a release schedule for the denom
+	ErrNoReleaseSchedule = sdkerrors.Register(RootCodespace, 5, "no release schedule")
 )
@@ -0,0 +1,33 @@
+package types
+
+const (
+	EventTypeChainUnlocked = "chain-unlocked"
+
+	ChainUnlockedKeyHeight = "height"
+	ChainUnlockedKeyTime   = "time"
+
+	EventTypeLockedTokenDenomsChanged = "locked-token-denoms-changed"
+
+	LockedTokenDenomsChangedKeyDenoms = "locked_token_denoms"
+)
+
+const (
+	EventTypeLockExemptAdded   = "lock-exempt-added"
+	EventTypeLockExemptRemoved = "lock-exempt-removed"
+
+	LockExemptKeyAddress = "address"
+
+	EventTypeDenomLocked   = "denom-locked"
+	EventTypeDenomUnlocked = "denom-unlocked"
+
+	LockedDenomKeyDenom = "denom"
+)
+
+const (
+	EventTypeReleaseScheduleSet     = "release-schedule-set"
+	EventTypeReleaseScheduleRemoved = "release-schedule-removed"
+
+	ReleaseScheduleKeyAddress = "address"
+	ReleaseScheduleKeyDenom   = "denom"
+	ReleaseScheduleKeyAmount  = "amount"
+)
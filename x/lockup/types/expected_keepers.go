@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+)
+
+// Erc20Keeper defines only the methods of the erc20 module needed by lockup to identify the ERC20 representations
+// of locked token denoms
+type Erc20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+}
+
+// BankKeeper defines only the methods of the bank module needed by lockup to enforce the release schedules
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -19,7 +20,8 @@ import (
 // DefaultGenesisState creates a simple GenesisState suitible for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:           DefaultParams(),
+		ReleaseSchedules: []ReleaseSchedule{},
 	}
 }
 
@@ -47,6 +49,9 @@ func DefaultParams() *Params {
 		LockedTokenDenoms: []string{
 			config.BaseDenom,
 		},
+		UnlockHeight:               0,
+		UnlockTime:                 time.Time{},
+		ScheduledLockedTokenDenoms: []ScheduledLockedTokenDenoms{},
 	}
 }
 
@@ -60,6 +65,18 @@ func (s GenesisState) ValidateBasic() error {
 	if err := ValidateLockedTokenDenoms(s.Params.LockedTokenDenoms); err != nil {
 		return errorsmod.Wrap(err, "Invalid LockedTokenDenoms GenesisState")
 	}
+	if err := ValidateUnlockHeight(s.Params.UnlockHeight); err != nil {
+		return errorsmod.Wrap(err, "Invalid UnlockHeight GenesisState")
+	}
+	if err := ValidateUnlockTime(s.Params.UnlockTime); err != nil {
+		return errorsmod.Wrap(err, "Invalid UnlockTime GenesisState")
+	}
+	if err := ValidateScheduledLockedTokenDenoms(s.Params.ScheduledLockedTokenDenoms); err != nil {
+		return errorsmod.Wrap(err, "Invalid ScheduledLockedTokenDenoms GenesisState")
+	}
+	if err := ValidateReleaseSchedules(s.ReleaseSchedules); err != nil {
+		return errorsmod.Wrap(err, "Invalid ReleaseSchedules GenesisState")
+	}
 	return nil
 }
 
@@ -107,21 +124,71 @@ func ValidateLockedTokenDenoms(i interface{}) error {
 	return nil
 }
 
+func ValidateUnlockHeight(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid unlock height type: %T", i)
+	}
+	return nil
+}
+
+func ValidateUnlockTime(i interface{}) error {
+	v, ok := i.(time.Time)
+	if !ok {
+		return fmt.Errorf("invalid unlock time type: %T", i)
+	}
+	if !v.IsZero() && v.Unix() <= 0 {
+		return fmt.Errorf("unlock time must be after the unix epoch or unset: %v", v)
+	}
+	return nil
+}
+
+func ValidateScheduledLockedTokenDenoms(i interface{}) error {
+	v, ok := i.([]ScheduledLockedTokenDenoms)
+	if !ok {
+		return fmt.Errorf("invalid scheduled locked token denoms type: %T", i)
+	}
+	for i, scheduled := range v {
+		if (scheduled.Height == 0) == scheduled.Time.IsZero() {
+			return fmt.Errorf("scheduled locked token denoms %d must set exactly one of height or time", i)
+		}
+		if !scheduled.Time.IsZero() && scheduled.Time.Unix() <= 0 {
+			return fmt.Errorf("scheduled locked token denoms %d time must be after the unix epoch: %v", i, scheduled.Time)
+		}
+		if err := ValidateLockedTokenDenoms(scheduled.LockedTokenDenoms); err != nil {
+			return errorsmod.Wrapf(err, "scheduled locked token denoms %d", i)
+		}
+		for _, denom := range scheduled.LockedTokenDenoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return errorsmod.Wrapf(err, "scheduled locked token denoms %d", i)
+			}
+		}
+	}
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{
-		Locked:             false,
-		LockExempt:         []string{},
-		LockedMessageTypes: []string{},
-		LockedTokenDenoms:  []string{},
+		Locked:                     false,
+		LockExempt:                 []string{},
+		LockedMessageTypes:         []string{},
+		LockedTokenDenoms:          []string{},
+		UnlockHeight:               0,
+		UnlockTime:                 time.Time{},
+		ScheduledLockedTokenDenoms: []ScheduledLockedTokenDenoms{},
 	})
 }
 
+// ParamSetPairs returns the params held in x/params, the LockExempt addresses are held in the lockup store instead
+// so they are not included
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(LockedKey, &p.Locked, ValidateLocked),
-		paramtypes.NewParamSetPair(LockExemptKey, &p.LockExempt, ValidateLockExempt),
 		paramtypes.NewParamSetPair(LockedMessageTypesKey, &p.LockedMessageTypes, ValidateLockedMessageTypes),
 		paramtypes.NewParamSetPair(LockedTokenDenomsKey, &p.LockedTokenDenoms, ValidateLockedTokenDenoms),
+		paramtypes.NewParamSetPair(UnlockHeightKey, &p.UnlockHeight, ValidateUnlockHeight),
+		paramtypes.NewParamSetPair(UnlockTimeKey, &p.UnlockTime, ValidateUnlockTime),
+		paramtypes.NewParamSetPair(ScheduledLockedTokenDenomsKey, &p.ScheduledLockedTokenDenoms, ValidateScheduledLockedTokenDenoms),
 	}
 }
@@ -5,16 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,17 +28,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReleaseType determines how the amount of a ReleaseSchedule is released over time
+type ReleaseType int32
+
+const (
+	// RELEASE_TYPE_UNSPECIFIED is invalid
+	RELEASE_TYPE_UNSPECIFIED ReleaseType = 0
+	// RELEASE_TYPE_LINEAR releases the amount in proportion to the heights or time elapsed between the start and end
+	RELEASE_TYPE_LINEAR ReleaseType = 1
+	// RELEASE_TYPE_CLIFF releases the whole amount at once, at the end height or time
+	RELEASE_TYPE_CLIFF ReleaseType = 2
+)
+
+var ReleaseType_name = map[int32]string{
+	0: "RELEASE_TYPE_UNSPECIFIED",
+	1: "RELEASE_TYPE_LINEAR",
+	2: "RELEASE_TYPE_CLIFF",
+}
+
+var ReleaseType_value = map[string]int32{
+	"RELEASE_TYPE_UNSPECIFIED": 0,
+	"RELEASE_TYPE_LINEAR":      1,
+	"RELEASE_TYPE_CLIFF":       2,
+}
+
+func (x ReleaseType) String() string {
+	return proto.EnumName(ReleaseType_name, int32(x))
+}
+
+func (ReleaseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b4337200cfe7f856, []int{0}
+}
+
 // Params struct
 type Params struct {
 	// The lockup module is engaged if locked is true (chain is "locked up")
 	Locked bool `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
-	// Addresses not affected by the lockup module
+	// Addresses not affected by the lockup module. These are held in the lockup store rather than x/params,
+	// and can only be changed by governance with MsgAddLockExempt and MsgRemoveLockExempt
 	LockExempt []string `protobuf:"bytes,2,rep,name=lock_exempt,json=lockExempt,proto3" json:"lock_exempt,omitempty"`
 	// Messages with one of these types are blocked when the chain is locked up
 	// and not sent from a lock_exempt address
 	LockedMessageTypes []string `protobuf:"bytes,3,rep,name=locked_message_types,json=lockedMessageTypes,proto3" json:"locked_message_types,omitempty"`
 	// These tokens will be the only ones blocked while the chain is locked
 	LockedTokenDenoms []string `protobuf:"bytes,4,rep,name=locked_token_denoms,json=lockedTokenDenoms,proto3" json:"locked_token_denoms,omitempty"`
+	// If nonzero, the chain is automatically unlocked at the start of this block height
+	UnlockHeight uint64 `protobuf:"varint,5,opt,name=unlock_height,json=unlockHeight,proto3" json:"unlock_height,omitempty"`
+	// If set, the chain is automatically unlocked at the start of the first block at or after this time
+	UnlockTime time.Time `protobuf:"bytes,6,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	// Changes to locked_token_denoms which are automatically applied once their height or time arrives
+	ScheduledLockedTokenDenoms []ScheduledLockedTokenDenoms `protobuf:"bytes,7,rep,name=scheduled_locked_token_denoms,json=scheduledLockedTokenDenoms,proto3" json:"scheduled_locked_token_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,15 +141,205 @@ func (m *Params) GetLockedTokenDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetUnlockHeight() uint64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (m *Params) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetScheduledLockedTokenDenoms() []ScheduledLockedTokenDenoms {
+	if m != nil {
+		return m.ScheduledLockedTokenDenoms
+	}
+	return nil
+}
+
+// ScheduledLockedTokenDenoms replaces the locked_token_denoms param at the start of the first block at or after
+// the given height or time, exactly one of which must be set
+type ScheduledLockedTokenDenoms struct {
+	Height            uint64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time              time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	LockedTokenDenoms []string  `protobuf:"bytes,3,rep,name=locked_token_denoms,json=lockedTokenDenoms,proto3" json:"locked_token_denoms,omitempty"`
+}
+
+func (m *ScheduledLockedTokenDenoms) Reset()         { *m = ScheduledLockedTokenDenoms{} }
+func (m *ScheduledLockedTokenDenoms) String() string { return proto.CompactTextString(m) }
+func (*ScheduledLockedTokenDenoms) ProtoMessage()    {}
+func (*ScheduledLockedTokenDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4337200cfe7f856, []int{1}
+}
+func (m *ScheduledLockedTokenDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledLockedTokenDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledLockedTokenDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledLockedTokenDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledLockedTokenDenoms.Merge(m, src)
+}
+func (m *ScheduledLockedTokenDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledLockedTokenDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledLockedTokenDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledLockedTokenDenoms proto.InternalMessageInfo
+
+func (m *ScheduledLockedTokenDenoms) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledLockedTokenDenoms) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ScheduledLockedTokenDenoms) GetLockedTokenDenoms() []string {
+	if m != nil {
+		return m.LockedTokenDenoms
+	}
+	return nil
+}
+
+// ReleaseSchedule allows a non-exempt address to move part of its balance of a locked denom while the chain is locked.
+// The schedule is measured in either block heights or block time, so exactly one of end_height or end_time must be set.
+// The address may move the denom out of its account until the total it has moved reaches the released part of amount,
+// however much of the denom it holds
+type ReleaseSchedule struct {
+	// address is the bech32 address the schedule applies to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the locked token denom released by the schedule
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount released by the schedule once it has ended
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	ReleaseType ReleaseType                            `protobuf:"varint,4,opt,name=release_type,json=releaseType,proto3,enum=althea.lockup.v1.ReleaseType" json:"release_type,omitempty"`
+	// start_height is the height at which a height based linear release begins, unused by cliff releases
+	StartHeight uint64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which a height based release has released the whole amount
+	EndHeight uint64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// start_time is the time at which a time based linear release begins, unused by cliff releases
+	StartTime time.Time `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which a time based release has released the whole amount
+	EndTime time.Time `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// moved is the total the address has moved under the schedule while the chain was locked, kept by the lockup module
+	Moved github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=moved,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"moved"`
+}
+
+func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
+func (m *ReleaseSchedule) String() string { return proto.CompactTextString(m) }
+func (*ReleaseSchedule) ProtoMessage()    {}
+func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4337200cfe7f856, []int{2}
+}
+func (m *ReleaseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSchedule.Merge(m, src)
+}
+func (m *ReleaseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSchedule proto.InternalMessageInfo
+
+func (m *ReleaseSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReleaseSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ReleaseSchedule) GetReleaseType() ReleaseType {
+	if m != nil {
+		return m.ReleaseType
+	}
+	return RELEASE_TYPE_UNSPECIFIED
+}
+
+func (m *ReleaseSchedule) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 type GenesisState struct {
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params           *Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ReleaseSchedules []ReleaseSchedule `protobuf:"bytes,2,rep,name=release_schedules,json=releaseSchedules,proto3" json:"release_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4337200cfe7f856, []int{1}
+	return fileDescriptor_b4337200cfe7f856, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,33 +375,70 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetReleaseSchedules() []ReleaseSchedule {
+	if m != nil {
+		return m.ReleaseSchedules
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("althea.lockup.v1.ReleaseType", ReleaseType_name, ReleaseType_value)
 	proto.RegisterType((*Params)(nil), "althea.lockup.v1.Params")
+	proto.RegisterType((*ScheduledLockedTokenDenoms)(nil), "althea.lockup.v1.ScheduledLockedTokenDenoms")
+	proto.RegisterType((*ReleaseSchedule)(nil), "althea.lockup.v1.ReleaseSchedule")
 	proto.RegisterType((*GenesisState)(nil), "althea.lockup.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("althea/lockup/v1/genesis.proto", fileDescriptor_b4337200cfe7f856) }
 
 var fileDescriptor_b4337200cfe7f856 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xaf, 0x9f, 0x22, 0x70, 0x19, 0xc0, 0x20, 0x94, 0xc9, 0x54, 0x9d, 0xba, 0x60,
-	0x37, 0xc0, 0x03, 0x00, 0xe2, 0xcf, 0x02, 0x08, 0x85, 0x4e, 0x2c, 0x91, 0xdb, 0x5c, 0xa5, 0x51,
-	0x9b, 0xd8, 0xaa, 0x9d, 0xaa, 0xbc, 0x05, 0xef, 0xc0, 0xcb, 0x30, 0x76, 0x64, 0x44, 0xc9, 0x8b,
-	0x20, 0xdb, 0x61, 0x61, 0xb3, 0xcf, 0xef, 0xdc, 0xab, 0x7b, 0x0e, 0xa6, 0x62, 0x69, 0xe6, 0x20,
-	0xf8, 0x52, 0xce, 0x16, 0xb5, 0xe2, 0xeb, 0x98, 0xe7, 0x50, 0x81, 0x2e, 0x34, 0x53, 0x2b, 0x69,
-	0x24, 0xd9, 0xf7, 0x9c, 0x79, 0xce, 0xd6, 0xf1, 0xf0, 0x03, 0xe1, 0xf0, 0x59, 0xac, 0x44, 0xa9,
-	0xc9, 0x31, 0x0e, 0xad, 0x0e, 0x59, 0x84, 0x06, 0x68, 0xb4, 0x93, 0x74, 0x3f, 0x72, 0x82, 0xfb,
-	0xf6, 0x95, 0xc2, 0x06, 0x4a, 0x65, 0xa2, 0x7f, 0x83, 0xde, 0x68, 0x37, 0xc1, 0x56, 0xba, 0x75,
-	0x0a, 0x19, 0xe3, 0x23, 0x6f, 0x4d, 0x4b, 0xd0, 0x5a, 0xe4, 0x90, 0x9a, 0x37, 0x05, 0x3a, 0xea,
-	0x39, 0x27, 0xf1, 0xec, 0xd1, 0xa3, 0x89, 0x25, 0x84, 0xe1, 0xc3, 0x6e, 0xc2, 0xc8, 0x05, 0x54,
-	0x69, 0x06, 0x95, 0x2c, 0x75, 0xf4, 0xdf, 0x0d, 0x1c, 0x78, 0x34, 0xb1, 0xe4, 0xc6, 0x81, 0xe1,
-	0x25, 0xde, 0xbb, 0xf7, 0x41, 0x5e, 0x8c, 0x30, 0x40, 0xc6, 0x38, 0x54, 0xee, 0x68, 0x77, 0x6a,
-	0xff, 0x2c, 0x62, 0x7f, 0x83, 0x31, 0x1f, 0x2a, 0xe9, 0x7c, 0xd7, 0x4f, 0x9f, 0x0d, 0x45, 0xdb,
-	0x86, 0xa2, 0xef, 0x86, 0xa2, 0xf7, 0x96, 0x06, 0xdb, 0x96, 0x06, 0x5f, 0x2d, 0x0d, 0x5e, 0x2f,
-	0xf2, 0xc2, 0xcc, 0xeb, 0x29, 0x9b, 0xc9, 0x92, 0x5f, 0xb9, 0x2d, 0x77, 0xb2, 0xae, 0x32, 0x61,
-	0x0a, 0x59, 0x71, 0xbf, 0xf6, 0xf4, 0x21, 0xe6, 0x9b, 0xdf, 0x52, 0x5d, 0xb6, 0x69, 0xe8, 0x0a,
-	0x3d, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x9b, 0x15, 0x71, 0x72, 0x01, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x4a,
+	0x10, 0x8f, 0x9b, 0x34, 0x7f, 0xc6, 0x79, 0xef, 0xa5, 0xdb, 0xaa, 0xcf, 0x8a, 0xa8, 0x93, 0x06,
+	0x09, 0x45, 0x88, 0xda, 0x6d, 0xe0, 0xc0, 0x0d, 0x9a, 0xd6, 0x81, 0x48, 0xa1, 0xaa, 0x9c, 0x70,
+	0x80, 0x4b, 0xe4, 0xc4, 0x8b, 0x13, 0x35, 0xf6, 0x46, 0xd9, 0x75, 0x55, 0xbe, 0x00, 0xe2, 0xd8,
+	0x13, 0x47, 0x2e, 0x7c, 0x99, 0x1e, 0x7b, 0x04, 0x0e, 0x05, 0xb5, 0x5f, 0x04, 0x79, 0xd6, 0x16,
+	0x4d, 0x4b, 0x0e, 0xe5, 0x14, 0xcf, 0xfc, 0x66, 0x66, 0x67, 0x7e, 0xbf, 0xc9, 0x80, 0xee, 0x4c,
+	0xc4, 0x88, 0x3a, 0xe6, 0x84, 0x0d, 0x8f, 0xc2, 0xa9, 0x79, 0xbc, 0x63, 0x7a, 0x34, 0xa0, 0x7c,
+	0xcc, 0x8d, 0xe9, 0x8c, 0x09, 0x46, 0x4a, 0x12, 0x37, 0x24, 0x6e, 0x1c, 0xef, 0x94, 0xd7, 0x3c,
+	0xe6, 0x31, 0x04, 0xcd, 0xe8, 0x4b, 0xc6, 0x95, 0x2b, 0x1e, 0x63, 0xde, 0x84, 0x9a, 0x68, 0x0d,
+	0xc2, 0x77, 0xa6, 0x18, 0xfb, 0x94, 0x0b, 0xc7, 0x9f, 0xca, 0x80, 0xda, 0x87, 0x34, 0x64, 0x0f,
+	0x9d, 0x99, 0xe3, 0x73, 0xb2, 0x0e, 0xd9, 0xa8, 0x1c, 0x75, 0x35, 0xa5, 0xaa, 0xd4, 0xf3, 0x76,
+	0x6c, 0x91, 0x0a, 0xa8, 0xd1, 0x57, 0x9f, 0x9e, 0x50, 0x7f, 0x2a, 0xb4, 0xa5, 0x6a, 0xba, 0x5e,
+	0xb0, 0x21, 0x72, 0x59, 0xe8, 0x21, 0xdb, 0xb0, 0x26, 0x43, 0xfb, 0x3e, 0xe5, 0xdc, 0xf1, 0x68,
+	0x5f, 0xbc, 0x9f, 0x52, 0xae, 0xa5, 0x31, 0x92, 0x48, 0xec, 0x95, 0x84, 0x7a, 0x11, 0x42, 0x0c,
+	0x58, 0x8d, 0x33, 0x04, 0x3b, 0xa2, 0x41, 0xdf, 0xa5, 0x01, 0xf3, 0xb9, 0x96, 0xc1, 0x84, 0x15,
+	0x09, 0xf5, 0x22, 0x64, 0x1f, 0x01, 0x72, 0x1f, 0xfe, 0x09, 0x03, 0x6c, 0x62, 0x44, 0xc7, 0xde,
+	0x48, 0x68, 0xcb, 0x55, 0xa5, 0x9e, 0xb1, 0x8b, 0xd2, 0xf9, 0x12, 0x7d, 0xc4, 0x02, 0x35, 0x0e,
+	0x8a, 0x86, 0xd4, 0xb2, 0x55, 0xa5, 0xae, 0x36, 0xca, 0x86, 0x64, 0xc0, 0x48, 0x18, 0x30, 0x7a,
+	0x09, 0x03, 0xcd, 0xfc, 0xd9, 0x45, 0x25, 0x75, 0xfa, 0xa3, 0xa2, 0xd8, 0x20, 0x13, 0x23, 0x88,
+	0x84, 0xb0, 0xc1, 0x87, 0x23, 0xea, 0x86, 0x13, 0xea, 0xf6, 0xff, 0xd4, 0x65, 0xae, 0x9a, 0xae,
+	0xab, 0x8d, 0x47, 0xc6, 0x4d, 0x09, 0x8c, 0x6e, 0x92, 0xd6, 0xb9, 0x39, 0x40, 0x33, 0x13, 0x3d,
+	0x65, 0x97, 0xf9, 0xc2, 0x88, 0xda, 0x67, 0x05, 0xca, 0x8b, 0x0b, 0x44, 0xe2, 0xc4, 0xa3, 0x2b,
+	0x38, 0x7a, 0x6c, 0x91, 0xa7, 0x90, 0xc1, 0x69, 0x97, 0xee, 0x30, 0x2d, 0x66, 0x2c, 0xd2, 0x20,
+	0xbd, 0x40, 0x83, 0xda, 0xb7, 0x34, 0xfc, 0x67, 0xd3, 0x09, 0x75, 0x38, 0x4d, 0xfa, 0x24, 0x1a,
+	0xe4, 0x1c, 0xd7, 0x9d, 0x51, 0xce, 0xb1, 0xad, 0x82, 0x9d, 0x98, 0x64, 0x0d, 0x96, 0xb1, 0x20,
+	0x36, 0x56, 0xb0, 0xa5, 0x41, 0x5a, 0x90, 0x75, 0x7c, 0x16, 0x06, 0x42, 0x4b, 0x47, 0xee, 0xa6,
+	0x11, 0xf5, 0xf4, 0xfd, 0xa2, 0xf2, 0xc0, 0x1b, 0x8b, 0x51, 0x38, 0x30, 0x86, 0xcc, 0x37, 0x87,
+	0x8c, 0xfb, 0x8c, 0xc7, 0x3f, 0x5b, 0xdc, 0x3d, 0x32, 0x71, 0x9b, 0x8c, 0x76, 0x20, 0xec, 0x38,
+	0x9b, 0x3c, 0x87, 0xe2, 0x4c, 0xb6, 0x82, 0xab, 0xa6, 0x65, 0xaa, 0x4a, 0xfd, 0xdf, 0xc6, 0xc6,
+	0x6d, 0x49, 0xe2, 0x86, 0xa3, 0xad, 0xb3, 0xd5, 0xd9, 0x6f, 0x83, 0x6c, 0x42, 0x91, 0x0b, 0x67,
+	0x26, 0xe6, 0x17, 0x4a, 0x45, 0x5f, 0xbc, 0x4f, 0x1b, 0x00, 0x34, 0x70, 0x93, 0x80, 0x2c, 0x06,
+	0x14, 0x68, 0xe0, 0xc6, 0xf0, 0x1e, 0x80, 0xac, 0x80, 0xfc, 0xe7, 0xee, 0xc0, 0x7f, 0x01, 0xf3,
+	0x70, 0xd9, 0x9e, 0x41, 0x3e, 0x7a, 0x03, 0x4b, 0xe4, 0xef, 0x50, 0x22, 0x47, 0x03, 0x17, 0x0b,
+	0xec, 0xc3, 0xb2, 0xcf, 0x8e, 0xa9, 0xab, 0x15, 0xfe, 0x8a, 0x50, 0x99, 0x5c, 0xfb, 0xa4, 0x40,
+	0xf1, 0x85, 0x3c, 0x30, 0x5d, 0xe1, 0x08, 0x4a, 0xb6, 0x21, 0x3b, 0xc5, 0xab, 0x80, 0xba, 0xaa,
+	0x0d, 0xed, 0x36, 0xb5, 0xf2, 0x6a, 0xd8, 0x71, 0x1c, 0xe9, 0xc1, 0x4a, 0x22, 0x49, 0xb2, 0xe5,
+	0x1c, 0x6f, 0x85, 0xda, 0xd8, 0x5c, 0xa8, 0x4b, 0xb2, 0x48, 0xf1, 0xff, 0xa3, 0x34, 0x9b, 0x77,
+	0xf3, 0x87, 0x03, 0x50, 0xaf, 0x49, 0x48, 0xee, 0x81, 0x66, 0x5b, 0x1d, 0x6b, 0xb7, 0x6b, 0xf5,
+	0x7b, 0x6f, 0x0e, 0xad, 0xfe, 0xeb, 0x83, 0xee, 0xa1, 0xb5, 0xd7, 0x6e, 0xb5, 0xad, 0xfd, 0x52,
+	0x8a, 0xfc, 0x0f, 0xab, 0x73, 0x68, 0xa7, 0x7d, 0x60, 0xed, 0xda, 0x25, 0x85, 0xac, 0x03, 0x99,
+	0x03, 0xf6, 0x3a, 0xed, 0x56, 0xab, 0xb4, 0x54, 0xce, 0x7c, 0xfc, 0xa2, 0xa7, 0x9a, 0x07, 0x67,
+	0x97, 0xba, 0x72, 0x7e, 0xa9, 0x2b, 0x3f, 0x2f, 0x75, 0xe5, 0xf4, 0x4a, 0x4f, 0x9d, 0x5f, 0xe9,
+	0xa9, 0xaf, 0x57, 0x7a, 0xea, 0xed, 0x93, 0x6b, 0x2c, 0xee, 0xe2, 0x08, 0x2d, 0x16, 0x06, 0xae,
+	0x23, 0xc6, 0x2c, 0x30, 0xe5, 0x4c, 0x5b, 0x9d, 0x1d, 0xf3, 0x24, 0x39, 0xd3, 0xc8, 0xeb, 0x20,
+	0x8b, 0xca, 0x3d, 0xfe, 0x35, 0x00, 0xa1, 0x70, 0x07, 0x24, 0xc4, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -189,6 +461,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledLockedTokenDenoms) > 0 {
+		for iNdEx := len(m.ScheduledLockedTokenDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledLockedTokenDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.UnlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.LockedTokenDenoms) > 0 {
 		for iNdEx := len(m.LockedTokenDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.LockedTokenDenoms[iNdEx])
@@ -229,6 +528,139 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledLockedTokenDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledLockedTokenDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledLockedTokenDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedTokenDenoms) > 0 {
+		for iNdEx := len(m.LockedTokenDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedTokenDenoms[iNdEx])
+			copy(dAtA[i:], m.LockedTokenDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockedTokenDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Moved.Size()
+		i -= size
+		if _, err := m.Moved.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReleaseType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReleaseType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -249,6 +681,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReleaseSchedules) > 0 {
+		for iNdEx := len(m.ReleaseSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -302,6 +748,71 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.UnlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.UnlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledLockedTokenDenoms) > 0 {
+		for _, e := range m.ScheduledLockedTokenDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledLockedTokenDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LockedTokenDenoms) > 0 {
+		for _, s := range m.LockedTokenDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ReleaseType != 0 {
+		n += 1 + sovGenesis(uint64(m.ReleaseType))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Moved.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -315,6 +826,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ReleaseSchedules) > 0 {
+		for _, e := range m.ReleaseSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -469,24 +986,549 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.LockedTokenDenoms = append(m.LockedTokenDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			m.UnlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledLockedTokenDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledLockedTokenDenoms = append(m.ScheduledLockedTokenDenoms, ScheduledLockedTokenDenoms{})
+			if err := m.ScheduledLockedTokenDenoms[len(m.ScheduledLockedTokenDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledLockedTokenDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledLockedTokenDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledLockedTokenDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedTokenDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedTokenDenoms = append(m.LockedTokenDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseType", wireType)
+			}
+			m.ReleaseType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseType |= ReleaseType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Moved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -555,6 +1597,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseSchedules = append(m.ReleaseSchedules, ReleaseSchedule{})
+			if err := m.ReleaseSchedules[len(m.ReleaseSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
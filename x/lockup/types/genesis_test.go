@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,3 +31,25 @@ func TestValidation(t *testing.T) {
 	err = ValidateLockedTokenDenoms(nil)
 	assert.NotNil(t, err, "badGenesis lockedTokenDenoms did not produce an error in validation fn")
 }
+
+func TestValidateScheduledLockedTokenDenoms(t *testing.T) {
+	byHeight := ScheduledLockedTokenDenoms{Height: 100, Time: time.Time{}, LockedTokenDenoms: []string{"aalthea"}}
+	byTime := ScheduledLockedTokenDenoms{Height: 0, Time: time.Unix(1000, 0), LockedTokenDenoms: []string{"aalthea"}}
+	err := ValidateScheduledLockedTokenDenoms([]ScheduledLockedTokenDenoms{byHeight, byTime})
+	assert.Nil(t, err, "error produced from valid scheduled locked token denoms %v", err)
+
+	both := byHeight
+	both.Time = byTime.Time
+	err = ValidateScheduledLockedTokenDenoms([]ScheduledLockedTokenDenoms{both})
+	assert.NotNil(t, err, "scheduled change with a height and a time did not produce an error")
+
+	neither := byHeight
+	neither.Height = 0
+	err = ValidateScheduledLockedTokenDenoms([]ScheduledLockedTokenDenoms{neither})
+	assert.NotNil(t, err, "scheduled change without a height or time did not produce an error")
+
+	noDenoms := byHeight
+	noDenoms.LockedTokenDenoms = []string{}
+	err = ValidateScheduledLockedTokenDenoms([]ScheduledLockedTokenDenoms{noDenoms})
+	assert.NotNil(t, err, "scheduled change without denoms did not produce an error")
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MovedFunds describes Coins moved out of the account of Sender (a bech32 address) by a Msg
+type MovedFunds struct {
+	Sender string
+	Coins  sdk.Coins
+}
+
+// MsgInspector extracts every movement of funds out of an account made by a Msg, so that the lockup module can block
+// the Msg when a non-exempt address moves a locked token denom
+type MsgInspector func(ctx sdk.Context, msg sdk.Msg) ([]MovedFunds, error)
+
+// MsgInspectorRegistry holds the MsgInspector for each inspectable Msg type URL.
+// Only Msg types with a registered MsgInspector may be added to the LockedMessageTypes param, which allows governance
+// to lock any of them without a binary upgrade
+type MsgInspectorRegistry struct {
+	inspectors map[string]MsgInspector
+}
+
+func NewMsgInspectorRegistry() *MsgInspectorRegistry {
+	return &MsgInspectorRegistry{inspectors: make(map[string]MsgInspector)}
+}
+
+// RegisterMsgInspector registers inspector for the type of msg, panicking if the type already has an inspector
+func (r *MsgInspectorRegistry) RegisterMsgInspector(msg sdk.Msg, inspector MsgInspector) {
+	r.RegisterMsgInspectorForTypeURL(sdk.MsgTypeURL(msg), inspector)
+}
+
+// RegisterMsgInspectorForTypeURL registers inspector for msgType, panicking if msgType already has an inspector
+func (r *MsgInspectorRegistry) RegisterMsgInspectorForTypeURL(msgType string, inspector MsgInspector) {
+	if inspector == nil {
+		panic(fmt.Sprintf("attempted to register a nil lockup MsgInspector for %s", msgType))
+	}
+	if _, present := r.inspectors[msgType]; present {
+		panic(fmt.Sprintf("lockup MsgInspector already registered for %s", msgType))
+	}
+	r.inspectors[msgType] = inspector
+}
+
+// GetMsgInspector returns the MsgInspector registered for msgType, if any
+func (r *MsgInspectorRegistry) GetMsgInspector(msgType string) (MsgInspector, bool) {
+	inspector, present := r.inspectors[msgType]
+	return inspector, present
+}
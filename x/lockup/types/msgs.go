@@ -0,0 +1,271 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authlegacy "github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	TypeMsgAddLockExempt    = "add_lock_exempt"
+	TypeMsgRemoveLockExempt = "remove_lock_exempt"
+	TypeMsgLockDenom        = "lock_denom"
+	TypeMsgUnlockDenom      = "unlock_denom"
+
+	TypeMsgSetReleaseSchedule    = "set_release_schedule"
+	TypeMsgRemoveReleaseSchedule = "remove_release_schedule"
+)
+
+// nolint: exhaustruct
+var (
+	_ sdk.Msg              = &MsgAddLockExempt{}
+	_ sdk.Msg              = &MsgRemoveLockExempt{}
+	_ sdk.Msg              = &MsgLockDenom{}
+	_ sdk.Msg              = &MsgUnlockDenom{}
+	_ authlegacy.LegacyMsg = &MsgAddLockExempt{}
+	_ authlegacy.LegacyMsg = &MsgRemoveLockExempt{}
+	_ authlegacy.LegacyMsg = &MsgLockDenom{}
+	_ authlegacy.LegacyMsg = &MsgUnlockDenom{}
+	_ sdk.Msg              = &MsgSetReleaseSchedule{}
+	_ sdk.Msg              = &MsgRemoveReleaseSchedule{}
+	_ authlegacy.LegacyMsg = &MsgSetReleaseSchedule{}
+	_ authlegacy.LegacyMsg = &MsgRemoveReleaseSchedule{}
+)
+
+// NewMsgAddLockExempt returns a new MsgAddLockExempt
+func NewMsgAddLockExempt(authority string, addresses []string) *MsgAddLockExempt {
+	return &MsgAddLockExempt{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgAddLockExempt) Route() string { return RouterKey }
+
+func (msg MsgAddLockExempt) Type() string { return TypeMsgAddLockExempt }
+
+// ValidateBasic checks for a valid authority and valid, unique addresses
+func (msg *MsgAddLockExempt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg add lock exempt")
+	}
+	return errorsmod.Wrap(validateAddresses(msg.Addresses), "lockup msg add lock exempt")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgAddLockExempt) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAddLockExempt) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRemoveLockExempt returns a new MsgRemoveLockExempt
+func NewMsgRemoveLockExempt(authority string, addresses []string) *MsgRemoveLockExempt {
+	return &MsgRemoveLockExempt{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRemoveLockExempt) Route() string { return RouterKey }
+
+func (msg MsgRemoveLockExempt) Type() string { return TypeMsgRemoveLockExempt }
+
+// ValidateBasic checks for a valid authority and valid, unique addresses
+func (msg *MsgRemoveLockExempt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg remove lock exempt")
+	}
+	return errorsmod.Wrap(validateAddresses(msg.Addresses), "lockup msg remove lock exempt")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgRemoveLockExempt) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveLockExempt) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgLockDenom returns a new MsgLockDenom
+func NewMsgLockDenom(authority string, denoms []string) *MsgLockDenom {
+	return &MsgLockDenom{
+		Authority: authority,
+		Denoms:    denoms,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgLockDenom) Route() string { return RouterKey }
+
+func (msg MsgLockDenom) Type() string { return TypeMsgLockDenom }
+
+// ValidateBasic checks for a valid authority and valid, unique denoms
+func (msg *MsgLockDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg lock denom")
+	}
+	return errorsmod.Wrap(validateDenoms(msg.Denoms), "lockup msg lock denom")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgLockDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgLockDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgUnlockDenom returns a new MsgUnlockDenom
+func NewMsgUnlockDenom(authority string, denoms []string) *MsgUnlockDenom {
+	return &MsgUnlockDenom{
+		Authority: authority,
+		Denoms:    denoms,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgUnlockDenom) Route() string { return RouterKey }
+
+func (msg MsgUnlockDenom) Type() string { return TypeMsgUnlockDenom }
+
+// ValidateBasic checks for a valid authority and valid, unique denoms
+func (msg *MsgUnlockDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg unlock denom")
+	}
+	return errorsmod.Wrap(validateDenoms(msg.Denoms), "lockup msg unlock denom")
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgUnlockDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnlockDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgSetReleaseSchedule returns a new MsgSetReleaseSchedule
+func NewMsgSetReleaseSchedule(authority string, schedule ReleaseSchedule) *MsgSetReleaseSchedule {
+	return &MsgSetReleaseSchedule{
+		Authority: authority,
+		Schedule:  schedule,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSetReleaseSchedule) Route() string { return RouterKey }
+
+func (msg MsgSetReleaseSchedule) Type() string { return TypeMsgSetReleaseSchedule }
+
+// ValidateBasic checks for a valid authority and a valid release schedule
+func (msg *MsgSetReleaseSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg set release schedule")
+	}
+	if err := msg.Schedule.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !msg.Schedule.GetMoved().IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the moved amount of a release schedule is kept by the lockup module")
+	}
+	return nil
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgSetReleaseSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetReleaseSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRemoveReleaseSchedule returns a new MsgRemoveReleaseSchedule
+func NewMsgRemoveReleaseSchedule(authority string, address string, denom string) *MsgRemoveReleaseSchedule {
+	return &MsgRemoveReleaseSchedule{
+		Authority: authority,
+		Address:   address,
+		Denom:     denom,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRemoveReleaseSchedule) Route() string { return RouterKey }
+
+func (msg MsgRemoveReleaseSchedule) Type() string { return TypeMsgRemoveReleaseSchedule }
+
+// ValidateBasic checks for a valid authority, address and denom
+func (msg *MsgRemoveReleaseSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority in lockup msg remove release schedule")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSigners requires the Authority to be the signer
+func (msg *MsgRemoveReleaseSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveReleaseSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// validateAddresses checks that addresses is a non-empty list of unique bech32 addresses
+func validateAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no addresses provided")
+	}
+	if err := ValidateLockExempt(addresses); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return validateUnique(addresses)
+}
+
+// validateDenoms checks that denoms is a non-empty list of unique, valid denoms
+func validateDenoms(denoms []string) error {
+	if len(denoms) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no denoms provided")
+	}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+	return validateUnique(denoms)
+}
+
+func validateUnique(values []string) error {
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if _, present := seen[value]; present {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("duplicate entry %s", value))
+		}
+		seen[value] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,3271 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: althea/lockup/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryIsExemptRequest is the request type for the Query/IsExempt RPC method.
+type QueryIsExemptRequest struct {
+	// address is either a bech32 or a hex (EVM) address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryIsExemptRequest) Reset()         { *m = QueryIsExemptRequest{} }
+func (m *QueryIsExemptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsExemptRequest) ProtoMessage()    {}
+func (*QueryIsExemptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{2}
+}
+func (m *QueryIsExemptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsExemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsExemptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsExemptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsExemptRequest.Merge(m, src)
+}
+func (m *QueryIsExemptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsExemptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsExemptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsExemptRequest proto.InternalMessageInfo
+
+func (m *QueryIsExemptRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIsExemptResponse is the response type for the Query/IsExempt RPC method.
+type QueryIsExemptResponse struct {
+	Exempt bool `protobuf:"varint,1,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *QueryIsExemptResponse) Reset()         { *m = QueryIsExemptResponse{} }
+func (m *QueryIsExemptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsExemptResponse) ProtoMessage()    {}
+func (*QueryIsExemptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{3}
+}
+func (m *QueryIsExemptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsExemptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsExemptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsExemptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsExemptResponse.Merge(m, src)
+}
+func (m *QueryIsExemptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsExemptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsExemptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsExemptResponse proto.InternalMessageInfo
+
+func (m *QueryIsExemptResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
+	}
+	return false
+}
+
+// QueryIsDenomLockedRequest is the request type for the Query/IsDenomLocked RPC method.
+type QueryIsDenomLockedRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryIsDenomLockedRequest) Reset()         { *m = QueryIsDenomLockedRequest{} }
+func (m *QueryIsDenomLockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsDenomLockedRequest) ProtoMessage()    {}
+func (*QueryIsDenomLockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{4}
+}
+func (m *QueryIsDenomLockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsDenomLockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsDenomLockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsDenomLockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsDenomLockedRequest.Merge(m, src)
+}
+func (m *QueryIsDenomLockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsDenomLockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsDenomLockedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsDenomLockedRequest proto.InternalMessageInfo
+
+func (m *QueryIsDenomLockedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryIsDenomLockedResponse is the response type for the Query/IsDenomLocked RPC method.
+type QueryIsDenomLockedResponse struct {
+	// locked is true when the chain is locked and the denom is one of the locked token denoms
+	Locked bool `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	// chain_locked is true when the lockup rules are currently enforced
+	ChainLocked bool `protobuf:"varint,2,opt,name=chain_locked,json=chainLocked,proto3" json:"chain_locked,omitempty"`
+}
+
+func (m *QueryIsDenomLockedResponse) Reset()         { *m = QueryIsDenomLockedResponse{} }
+func (m *QueryIsDenomLockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsDenomLockedResponse) ProtoMessage()    {}
+func (*QueryIsDenomLockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{5}
+}
+func (m *QueryIsDenomLockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsDenomLockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsDenomLockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsDenomLockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsDenomLockedResponse.Merge(m, src)
+}
+func (m *QueryIsDenomLockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsDenomLockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsDenomLockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsDenomLockedResponse proto.InternalMessageInfo
+
+func (m *QueryIsDenomLockedResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *QueryIsDenomLockedResponse) GetChainLocked() bool {
+	if m != nil {
+		return m.ChainLocked
+	}
+	return false
+}
+
+// QueryCheckTxRequest is the request type for the Query/CheckTx RPC method.
+// Either tx_bytes or msgs must be provided, if tx_bytes is set then msgs is ignored
+type QueryCheckTxRequest struct {
+	// tx_bytes is a protobuf encoded tx, exactly as it would be broadcast
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// msgs are the messages of an unsigned tx, used when tx_bytes is empty
+	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *QueryCheckTxRequest) Reset()         { *m = QueryCheckTxRequest{} }
+func (m *QueryCheckTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTxRequest) ProtoMessage()    {}
+func (*QueryCheckTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{6}
+}
+func (m *QueryCheckTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTxRequest.Merge(m, src)
+}
+func (m *QueryCheckTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckTxRequest proto.InternalMessageInfo
+
+func (m *QueryCheckTxRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueryCheckTxRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// QueryCheckTxResponse is the response type for the Query/CheckTx RPC method.
+type QueryCheckTxResponse struct {
+	// allowed is true when every message in the tx is allowed by the lockup rules
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// chain_locked is true when the lockup rules are currently enforced
+	ChainLocked bool `protobuf:"varint,2,opt,name=chain_locked,json=chainLocked,proto3" json:"chain_locked,omitempty"`
+	// msg_verdicts holds the verdict for each top-level message of the tx
+	MsgVerdicts []MsgVerdict `protobuf:"bytes,3,rep,name=msg_verdicts,json=msgVerdicts,proto3" json:"msg_verdicts"`
+}
+
+func (m *QueryCheckTxResponse) Reset()         { *m = QueryCheckTxResponse{} }
+func (m *QueryCheckTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckTxResponse) ProtoMessage()    {}
+func (*QueryCheckTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{7}
+}
+func (m *QueryCheckTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckTxResponse.Merge(m, src)
+}
+func (m *QueryCheckTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckTxResponse proto.InternalMessageInfo
+
+func (m *QueryCheckTxResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryCheckTxResponse) GetChainLocked() bool {
+	if m != nil {
+		return m.ChainLocked
+	}
+	return false
+}
+
+func (m *QueryCheckTxResponse) GetMsgVerdicts() []MsgVerdict {
+	if m != nil {
+		return m.MsgVerdicts
+	}
+	return nil
+}
+
+// MsgVerdict holds the verdict of the lockup rules for a single message
+type MsgVerdict struct {
+	// msg_type_url is the type url of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// allowed is true if the message may execute under the current lockup rules
+	Allowed bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason explains why a message is not allowed, and is empty for allowed messages
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgVerdict) Reset()         { *m = MsgVerdict{} }
+func (m *MsgVerdict) String() string { return proto.CompactTextString(m) }
+func (*MsgVerdict) ProtoMessage()    {}
+func (*MsgVerdict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{8}
+}
+func (m *MsgVerdict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerdict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerdict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerdict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerdict.Merge(m, src)
+}
+func (m *MsgVerdict) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerdict) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerdict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerdict proto.InternalMessageInfo
+
+func (m *MsgVerdict) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgVerdict) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *MsgVerdict) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// QueryReleaseSchedulesRequest is the request type for the Query/ReleaseSchedules RPC method.
+type QueryReleaseSchedulesRequest struct {
+}
+
+func (m *QueryReleaseSchedulesRequest) Reset()         { *m = QueryReleaseSchedulesRequest{} }
+func (m *QueryReleaseSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseSchedulesRequest) ProtoMessage()    {}
+func (*QueryReleaseSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{9}
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseSchedulesRequest.Merge(m, src)
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseSchedulesRequest proto.InternalMessageInfo
+
+// QueryReleaseSchedulesResponse is the response type for the Query/ReleaseSchedules RPC method.
+type QueryReleaseSchedulesResponse struct {
+	ReleaseSchedules []ReleaseSchedule `protobuf:"bytes,1,rep,name=release_schedules,json=releaseSchedules,proto3" json:"release_schedules"`
+}
+
+func (m *QueryReleaseSchedulesResponse) Reset()         { *m = QueryReleaseSchedulesResponse{} }
+func (m *QueryReleaseSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseSchedulesResponse) ProtoMessage()    {}
+func (*QueryReleaseSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{10}
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseSchedulesResponse.Merge(m, src)
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseSchedulesResponse) GetReleaseSchedules() []ReleaseSchedule {
+	if m != nil {
+		return m.ReleaseSchedules
+	}
+	return nil
+}
+
+// QueryAccountReleaseRequest is the request type for the Query/AccountRelease RPC method.
+type QueryAccountReleaseRequest struct {
+	// address is either a bech32 or a hex (EVM) address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountReleaseRequest) Reset()         { *m = QueryAccountReleaseRequest{} }
+func (m *QueryAccountReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountReleaseRequest) ProtoMessage()    {}
+func (*QueryAccountReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{11}
+}
+func (m *QueryAccountReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountReleaseRequest.Merge(m, src)
+}
+func (m *QueryAccountReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountReleaseRequest proto.InternalMessageInfo
+
+func (m *QueryAccountReleaseRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountReleaseResponse is the response type for the Query/AccountRelease RPC method.
+type QueryAccountReleaseResponse struct {
+	// releases holds the status of each release schedule of the address
+	Releases []ReleaseStatus `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases"`
+}
+
+func (m *QueryAccountReleaseResponse) Reset()         { *m = QueryAccountReleaseResponse{} }
+func (m *QueryAccountReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountReleaseResponse) ProtoMessage()    {}
+func (*QueryAccountReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{12}
+}
+func (m *QueryAccountReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountReleaseResponse.Merge(m, src)
+}
+func (m *QueryAccountReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountReleaseResponse proto.InternalMessageInfo
+
+func (m *QueryAccountReleaseResponse) GetReleases() []ReleaseStatus {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+// ReleaseStatus holds the current state of a release schedule
+type ReleaseStatus struct {
+	Schedule ReleaseSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// released is the part of the schedule's amount which has been released so far
+	Released github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=released,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"released"`
+	// locked is the part of the schedule's amount which has not been released yet
+	Locked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
+	// balance is the account's current balance of the schedule's denom
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// spendable is the part of the released amount which has not been moved yet, limited to balance, which may
+	// currently be moved while the chain is locked
+	Spendable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=spendable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spendable"`
+}
+
+func (m *ReleaseStatus) Reset()         { *m = ReleaseStatus{} }
+func (m *ReleaseStatus) String() string { return proto.CompactTextString(m) }
+func (*ReleaseStatus) ProtoMessage()    {}
+func (*ReleaseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3763c805eb71c58e, []int{13}
+}
+func (m *ReleaseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseStatus.Merge(m, src)
+}
+func (m *ReleaseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseStatus proto.InternalMessageInfo
+
+func (m *ReleaseStatus) GetSchedule() ReleaseSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ReleaseSchedule{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "althea.lockup.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "althea.lockup.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryIsExemptRequest)(nil), "althea.lockup.v1.QueryIsExemptRequest")
+	proto.RegisterType((*QueryIsExemptResponse)(nil), "althea.lockup.v1.QueryIsExemptResponse")
+	proto.RegisterType((*QueryIsDenomLockedRequest)(nil), "althea.lockup.v1.QueryIsDenomLockedRequest")
+	proto.RegisterType((*QueryIsDenomLockedResponse)(nil), "althea.lockup.v1.QueryIsDenomLockedResponse")
+	proto.RegisterType((*QueryCheckTxRequest)(nil), "althea.lockup.v1.QueryCheckTxRequest")
+	proto.RegisterType((*QueryCheckTxResponse)(nil), "althea.lockup.v1.QueryCheckTxResponse")
+	proto.RegisterType((*MsgVerdict)(nil), "althea.lockup.v1.MsgVerdict")
+	proto.RegisterType((*QueryReleaseSchedulesRequest)(nil), "althea.lockup.v1.QueryReleaseSchedulesRequest")
+	proto.RegisterType((*QueryReleaseSchedulesResponse)(nil), "althea.lockup.v1.QueryReleaseSchedulesResponse")
+	proto.RegisterType((*QueryAccountReleaseRequest)(nil), "althea.lockup.v1.QueryAccountReleaseRequest")
+	proto.RegisterType((*QueryAccountReleaseResponse)(nil), "althea.lockup.v1.QueryAccountReleaseResponse")
+	proto.RegisterType((*ReleaseStatus)(nil), "althea.lockup.v1.ReleaseStatus")
+}
+
+func init() { proto.RegisterFile("althea/lockup/v1/query.proto", fileDescriptor_3763c805eb71c58e) }
+
+var fileDescriptor_3763c805eb71c58e = []byte{
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x89, 0xe3, 0xbc, 0xa4, 0x28, 0x0c, 0xa6, 0xda, 0x2c, 0xc1, 0x49, 0x17, 0x92,
+	0x06, 0x82, 0x77, 0x71, 0x8a, 0x7a, 0xe0, 0x96, 0x94, 0x56, 0x14, 0xa5, 0x08, 0x4c, 0x00, 0xa9,
+	0x17, 0x77, 0xbc, 0x3b, 0xac, 0xad, 0xec, 0xee, 0x6c, 0x77, 0x66, 0x53, 0x5b, 0x55, 0x05, 0xe2,
+	0xc8, 0x09, 0x89, 0x1b, 0x12, 0x12, 0xe2, 0xaf, 0xe9, 0xb1, 0x12, 0x17, 0xc4, 0x21, 0x42, 0x09,
+	0x7f, 0x03, 0x67, 0xe4, 0x99, 0xb7, 0x4e, 0xec, 0x8d, 0x15, 0x2b, 0x27, 0xef, 0xfb, 0xf5, 0xbd,
+	0x6f, 0x66, 0xde, 0x7c, 0x63, 0x58, 0xa3, 0xa1, 0xec, 0x30, 0xea, 0x86, 0xdc, 0x3b, 0xca, 0x12,
+	0xf7, 0xb8, 0xe1, 0x3e, 0xcd, 0x58, 0xda, 0x77, 0x92, 0x94, 0x4b, 0x4e, 0x56, 0x74, 0xd4, 0xd1,
+	0x51, 0xe7, 0xb8, 0x61, 0xad, 0x05, 0x9c, 0x07, 0x21, 0x73, 0x69, 0xd2, 0x75, 0x69, 0x1c, 0x73,
+	0x49, 0x65, 0x97, 0xc7, 0x42, 0xe7, 0x5b, 0xab, 0x18, 0x55, 0x56, 0x3b, 0xfb, 0xce, 0xa5, 0x31,
+	0x42, 0x59, 0xd5, 0x80, 0x07, 0x5c, 0x7d, 0xba, 0x83, 0x2f, 0xf4, 0xd6, 0x0a, 0xed, 0x03, 0x16,
+	0x33, 0xd1, 0x45, 0x40, 0xbb, 0x0a, 0xe4, 0xcb, 0x01, 0x9f, 0x2f, 0x68, 0x4a, 0x23, 0xd1, 0x64,
+	0x4f, 0x33, 0x26, 0xa4, 0xfd, 0x08, 0xde, 0x18, 0xf1, 0x8a, 0x84, 0xc7, 0x82, 0x91, 0xbb, 0x50,
+	0x4e, 0x94, 0xc7, 0x34, 0x36, 0x8c, 0xed, 0xa5, 0x5d, 0xd3, 0x19, 0xa7, 0xef, 0xe8, 0x8a, 0xfd,
+	0xb9, 0x97, 0x27, 0xeb, 0x33, 0x4d, 0xcc, 0xb6, 0x3f, 0x84, 0xaa, 0x82, 0x7b, 0x28, 0xee, 0xf7,
+	0x58, 0x94, 0x48, 0x6c, 0x43, 0x4c, 0x58, 0xa0, 0xbe, 0x9f, 0x32, 0xa1, 0x01, 0x17, 0x9b, 0xb9,
+	0x69, 0xbb, 0xf0, 0xe6, 0x58, 0x05, 0x52, 0xb8, 0x09, 0x65, 0xa6, 0x3c, 0xaa, 0xa2, 0xd2, 0x44,
+	0xcb, 0x6e, 0xc0, 0x2a, 0x16, 0x7c, 0xc2, 0x62, 0x1e, 0x1d, 0x70, 0xef, 0x88, 0xf9, 0x79, 0x9f,
+	0x2a, 0xcc, 0xfb, 0x03, 0x2f, 0x76, 0xd1, 0x86, 0xfd, 0x2d, 0x58, 0x97, 0x95, 0x9c, 0x37, 0x0a,
+	0x95, 0x27, 0x6f, 0xa4, 0x2d, 0x72, 0x0b, 0x96, 0xbd, 0x0e, 0xed, 0xc6, 0x2d, 0x8c, 0xce, 0xaa,
+	0xe8, 0x92, 0xf2, 0x69, 0x08, 0xfb, 0x31, 0xee, 0xde, 0xbd, 0x0e, 0xf3, 0x8e, 0x0e, 0x7b, 0x39,
+	0x8b, 0x55, 0xa8, 0xc8, 0x5e, 0xab, 0xdd, 0x97, 0x4c, 0x2f, 0x77, 0xb9, 0xb9, 0x20, 0x7b, 0xfb,
+	0x03, 0x93, 0x6c, 0xc3, 0x5c, 0x24, 0x02, 0x61, 0xce, 0x6e, 0x94, 0xb6, 0x97, 0x76, 0xab, 0x8e,
+	0x3e, 0x65, 0x27, 0x3f, 0x65, 0x67, 0x2f, 0xee, 0x37, 0x55, 0x86, 0xfd, 0xab, 0x01, 0xd5, 0x51,
+	0x70, 0xe4, 0x3b, 0xd8, 0xcb, 0x30, 0xe4, 0xcf, 0x86, 0x84, 0x73, 0x73, 0x0a, 0xc6, 0xe4, 0x3e,
+	0x2c, 0x47, 0x22, 0x68, 0x1d, 0xb3, 0xd4, 0xef, 0x7a, 0x52, 0x98, 0x25, 0xc5, 0x63, 0xad, 0x78,
+	0xbc, 0x8f, 0x44, 0xf0, 0x8d, 0x4e, 0xc2, 0x23, 0x5e, 0x8a, 0x86, 0x1e, 0x61, 0x3f, 0x01, 0x38,
+	0x4f, 0x20, 0x1b, 0x1a, 0x54, 0xf6, 0x13, 0xd6, 0xca, 0xd2, 0x10, 0x37, 0x1f, 0x22, 0x11, 0x1c,
+	0xf6, 0x13, 0xf6, 0x75, 0x1a, 0x5e, 0xe4, 0x3c, 0x3b, 0xca, 0xf9, 0x26, 0x94, 0x53, 0x46, 0x05,
+	0x8f, 0xcd, 0x92, 0xaa, 0x42, 0xcb, 0xae, 0xc1, 0x9a, 0x5a, 0x7d, 0x93, 0x85, 0x8c, 0x0a, 0xf6,
+	0x95, 0xd7, 0x61, 0x7e, 0x16, 0xb2, 0xe1, 0xe0, 0x66, 0xf0, 0xf6, 0x84, 0x38, 0x6e, 0xd3, 0x21,
+	0xbc, 0x9e, 0xea, 0x58, 0x4b, 0xe4, 0x41, 0xd3, 0x50, 0xcb, 0xbd, 0x55, 0x5c, 0xee, 0x18, 0x0c,
+	0xae, 0x79, 0x25, 0x1d, 0x43, 0xb7, 0xef, 0xe2, 0x28, 0xed, 0x79, 0x1e, 0xcf, 0x62, 0x89, 0x65,
+	0x57, 0x8f, 0xf9, 0x13, 0x78, 0xeb, 0xd2, 0x3a, 0x24, 0xbb, 0x07, 0x15, 0x6c, 0x95, 0x73, 0x5c,
+	0x9f, 0xcc, 0x51, 0x52, 0x99, 0xe5, 0x17, 0x6f, 0x58, 0x66, 0xff, 0x50, 0x82, 0x1b, 0x23, 0x19,
+	0xe4, 0x1e, 0x54, 0xf2, 0x95, 0xe3, 0x35, 0x9e, 0x7a, 0xe1, 0xc3, 0x42, 0xf2, 0xd9, 0x90, 0x99,
+	0x3e, 0xba, 0xc5, 0x7d, 0x67, 0x90, 0xf1, 0xf7, 0xc9, 0xfa, 0x56, 0xd0, 0x95, 0x9d, 0xac, 0xed,
+	0x78, 0x3c, 0x72, 0x3d, 0x2e, 0x22, 0x2e, 0xf0, 0xa7, 0x2e, 0xfc, 0x23, 0x77, 0x30, 0x0c, 0xc2,
+	0x79, 0x18, 0xcb, 0x21, 0x45, 0x9f, 0x3c, 0x18, 0xde, 0xb4, 0xd2, 0xb5, 0x90, 0xf2, 0x9b, 0xf9,
+	0x29, 0x2c, 0xb4, 0x69, 0x48, 0x63, 0x8f, 0x99, 0x73, 0xd7, 0x02, 0xca, 0xcb, 0xc9, 0x01, 0x2c,
+	0x8a, 0x84, 0xc5, 0x3e, 0x6d, 0x87, 0xcc, 0x9c, 0xbf, 0x16, 0xd6, 0x39, 0xc0, 0xee, 0x7f, 0x65,
+	0x98, 0x57, 0xa7, 0x4c, 0x9e, 0x41, 0x59, 0xeb, 0x23, 0x79, 0xb7, 0xb8, 0xe5, 0x45, 0x19, 0xb6,
+	0x36, 0xaf, 0xc8, 0xd2, 0x63, 0x62, 0x6f, 0xfc, 0xf8, 0xe7, 0xbf, 0xbf, 0xcc, 0x5a, 0xc4, 0x74,
+	0x0b, 0x62, 0xaf, 0x05, 0x98, 0xfc, 0x64, 0x40, 0x25, 0x97, 0x52, 0xb2, 0x35, 0x01, 0x75, 0x4c,
+	0x9d, 0xad, 0xdb, 0x57, 0xe6, 0x61, 0xff, 0xba, 0xea, 0x7f, 0x9b, 0x6c, 0x16, 0xfb, 0x77, 0x45,
+	0x4b, 0x0b, 0xb4, 0xfb, 0x1c, 0x67, 0xfe, 0x05, 0xf9, 0xcd, 0x80, 0x1b, 0x23, 0x9a, 0x4b, 0x76,
+	0x26, 0x76, 0x2a, 0x8a, 0xb9, 0xf5, 0xc1, 0x74, 0xc9, 0xc8, 0xad, 0xa1, 0xb8, 0xed, 0x90, 0xf7,
+	0x2e, 0xe5, 0xa6, 0x1e, 0x02, 0xd4, 0x45, 0xf7, 0xb9, 0xb2, 0x5e, 0x90, 0xdf, 0x0d, 0x58, 0x19,
+	0xd7, 0x0f, 0xe2, 0x4c, 0xe8, 0x3a, 0x41, 0x88, 0x2c, 0x77, 0xea, 0x7c, 0x24, 0xba, 0xa3, 0x88,
+	0x6e, 0x92, 0x77, 0x8a, 0x44, 0x0b, 0x82, 0x45, 0xfe, 0x30, 0xe0, 0xb5, 0x51, 0xcd, 0x20, 0x93,
+	0xb6, 0xe5, 0x52, 0x49, 0xb2, 0xea, 0x53, 0x66, 0x23, 0xb9, 0x3b, 0x8a, 0x5c, 0x9d, 0xec, 0x14,
+	0xc9, 0x51, 0x5d, 0xd1, 0x42, 0x92, 0x17, 0xce, 0xf9, 0x7b, 0x58, 0xc0, 0x47, 0x8a, 0x4c, 0x1a,
+	0xe4, 0xd1, 0x17, 0xd2, 0xda, 0xba, 0x2a, 0x0d, 0xe9, 0x6c, 0x2a, 0x3a, 0xeb, 0x1f, 0x1b, 0xef,
+	0xdb, 0x56, 0x91, 0x91, 0x37, 0xc8, 0x6e, 0xc9, 0xde, 0xfe, 0xe7, 0x2f, 0x4f, 0x6b, 0xc6, 0xab,
+	0xd3, 0x9a, 0xf1, 0xcf, 0x69, 0xcd, 0xf8, 0xf9, 0xac, 0x36, 0xf3, 0xea, 0xac, 0x36, 0xf3, 0xd7,
+	0x59, 0x6d, 0xe6, 0xf1, 0x47, 0x17, 0x6e, 0xf1, 0x9e, 0xaa, 0x7f, 0xc0, 0xb3, 0xd8, 0x57, 0x7f,
+	0xb5, 0x10, 0xb0, 0x7e, 0xd0, 0x70, 0x7b, 0x39, 0xaa, 0xba, 0xd7, 0xed, 0xb2, 0x7a, 0x8f, 0xef,
+	0xfc, 0x3f, 0x00, 0x54, 0xbe, 0x8e, 0xf1, 0xd3, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params retrieves the total set of lockup parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// IsExempt checks if an address (bech32 or hex) may move locked tokens while the chain is locked
+	IsExempt(ctx context.Context, in *QueryIsExemptRequest, opts ...grpc.CallOption) (*QueryIsExemptResponse, error)
+	// IsDenomLocked checks if non-exempt addresses are currently unable to move a denom
+	IsDenomLocked(ctx context.Context, in *QueryIsDenomLockedRequest, opts ...grpc.CallOption) (*QueryIsDenomLockedResponse, error)
+	// ReleaseSchedules returns every release schedule
+	ReleaseSchedules(ctx context.Context, in *QueryReleaseSchedulesRequest, opts ...grpc.CallOption) (*QueryReleaseSchedulesResponse, error)
+	// AccountRelease returns the released and still locked amounts of each release schedule of an address
+	AccountRelease(ctx context.Context, in *QueryAccountReleaseRequest, opts ...grpc.CallOption) (*QueryAccountReleaseResponse, error)
+	// CheckTx returns the verdict of the lockup rules for a tx without executing it.
+	// Note that the ERC20 transfers made by a MsgEthereumTx are only checked after execution, so they are not reflected
+	CheckTx(ctx context.Context, in *QueryCheckTxRequest, opts ...grpc.CallOption) (*QueryCheckTxResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsExempt(ctx context.Context, in *QueryIsExemptRequest, opts ...grpc.CallOption) (*QueryIsExemptResponse, error) {
+	out := new(QueryIsExemptResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/IsExempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsDenomLocked(ctx context.Context, in *QueryIsDenomLockedRequest, opts ...grpc.CallOption) (*QueryIsDenomLockedResponse, error) {
+	out := new(QueryIsDenomLockedResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/IsDenomLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseSchedules(ctx context.Context, in *QueryReleaseSchedulesRequest, opts ...grpc.CallOption) (*QueryReleaseSchedulesResponse, error) {
+	out := new(QueryReleaseSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/ReleaseSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRelease(ctx context.Context, in *QueryAccountReleaseRequest, opts ...grpc.CallOption) (*QueryAccountReleaseResponse, error) {
+	out := new(QueryAccountReleaseResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/AccountRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckTx(ctx context.Context, in *QueryCheckTxRequest, opts ...grpc.CallOption) (*QueryCheckTxResponse, error) {
+	out := new(QueryCheckTxResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Query/CheckTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params retrieves the total set of lockup parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// IsExempt checks if an address (bech32 or hex) may move locked tokens while the chain is locked
+	IsExempt(context.Context, *QueryIsExemptRequest) (*QueryIsExemptResponse, error)
+	// IsDenomLocked checks if non-exempt addresses are currently unable to move a denom
+	IsDenomLocked(context.Context, *QueryIsDenomLockedRequest) (*QueryIsDenomLockedResponse, error)
+	// ReleaseSchedules returns every release schedule
+	ReleaseSchedules(context.Context, *QueryReleaseSchedulesRequest) (*QueryReleaseSchedulesResponse, error)
+	// AccountRelease returns the released and still locked amounts of each release schedule of an address
+	AccountRelease(context.Context, *QueryAccountReleaseRequest) (*QueryAccountReleaseResponse, error)
+	// CheckTx returns the verdict of the lockup rules for a tx without executing it.
+	// Note that the ERC20 transfers made by a MsgEthereumTx are only checked after execution, so they are not reflected
+	CheckTx(context.Context, *QueryCheckTxRequest) (*QueryCheckTxResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) IsExempt(ctx context.Context, req *QueryIsExemptRequest) (*QueryIsExemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsExempt not implemented")
+}
+func (*UnimplementedQueryServer) IsDenomLocked(ctx context.Context, req *QueryIsDenomLockedRequest) (*QueryIsDenomLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsDenomLocked not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseSchedules(ctx context.Context, req *QueryReleaseSchedulesRequest) (*QueryReleaseSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedules not implemented")
+}
+func (*UnimplementedQueryServer) AccountRelease(ctx context.Context, req *QueryAccountReleaseRequest) (*QueryAccountReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRelease not implemented")
+}
+func (*UnimplementedQueryServer) CheckTx(ctx context.Context, req *QueryCheckTxRequest) (*QueryCheckTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTx not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsExempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsExemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsExempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/IsExempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsExempt(ctx, req.(*QueryIsExemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsDenomLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsDenomLockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsDenomLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/IsDenomLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsDenomLocked(ctx, req.(*QueryIsDenomLockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/ReleaseSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseSchedules(ctx, req.(*QueryReleaseSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/AccountRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRelease(ctx, req.(*QueryAccountReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Query/CheckTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckTx(ctx, req.(*QueryCheckTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "althea.lockup.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "IsExempt",
+			Handler:    _Query_IsExempt_Handler,
+		},
+		{
+			MethodName: "IsDenomLocked",
+			Handler:    _Query_IsDenomLocked_Handler,
+		},
+		{
+			MethodName: "ReleaseSchedules",
+			Handler:    _Query_ReleaseSchedules_Handler,
+		},
+		{
+			MethodName: "AccountRelease",
+			Handler:    _Query_AccountRelease_Handler,
+		},
+		{
+			MethodName: "CheckTx",
+			Handler:    _Query_CheckTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "althea/lockup/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsExemptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsExemptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsExemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsExemptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsExemptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsExemptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsDenomLockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsDenomLockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsDenomLockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsDenomLockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsDenomLockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsDenomLockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainLocked {
+		i--
+		if m.ChainLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgVerdicts) > 0 {
+		for iNdEx := len(m.MsgVerdicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgVerdicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChainLocked {
+		i--
+		if m.ChainLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerdict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerdict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerdict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleaseSchedules) > 0 {
+		for iNdEx := len(m.ReleaseSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spendable.Size()
+		i -= size
+		if _, err := m.Spendable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Released.Size()
+		i -= size
+		if _, err := m.Released.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIsExemptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsExemptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsDenomLockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsDenomLockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locked {
+		n += 2
+	}
+	if m.ChainLocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCheckTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.ChainLocked {
+		n += 2
+	}
+	if len(m.MsgVerdicts) > 0 {
+		for _, e := range m.MsgVerdicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVerdict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReleaseSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReleaseSchedules) > 0 {
+		for _, e := range m.ReleaseSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Spendable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsExemptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsExemptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsExemptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsExemptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsExemptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsExemptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsDenomLockedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsDenomLockedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsDenomLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsDenomLockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsDenomLockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsDenomLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainLocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainLocked = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVerdicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgVerdicts = append(m.MsgVerdicts, MsgVerdict{})
+			if err := m.MsgVerdicts[len(m.MsgVerdicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerdict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerdict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerdict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseSchedules = append(m.ReleaseSchedules, ReleaseSchedule{})
+			if err := m.ReleaseSchedules[len(m.ReleaseSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, ReleaseStatus{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spendable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHeightReleaseSchedule returns a ReleaseSchedule of amount of denom for address, measured in block heights
+func NewHeightReleaseSchedule(
+	address string, denom string, amount sdk.Int, releaseType ReleaseType, startHeight uint64, endHeight uint64,
+) ReleaseSchedule {
+	return ReleaseSchedule{
+		Address:     address,
+		Denom:       denom,
+		Amount:      amount,
+		ReleaseType: releaseType,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		StartTime:   time.Time{},
+		EndTime:     time.Time{},
+		Moved:       sdk.ZeroInt(),
+	}
+}
+
+// NewTimeReleaseSchedule returns a ReleaseSchedule of amount of denom for address, measured in block time
+func NewTimeReleaseSchedule(
+	address string, denom string, amount sdk.Int, releaseType ReleaseType, startTime time.Time, endTime time.Time,
+) ReleaseSchedule {
+	return ReleaseSchedule{
+		Address:     address,
+		Denom:       denom,
+		Amount:      amount,
+		ReleaseType: releaseType,
+		StartHeight: 0,
+		EndHeight:   0,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Moved:       sdk.ZeroInt(),
+	}
+}
+
+// IsHeightBased returns true if the schedule is measured in block heights rather than block time
+func (s ReleaseSchedule) IsHeightBased() bool {
+	return s.EndHeight != 0
+}
+
+// ValidateBasic checks that the schedule has a valid address, denom and amount, and exactly one of a height or time
+// based release period
+func (s ReleaseSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return fmt.Errorf("invalid release schedule address %s: %v", s.Address, err)
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid release schedule denom %s: %v", s.Denom, err)
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("release schedule amount must be positive: %v", s.Amount)
+	}
+	if s.GetMoved().IsNegative() {
+		return fmt.Errorf("release schedule moved amount must not be negative: %v", s.Moved)
+	}
+	if (s.EndHeight == 0) == s.EndTime.IsZero() {
+		return fmt.Errorf("release schedule must set exactly one of end height or end time")
+	}
+	if s.IsHeightBased() && !s.StartTime.IsZero() {
+		return fmt.Errorf("height based release schedule must not set a start time")
+	}
+	if !s.IsHeightBased() && s.StartHeight != 0 {
+		return fmt.Errorf("time based release schedule must not set a start height")
+	}
+	if !s.EndTime.IsZero() && s.EndTime.Unix() <= 0 {
+		return fmt.Errorf("release schedule end time must be after the unix epoch: %v", s.EndTime)
+	}
+
+	switch s.ReleaseType {
+	case RELEASE_TYPE_LINEAR:
+		if s.IsHeightBased() && s.StartHeight >= s.EndHeight {
+			return fmt.Errorf("linear release schedule start height %d must be before end height %d", s.StartHeight, s.EndHeight)
+		}
+		if !s.IsHeightBased() && !s.StartTime.Before(s.EndTime) {
+			return fmt.Errorf("linear release schedule start time %v must be before end time %v", s.StartTime, s.EndTime)
+		}
+	case RELEASE_TYPE_CLIFF:
+		if s.StartHeight != 0 || !s.StartTime.IsZero() {
+			return fmt.Errorf("cliff release schedule must not set a start height or time")
+		}
+	default:
+		return fmt.Errorf("invalid release schedule type %v", s.ReleaseType)
+	}
+	return nil
+}
+
+// ReleasedAmount returns the part of the schedule's amount which has been released by the given block height and time
+func (s ReleaseSchedule) ReleasedAmount(height uint64, blockTime time.Time) sdk.Int {
+	if s.IsHeightBased() {
+		if height >= s.EndHeight {
+			return s.Amount
+		}
+		if s.ReleaseType == RELEASE_TYPE_CLIFF || height <= s.StartHeight {
+			return sdk.ZeroInt()
+		}
+		elapsed := sdk.NewIntFromUint64(height - s.StartHeight)
+		period := sdk.NewIntFromUint64(s.EndHeight - s.StartHeight)
+		return s.Amount.Mul(elapsed).Quo(period)
+	}
+
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+	if s.ReleaseType == RELEASE_TYPE_CLIFF || !blockTime.After(s.StartTime) {
+		return sdk.ZeroInt()
+	}
+	elapsed := sdk.NewInt(blockTime.Sub(s.StartTime).Nanoseconds())
+	period := sdk.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	return s.Amount.Mul(elapsed).Quo(period)
+}
+
+// GetMoved returns the amount moved under the schedule, which is zero for a schedule without one
+func (s ReleaseSchedule) GetMoved() sdk.Int {
+	if s.Moved.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return s.Moved
+}
+
+// UnmovedAmount returns the part of the amount released by the given block height and time which has not been moved
+func (s ReleaseSchedule) UnmovedAmount(height uint64, blockTime time.Time) sdk.Int {
+	unmoved := s.ReleasedAmount(height, blockTime).Sub(s.GetMoved())
+	if unmoved.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return unmoved
+}
+
+// LockedAmount returns the part of the schedule's amount which has not been released by the given block height and time
+func (s ReleaseSchedule) LockedAmount(height uint64, blockTime time.Time) sdk.Int {
+	return s.Amount.Sub(s.ReleasedAmount(height, blockTime))
+}
+
+// ValidateReleaseSchedules checks that every schedule is valid and that no address has two schedules for a denom
+func ValidateReleaseSchedules(schedules []ReleaseSchedule) error {
+	seen := make(map[string]struct{}, len(schedules))
+	for i, schedule := range schedules {
+		if err := schedule.ValidateBasic(); err != nil {
+			return fmt.Errorf("release schedule %d: %v", i, err)
+		}
+		key := schedule.Address + "/" + schedule.Denom
+		if _, present := seen[key]; present {
+			return fmt.Errorf("duplicate release schedule for %s and %s", schedule.Address, schedule.Denom)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestReleasedAmount(t *testing.T) {
+	addr := sdk.AccAddress([]byte("release_address_____")).String()
+	amount := sdk.NewInt(1000)
+	start := time.Unix(1000, 0)
+	end := time.Unix(2000, 0)
+
+	linearHeight := NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, 100, 200)
+	require.NoError(t, linearHeight.ValidateBasic())
+	require.Equal(t, sdk.ZeroInt(), linearHeight.ReleasedAmount(50, time.Time{}))
+	require.Equal(t, sdk.ZeroInt(), linearHeight.ReleasedAmount(100, time.Time{}))
+	require.Equal(t, sdk.NewInt(250), linearHeight.ReleasedAmount(125, time.Time{}))
+	require.Equal(t, sdk.NewInt(750), linearHeight.LockedAmount(125, time.Time{}))
+	require.Equal(t, amount, linearHeight.ReleasedAmount(200, time.Time{}))
+	require.Equal(t, amount, linearHeight.ReleasedAmount(300, time.Time{}))
+
+	// Whatever has been moved no longer counts towards the released amount
+	linearHeight.Moved = sdk.NewInt(200)
+	require.Equal(t, sdk.NewInt(50), linearHeight.UnmovedAmount(125, time.Time{}))
+	require.Equal(t, sdk.NewInt(800), linearHeight.UnmovedAmount(200, time.Time{}))
+	linearHeight.Moved = sdk.NewInt(300)
+	require.Equal(t, sdk.ZeroInt(), linearHeight.UnmovedAmount(125, time.Time{}))
+	linearHeight.Moved = sdk.Int{}
+	require.Equal(t, sdk.NewInt(250), linearHeight.UnmovedAmount(125, time.Time{}))
+
+	cliffHeight := NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_CLIFF, 0, 200)
+	require.NoError(t, cliffHeight.ValidateBasic())
+	require.Equal(t, sdk.ZeroInt(), cliffHeight.ReleasedAmount(199, time.Time{}))
+	require.Equal(t, amount, cliffHeight.ReleasedAmount(200, time.Time{}))
+
+	linearTime := NewTimeReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, start, end)
+	require.NoError(t, linearTime.ValidateBasic())
+	require.Equal(t, sdk.ZeroInt(), linearTime.ReleasedAmount(0, start))
+	require.Equal(t, sdk.NewInt(500), linearTime.ReleasedAmount(0, time.Unix(1500, 0)))
+	require.Equal(t, amount, linearTime.ReleasedAmount(0, end))
+
+	cliffTime := NewTimeReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_CLIFF, time.Time{}, end)
+	require.NoError(t, cliffTime.ValidateBasic())
+	require.Equal(t, sdk.ZeroInt(), cliffTime.ReleasedAmount(0, time.Unix(1999, 0)))
+	require.Equal(t, amount, cliffTime.ReleasedAmount(0, end))
+}
+
+func TestValidateReleaseSchedules(t *testing.T) {
+	addr := sdk.AccAddress([]byte("release_address_____")).String()
+	amount := sdk.NewInt(1000)
+
+	valid := NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, 100, 200)
+	require.NoError(t, ValidateReleaseSchedules([]ReleaseSchedule{valid}))
+	require.Error(t, ValidateReleaseSchedules([]ReleaseSchedule{valid, valid}))
+
+	negativeMoved := NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, 100, 200)
+	negativeMoved.Moved = sdk.NewInt(-1)
+	invalid := []ReleaseSchedule{
+		negativeMoved,
+		NewHeightReleaseSchedule("0x0000000000000000000000000000000000000000", "aalthea", amount, RELEASE_TYPE_LINEAR, 100, 200),
+		NewHeightReleaseSchedule(addr, "!invalid", amount, RELEASE_TYPE_LINEAR, 100, 200),
+		NewHeightReleaseSchedule(addr, "aalthea", sdk.ZeroInt(), RELEASE_TYPE_LINEAR, 100, 200),
+		NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_UNSPECIFIED, 100, 200),
+		NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, 200, 200),
+		NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_CLIFF, 100, 200),
+		NewHeightReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, 0, 0),
+		NewTimeReleaseSchedule(addr, "aalthea", amount, RELEASE_TYPE_LINEAR, time.Unix(2000, 0), time.Unix(1000, 0)),
+	}
+	for i, schedule := range invalid {
+		require.Error(t, schedule.ValidateBasic(), "invalid schedule %d passed validation", i)
+	}
+}
@@ -0,0 +1,2573 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: althea/lockup/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddLockExempt makes the given addresses exempt from the lockup
+// AUTHORITY the governance module account address, the only valid signer of this message
+// ADDRESSES the bech32 addresses to add, addresses which are already exempt are ignored
+type MsgAddLockExempt struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgAddLockExempt) Reset()         { *m = MsgAddLockExempt{} }
+func (m *MsgAddLockExempt) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLockExempt) ProtoMessage()    {}
+func (*MsgAddLockExempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{0}
+}
+func (m *MsgAddLockExempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddLockExempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLockExempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddLockExempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLockExempt.Merge(m, src)
+}
+func (m *MsgAddLockExempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddLockExempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLockExempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddLockExempt proto.InternalMessageInfo
+
+func (m *MsgAddLockExempt) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddLockExempt) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type MsgAddLockExemptResponse struct {
+}
+
+func (m *MsgAddLockExemptResponse) Reset()         { *m = MsgAddLockExemptResponse{} }
+func (m *MsgAddLockExemptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLockExemptResponse) ProtoMessage()    {}
+func (*MsgAddLockExemptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{1}
+}
+func (m *MsgAddLockExemptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddLockExemptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLockExemptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddLockExemptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLockExemptResponse.Merge(m, src)
+}
+func (m *MsgAddLockExemptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddLockExemptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLockExemptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddLockExemptResponse proto.InternalMessageInfo
+
+// MsgRemoveLockExempt makes the given addresses subject to the lockup again
+// AUTHORITY the governance module account address, the only valid signer of this message
+// ADDRESSES the bech32 addresses to remove, each of which must currently be exempt
+type MsgRemoveLockExempt struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgRemoveLockExempt) Reset()         { *m = MsgRemoveLockExempt{} }
+func (m *MsgRemoveLockExempt) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockExempt) ProtoMessage()    {}
+func (*MsgRemoveLockExempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{2}
+}
+func (m *MsgRemoveLockExempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockExempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockExempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveLockExempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockExempt.Merge(m, src)
+}
+func (m *MsgRemoveLockExempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockExempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockExempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLockExempt proto.InternalMessageInfo
+
+func (m *MsgRemoveLockExempt) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveLockExempt) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type MsgRemoveLockExemptResponse struct {
+}
+
+func (m *MsgRemoveLockExemptResponse) Reset()         { *m = MsgRemoveLockExemptResponse{} }
+func (m *MsgRemoveLockExemptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockExemptResponse) ProtoMessage()    {}
+func (*MsgRemoveLockExemptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{3}
+}
+func (m *MsgRemoveLockExemptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockExemptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockExemptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveLockExemptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockExemptResponse.Merge(m, src)
+}
+func (m *MsgRemoveLockExemptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockExemptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockExemptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLockExemptResponse proto.InternalMessageInfo
+
+// MsgLockDenom adds the given denoms to the locked token denoms
+// AUTHORITY the governance module account address, the only valid signer of this message
+// DENOMS the denoms to lock, denoms which are already locked are ignored
+type MsgLockDenom struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denoms    []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgLockDenom) Reset()         { *m = MsgLockDenom{} }
+func (m *MsgLockDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDenom) ProtoMessage()    {}
+func (*MsgLockDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{4}
+}
+func (m *MsgLockDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDenom.Merge(m, src)
+}
+func (m *MsgLockDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDenom proto.InternalMessageInfo
+
+func (m *MsgLockDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgLockDenom) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+type MsgLockDenomResponse struct {
+}
+
+func (m *MsgLockDenomResponse) Reset()         { *m = MsgLockDenomResponse{} }
+func (m *MsgLockDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockDenomResponse) ProtoMessage()    {}
+func (*MsgLockDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{5}
+}
+func (m *MsgLockDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockDenomResponse.Merge(m, src)
+}
+func (m *MsgLockDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockDenomResponse proto.InternalMessageInfo
+
+// MsgUnlockDenom removes the given denoms from the locked token denoms
+// AUTHORITY the governance module account address, the only valid signer of this message
+// DENOMS the denoms to unlock, each of which must currently be locked
+type MsgUnlockDenom struct {
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denoms    []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgUnlockDenom) Reset()         { *m = MsgUnlockDenom{} }
+func (m *MsgUnlockDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockDenom) ProtoMessage()    {}
+func (*MsgUnlockDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{6}
+}
+func (m *MsgUnlockDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockDenom.Merge(m, src)
+}
+func (m *MsgUnlockDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockDenom proto.InternalMessageInfo
+
+func (m *MsgUnlockDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnlockDenom) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+type MsgUnlockDenomResponse struct {
+}
+
+func (m *MsgUnlockDenomResponse) Reset()         { *m = MsgUnlockDenomResponse{} }
+func (m *MsgUnlockDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockDenomResponse) ProtoMessage()    {}
+func (*MsgUnlockDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{7}
+}
+func (m *MsgUnlockDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockDenomResponse.Merge(m, src)
+}
+func (m *MsgUnlockDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockDenomResponse proto.InternalMessageInfo
+
+// MsgSetReleaseSchedule creates the release schedule for the schedule's address and denom, replacing any existing one
+// AUTHORITY the governance module account address, the only valid signer of this message
+// SCHEDULE the release schedule to set, without a moved amount. A replaced schedule's moved amount is kept, so the
+// funds already moved count against the new schedule
+type MsgSetReleaseSchedule struct {
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Schedule  ReleaseSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgSetReleaseSchedule) Reset()         { *m = MsgSetReleaseSchedule{} }
+func (m *MsgSetReleaseSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReleaseSchedule) ProtoMessage()    {}
+func (*MsgSetReleaseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{8}
+}
+func (m *MsgSetReleaseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReleaseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReleaseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReleaseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReleaseSchedule.Merge(m, src)
+}
+func (m *MsgSetReleaseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReleaseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReleaseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReleaseSchedule proto.InternalMessageInfo
+
+func (m *MsgSetReleaseSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetReleaseSchedule) GetSchedule() ReleaseSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ReleaseSchedule{}
+}
+
+type MsgSetReleaseScheduleResponse struct {
+}
+
+func (m *MsgSetReleaseScheduleResponse) Reset()         { *m = MsgSetReleaseScheduleResponse{} }
+func (m *MsgSetReleaseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReleaseScheduleResponse) ProtoMessage()    {}
+func (*MsgSetReleaseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{9}
+}
+func (m *MsgSetReleaseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReleaseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReleaseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReleaseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReleaseScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetReleaseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReleaseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReleaseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReleaseScheduleResponse proto.InternalMessageInfo
+
+// MsgRemoveReleaseSchedule removes the release schedule of an address for a denom, fully locking the denom again
+// AUTHORITY the governance module account address, the only valid signer of this message
+// ADDRESS the bech32 address of the schedule
+// DENOM the denom of the schedule
+type MsgRemoveReleaseSchedule struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveReleaseSchedule) Reset()         { *m = MsgRemoveReleaseSchedule{} }
+func (m *MsgRemoveReleaseSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveReleaseSchedule) ProtoMessage()    {}
+func (*MsgRemoveReleaseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{10}
+}
+func (m *MsgRemoveReleaseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveReleaseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveReleaseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveReleaseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveReleaseSchedule.Merge(m, src)
+}
+func (m *MsgRemoveReleaseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveReleaseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveReleaseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveReleaseSchedule proto.InternalMessageInfo
+
+func (m *MsgRemoveReleaseSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveReleaseSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRemoveReleaseSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveReleaseScheduleResponse struct {
+}
+
+func (m *MsgRemoveReleaseScheduleResponse) Reset()         { *m = MsgRemoveReleaseScheduleResponse{} }
+func (m *MsgRemoveReleaseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveReleaseScheduleResponse) ProtoMessage()    {}
+func (*MsgRemoveReleaseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7db52bc1880b8580, []int{11}
+}
+func (m *MsgRemoveReleaseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveReleaseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveReleaseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveReleaseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveReleaseScheduleResponse.Merge(m, src)
+}
+func (m *MsgRemoveReleaseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveReleaseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveReleaseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveReleaseScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddLockExempt)(nil), "althea.lockup.v1.MsgAddLockExempt")
+	proto.RegisterType((*MsgAddLockExemptResponse)(nil), "althea.lockup.v1.MsgAddLockExemptResponse")
+	proto.RegisterType((*MsgRemoveLockExempt)(nil), "althea.lockup.v1.MsgRemoveLockExempt")
+	proto.RegisterType((*MsgRemoveLockExemptResponse)(nil), "althea.lockup.v1.MsgRemoveLockExemptResponse")
+	proto.RegisterType((*MsgLockDenom)(nil), "althea.lockup.v1.MsgLockDenom")
+	proto.RegisterType((*MsgLockDenomResponse)(nil), "althea.lockup.v1.MsgLockDenomResponse")
+	proto.RegisterType((*MsgUnlockDenom)(nil), "althea.lockup.v1.MsgUnlockDenom")
+	proto.RegisterType((*MsgUnlockDenomResponse)(nil), "althea.lockup.v1.MsgUnlockDenomResponse")
+	proto.RegisterType((*MsgSetReleaseSchedule)(nil), "althea.lockup.v1.MsgSetReleaseSchedule")
+	proto.RegisterType((*MsgSetReleaseScheduleResponse)(nil), "althea.lockup.v1.MsgSetReleaseScheduleResponse")
+	proto.RegisterType((*MsgRemoveReleaseSchedule)(nil), "althea.lockup.v1.MsgRemoveReleaseSchedule")
+	proto.RegisterType((*MsgRemoveReleaseScheduleResponse)(nil), "althea.lockup.v1.MsgRemoveReleaseScheduleResponse")
+}
+
+func init() { proto.RegisterFile("althea/lockup/v1/tx.proto", fileDescriptor_7db52bc1880b8580) }
+
+var fileDescriptor_7db52bc1880b8580 = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6e, 0xd3, 0x40,
+	0x18, 0x85, 0xe3, 0xa6, 0x14, 0xf2, 0x17, 0x50, 0x64, 0xd2, 0xc8, 0x18, 0xea, 0x1a, 0x4b, 0x40,
+	0x54, 0xa9, 0xb6, 0x12, 0xb8, 0x40, 0x4b, 0xe9, 0xaa, 0xae, 0x84, 0x23, 0x16, 0xb0, 0x41, 0x6e,
+	0xfc, 0x6b, 0x1c, 0xd5, 0xf1, 0x58, 0x99, 0x71, 0x48, 0x39, 0x05, 0xc7, 0xea, 0xb2, 0x4b, 0x56,
+	0x08, 0x25, 0x2b, 0x6e, 0x81, 0x62, 0xc7, 0xd3, 0xd4, 0x31, 0x71, 0x10, 0xdd, 0x65, 0xe6, 0xbd,
+	0xff, 0x7d, 0x93, 0xf1, 0xd3, 0xc0, 0x53, 0x37, 0xe0, 0x3e, 0xba, 0x56, 0x40, 0x7b, 0x17, 0x71,
+	0x64, 0x8d, 0xda, 0x16, 0x1f, 0x9b, 0xd1, 0x90, 0x72, 0x2a, 0xd7, 0x53, 0xc9, 0x4c, 0x25, 0x73,
+	0xd4, 0x56, 0x1b, 0x84, 0x12, 0x9a, 0x88, 0xd6, 0xec, 0x57, 0xea, 0x53, 0xb5, 0xa5, 0x08, 0x82,
+	0x21, 0xb2, 0x3e, 0x4b, 0x75, 0xe3, 0x0c, 0xea, 0x36, 0x23, 0x87, 0x9e, 0x77, 0x4a, 0x7b, 0x17,
+	0xef, 0xc7, 0x38, 0x88, 0xb8, 0xfc, 0x1c, 0x6a, 0x6e, 0xcc, 0x7d, 0x3a, 0xec, 0xf3, 0x4b, 0x45,
+	0xd2, 0xa5, 0x56, 0xcd, 0xb9, 0xd9, 0x48, 0x54, 0xcf, 0x1b, 0x22, 0x63, 0xc8, 0x94, 0x0d, 0xbd,
+	0x9a, 0xa8, 0xd9, 0x86, 0xa1, 0x82, 0x92, 0xcf, 0x73, 0x90, 0x45, 0x34, 0x64, 0x68, 0x7c, 0x80,
+	0x27, 0x36, 0x23, 0x0e, 0x0e, 0xe8, 0x08, 0xef, 0x08, 0xb7, 0x0b, 0xcf, 0x0a, 0x22, 0x05, 0xf1,
+	0x18, 0x1e, 0xda, 0x8c, 0xcc, 0x84, 0x63, 0x0c, 0xe9, 0xa0, 0x04, 0xd5, 0x84, 0x2d, 0x6f, 0x66,
+	0xcb, 0x38, 0xf3, 0x95, 0xd1, 0x84, 0xc6, 0x62, 0x8a, 0x48, 0x3f, 0x81, 0xc7, 0x36, 0x23, 0x1f,
+	0xc3, 0xe0, 0x3f, 0xf3, 0x15, 0x68, 0xde, 0xce, 0x11, 0x84, 0x6f, 0xb0, 0x63, 0x33, 0xd2, 0x45,
+	0xee, 0x60, 0x80, 0x2e, 0xc3, 0x6e, 0xcf, 0x47, 0x2f, 0x0e, 0xb0, 0x04, 0xf4, 0x0e, 0x1e, 0xb0,
+	0xb9, 0x53, 0xd9, 0xd0, 0xa5, 0xd6, 0x76, 0xe7, 0x85, 0x99, 0xef, 0x8b, 0x99, 0x8b, 0x3c, 0xda,
+	0xbc, 0xfa, 0xb9, 0x57, 0x71, 0xc4, 0xa0, 0xb1, 0x07, 0xbb, 0x85, 0x6c, 0x71, 0x38, 0x1f, 0x14,
+	0x71, 0xf7, 0xff, 0x76, 0x3e, 0x05, 0xee, 0xcf, 0x3f, 0x61, 0x72, 0xbc, 0x9a, 0x93, 0x2d, 0xe5,
+	0x06, 0xdc, 0x4b, 0x2e, 0x45, 0xa9, 0x26, 0xfb, 0xe9, 0xc2, 0x30, 0x40, 0xff, 0x1b, 0x29, 0x3b,
+	0x4d, 0xe7, 0xf7, 0x26, 0x54, 0x6d, 0x46, 0xe4, 0x2f, 0xf0, 0xe8, 0x76, 0x9b, 0x8d, 0xe5, 0xbf,
+	0x9e, 0x6f, 0xa8, 0xba, 0x5f, 0xee, 0xc9, 0x40, 0xb2, 0x0f, 0xf5, 0xa5, 0x0a, 0xbf, 0x2c, 0x9c,
+	0xcf, 0xdb, 0xd4, 0x83, 0xb5, 0x6c, 0x82, 0xd4, 0x85, 0xda, 0x4d, 0x75, 0xb5, 0xc2, 0x59, 0xa1,
+	0xab, 0xaf, 0x56, 0xeb, 0x22, 0xf4, 0x13, 0x6c, 0x2f, 0x36, 0x56, 0x2f, 0x1c, 0x5b, 0x70, 0xa8,
+	0xad, 0x32, 0x87, 0x88, 0x0e, 0x41, 0x2e, 0xa8, 0xea, 0xeb, 0xc2, 0xf9, 0x65, 0xa3, 0x6a, 0xad,
+	0x69, 0x14, 0xbc, 0xaf, 0xb0, 0x53, 0xdc, 0xbe, 0xfd, 0x15, 0xf7, 0x9c, 0xa7, 0x76, 0xd6, 0xf7,
+	0x66, 0xe0, 0xa3, 0xb3, 0xab, 0x89, 0x26, 0x5d, 0x4f, 0x34, 0xe9, 0xd7, 0x44, 0x93, 0xbe, 0x4f,
+	0xb5, 0xca, 0xf5, 0x54, 0xab, 0xfc, 0x98, 0x6a, 0x95, 0xcf, 0x6f, 0x49, 0x9f, 0xfb, 0xf1, 0xb9,
+	0xd9, 0xa3, 0x03, 0xeb, 0x30, 0xc9, 0x3d, 0xa1, 0x71, 0xe8, 0xb9, 0xbc, 0x4f, 0x43, 0x2b, 0x05,
+	0x1d, 0x9c, 0xb6, 0xad, 0x71, 0xf6, 0x1e, 0xf3, 0xcb, 0x08, 0xd9, 0xf9, 0x56, 0xf2, 0x16, 0xbf,
+	0xf9, 0x33, 0x00, 0x32, 0xd1, 0x86, 0x29, 0xf0, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddLockExempt adds addresses to the set of lock exempt addresses
+	AddLockExempt(ctx context.Context, in *MsgAddLockExempt, opts ...grpc.CallOption) (*MsgAddLockExemptResponse, error)
+	// RemoveLockExempt removes addresses from the set of lock exempt addresses
+	RemoveLockExempt(ctx context.Context, in *MsgRemoveLockExempt, opts ...grpc.CallOption) (*MsgRemoveLockExemptResponse, error)
+	// LockDenom adds denoms to the locked token denoms
+	LockDenom(ctx context.Context, in *MsgLockDenom, opts ...grpc.CallOption) (*MsgLockDenomResponse, error)
+	// UnlockDenom removes denoms from the locked token denoms
+	UnlockDenom(ctx context.Context, in *MsgUnlockDenom, opts ...grpc.CallOption) (*MsgUnlockDenomResponse, error)
+	// SetReleaseSchedule creates or replaces the release schedule of an address for a locked denom
+	SetReleaseSchedule(ctx context.Context, in *MsgSetReleaseSchedule, opts ...grpc.CallOption) (*MsgSetReleaseScheduleResponse, error)
+	// RemoveReleaseSchedule removes the release schedule of an address for a locked denom
+	RemoveReleaseSchedule(ctx context.Context, in *MsgRemoveReleaseSchedule, opts ...grpc.CallOption) (*MsgRemoveReleaseScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddLockExempt(ctx context.Context, in *MsgAddLockExempt, opts ...grpc.CallOption) (*MsgAddLockExemptResponse, error) {
+	out := new(MsgAddLockExemptResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/AddLockExempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLockExempt(ctx context.Context, in *MsgRemoveLockExempt, opts ...grpc.CallOption) (*MsgRemoveLockExemptResponse, error) {
+	out := new(MsgRemoveLockExemptResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/RemoveLockExempt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockDenom(ctx context.Context, in *MsgLockDenom, opts ...grpc.CallOption) (*MsgLockDenomResponse, error) {
+	out := new(MsgLockDenomResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/LockDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockDenom(ctx context.Context, in *MsgUnlockDenom, opts ...grpc.CallOption) (*MsgUnlockDenomResponse, error) {
+	out := new(MsgUnlockDenomResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/UnlockDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReleaseSchedule(ctx context.Context, in *MsgSetReleaseSchedule, opts ...grpc.CallOption) (*MsgSetReleaseScheduleResponse, error) {
+	out := new(MsgSetReleaseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/SetReleaseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveReleaseSchedule(ctx context.Context, in *MsgRemoveReleaseSchedule, opts ...grpc.CallOption) (*MsgRemoveReleaseScheduleResponse, error) {
+	out := new(MsgRemoveReleaseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/althea.lockup.v1.Msg/RemoveReleaseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddLockExempt adds addresses to the set of lock exempt addresses
+	AddLockExempt(context.Context, *MsgAddLockExempt) (*MsgAddLockExemptResponse, error)
+	// RemoveLockExempt removes addresses from the set of lock exempt addresses
+	RemoveLockExempt(context.Context, *MsgRemoveLockExempt) (*MsgRemoveLockExemptResponse, error)
+	// LockDenom adds denoms to the locked token denoms
+	LockDenom(context.Context, *MsgLockDenom) (*MsgLockDenomResponse, error)
+	// UnlockDenom removes denoms from the locked token denoms
+	UnlockDenom(context.Context, *MsgUnlockDenom) (*MsgUnlockDenomResponse, error)
+	// SetReleaseSchedule creates or replaces the release schedule of an address for a locked denom
+	SetReleaseSchedule(context.Context, *MsgSetReleaseSchedule) (*MsgSetReleaseScheduleResponse, error)
+	// RemoveReleaseSchedule removes the release schedule of an address for a locked denom
+	RemoveReleaseSchedule(context.Context, *MsgRemoveReleaseSchedule) (*MsgRemoveReleaseScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddLockExempt(ctx context.Context, req *MsgAddLockExempt) (*MsgAddLockExemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLockExempt not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLockExempt(ctx context.Context, req *MsgRemoveLockExempt) (*MsgRemoveLockExemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLockExempt not implemented")
+}
+func (*UnimplementedMsgServer) LockDenom(ctx context.Context, req *MsgLockDenom) (*MsgLockDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockDenom not implemented")
+}
+func (*UnimplementedMsgServer) UnlockDenom(ctx context.Context, req *MsgUnlockDenom) (*MsgUnlockDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockDenom not implemented")
+}
+func (*UnimplementedMsgServer) SetReleaseSchedule(ctx context.Context, req *MsgSetReleaseSchedule) (*MsgSetReleaseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReleaseSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveReleaseSchedule(ctx context.Context, req *MsgRemoveReleaseSchedule) (*MsgRemoveReleaseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReleaseSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddLockExempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLockExempt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddLockExempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/AddLockExempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLockExempt(ctx, req.(*MsgAddLockExempt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLockExempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLockExempt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLockExempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/RemoveLockExempt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLockExempt(ctx, req.(*MsgRemoveLockExempt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/LockDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockDenom(ctx, req.(*MsgLockDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/UnlockDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockDenom(ctx, req.(*MsgUnlockDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReleaseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReleaseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/SetReleaseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReleaseSchedule(ctx, req.(*MsgSetReleaseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveReleaseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveReleaseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.lockup.v1.Msg/RemoveReleaseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveReleaseSchedule(ctx, req.(*MsgRemoveReleaseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "althea.lockup.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddLockExempt",
+			Handler:    _Msg_AddLockExempt_Handler,
+		},
+		{
+			MethodName: "RemoveLockExempt",
+			Handler:    _Msg_RemoveLockExempt_Handler,
+		},
+		{
+			MethodName: "LockDenom",
+			Handler:    _Msg_LockDenom_Handler,
+		},
+		{
+			MethodName: "UnlockDenom",
+			Handler:    _Msg_UnlockDenom_Handler,
+		},
+		{
+			MethodName: "SetReleaseSchedule",
+			Handler:    _Msg_SetReleaseSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveReleaseSchedule",
+			Handler:    _Msg_RemoveReleaseSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "althea/lockup/v1/tx.proto",
+}
+
+func (m *MsgAddLockExempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLockExempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLockExempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLockExemptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLockExemptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLockExemptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockExempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockExempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockExempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockExemptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockExemptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockExemptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReleaseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReleaseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveReleaseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveReleaseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddLockExempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddLockExemptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveLockExempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveLockExemptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLockDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnlockDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReleaseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReleaseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveReleaseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveReleaseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddLockExempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLockExempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLockExempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLockExemptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLockExemptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLockExemptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLockExempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLockExempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLockExempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLockExemptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLockExemptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLockExemptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReleaseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveReleaseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,11 +1,19 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "lockup"
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
+
+	// RouterKey is the module name router key
+	RouterKey = ModuleName
 )
 
 var (
@@ -13,8 +21,8 @@ var (
 	// In other words Locked -> The chain is "locked up"
 	LockedKey = []byte("locked")
 
-	// LockExemptKey Indexes the LockExempt addresses, who will be able to initiate transactions even
-	// when the chain is locked
+	// LockExemptKey Indexed the LockExempt addresses param before consensus version 3, the addresses are now
+	// held in the lockup store under LockExemptAddressKeyPrefix
 	LockExemptKey = []byte("lockExempt")
 
 	// LockedMessageTypesKey Indexes the LockedMessageTypes array, the collection of messages which
@@ -24,4 +32,44 @@ var (
 	// LockedTokenDenomsKey Indexes the LockedTokenDenoms array, the collection of tokens which
 	// will be blocked when the chain is locked up and not sent from a LockExempt address
 	LockedTokenDenomsKey = []byte("lockedTokenDenoms")
+
+	// UnlockHeightKey Indexes the UnlockHeight, the block height at which the chain will be automatically
+	// unlocked, zero if no unlock height is scheduled
+	UnlockHeightKey = []byte("unlockHeight")
+
+	// UnlockTimeKey Indexes the UnlockTime, the block time at which the chain will be automatically
+	// unlocked, the zero time if no unlock time is scheduled
+	UnlockTimeKey = []byte("unlockTime")
+
+	// ScheduledLockedTokenDenomsKey Indexes the ScheduledLockedTokenDenoms array, the pending changes to
+	// LockedTokenDenoms which will be automatically applied once their height or time arrives
+	ScheduledLockedTokenDenomsKey = []byte("scheduledLockedTokenDenoms")
 )
+
+var (
+	// LockExemptAddressKeyPrefix indexes the LockExempt addresses, who will be able to initiate transactions even
+	// when the chain is locked
+	LockExemptAddressKeyPrefix = []byte{0x1}
+
+	// ReleaseScheduleKeyPrefix indexes the ReleaseSchedules, which allow non-exempt addresses to move part of their
+	// balance of a locked denom while the chain is locked
+	ReleaseScheduleKeyPrefix = []byte{0x2}
+)
+
+// GetLockExemptAddressKey returns the key for a lock exempt address,
+// the key's format is [ LockExemptAddressKeyPrefix | address ]
+func GetLockExemptAddressKey(address string) []byte {
+	return append(append([]byte{}, LockExemptAddressKeyPrefix...), []byte(address)...)
+}
+
+// GetReleaseSchedulesByAddressKey returns the prefix of every release schedule of addr,
+// the key's format is [ ReleaseScheduleKeyPrefix | len(addr) | addr ]
+func GetReleaseSchedulesByAddressKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ReleaseScheduleKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// GetReleaseScheduleKey returns the key for the release schedule of addr for denom,
+// the key's format is [ ReleaseScheduleKeyPrefix | len(addr) | addr | denom ]
+func GetReleaseScheduleKey(addr sdk.AccAddress, denom string) []byte {
+	return append(GetReleaseSchedulesByAddressKey(addr), []byte(denom)...)
+}
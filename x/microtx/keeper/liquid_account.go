@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -18,6 +19,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	altheacommon "github.com/AltheaFoundation/althea-L1/x/common"
 	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
 	"github.com/AltheaFoundation/althea-L1/x/microtx/types"
 )
@@ -478,7 +480,7 @@ func (k Keeper) CallMethod(
 	}
 
 	// call method
-	resp, err := k.CallEVM(ctx, from, contractAddr, amount, data, true)
+	resp, err := k.CallEVM(ctx, from, contractAddr, amount, data, true, contract.ABI)
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.ErrContractCall, "EVM::CallMethod error applying message: %s", err.Error())
 	}
@@ -487,7 +489,8 @@ func (k Keeper) CallMethod(
 }
 
 // CallEVM performs a EVM transaction given the from address, the to address, amount to be sent, data and
-// whether to commit the tx in the EVM keeper.
+// whether to commit the tx in the EVM keeper. The revert reason of a failed call is decoded, using contractABIs to
+// decode custom errors.
 func (k Keeper) CallEVM(
 	ctx sdk.Context,
 	from common.Address,
@@ -495,6 +498,7 @@ func (k Keeper) CallEVM(
 	amount *big.Int,
 	data []byte,
 	commit bool,
+	contractABIs ...abi.ABI,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
 	if err != nil {
@@ -534,8 +538,8 @@ func (k Keeper) CallEVM(
 	// the EVM Tx is allowed to use, and then refunds remaining gas after execution.
 	ctx.GasMeter().ConsumeGas(res.GasUsed, "EVM call")
 
-	if res.Failed() {
-		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	if err := altheacommon.CheckEVMResponse(to, res, contractABIs...); err != nil {
+		return nil, err
 	}
 	return res, nil
 }
@@ -581,8 +585,8 @@ func (k Keeper) QueryEVM(
 		return nil, err
 	}
 
-	if res.Failed() {
-		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	if err := altheacommon.CheckEVMResponse(contractAddr, res, contract.ABI); err != nil {
+		return nil, err
 	}
 	return res, nil
 }
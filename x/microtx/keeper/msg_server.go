@@ -154,6 +154,8 @@ func (k Keeper) DeductMicrotxFee(ctx sdk.Context, sender sdk.AccAddress, sendAmo
 		ctx.Logger().Error("Could not deduct MsgMicrotx fee!", "error", err, "account", sender, "fee-basis-points", microtxFeeBasisPoints, "send-amount", sendAmount)
 		return nil, err
 	}
+	// nolint: exhaustruct
+	k.gasfreeKeeper.RecordFeesCollected(ctx, sdk.MsgTypeURL(&types.MsgMicrotx{}), sdk.NewCoins(collectedFee))
 
 	return &collectedFee, nil
 }
@@ -0,0 +1,135 @@
+package nativedex
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// EndBlocker executes the queued proposals whose timelock has expired, then runs the periodic treasury collection,
+// records the price oracle's observations, and pays the liquidity incentive programs
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	executeQueuedProposals(ctx, k)
+	autoCollectTreasury(ctx, k)
+	k.RecordOraclePrices(ctx)
+	k.DistributeIncentives(ctx)
+}
+
+// executeQueuedProposals executes the queued proposals whose timelock has expired, a proposal which fails is dropped
+// from the queue without any of its changes being applied
+func executeQueuedProposals(ctx sdk.Context, k keeper.Keeper) {
+	height := uint64(ctx.BlockHeight())
+	var due []types.QueuedProposal
+	k.IterateQueuedProposals(ctx, func(queued types.QueuedProposal) bool {
+		if queued.ExecuteHeight <= height {
+			due = append(due, queued)
+		}
+		return false
+	})
+	if len(due) == 0 {
+		return
+	}
+
+	execute := NewNativeDexProposalExecutor(&k)
+	for _, queued := range due {
+		k.DeleteQueuedProposal(ctx, queued.Id)
+
+		content := queued.ProposalContent()
+		attrs := []sdk.Attribute{sdk.NewAttribute(types.TimelockKeyQueuedID, strconv.FormatUint(queued.Id, 10))}
+		var err error
+		if content == nil {
+			err = errorsmod.Wrap(types.ErrInvalidProposal, "queued proposal has no content")
+		} else {
+			attrs = append(attrs, sdk.NewAttribute(types.TimelockKeyProposalType, content.ProposalType()))
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err = execute(cacheCtx, content); err == nil {
+				writeCache()
+			}
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("Timelocked proposal failed", "id", queued.Id, "err", err)
+			attrs = append(attrs, sdk.NewAttribute(types.TimelockKeySuccess, "false"), sdk.NewAttribute(types.TimelockKeyError, err.Error()))
+		} else {
+			k.Logger(ctx).Info("Executed timelocked proposal", "id", queued.Id)
+			attrs = append(attrs, sdk.NewAttribute(types.TimelockKeySuccess, "true"))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProposalExecuted, attrs...))
+	}
+}
+
+// autoCollectTreasury collects the protocol fees of each AutoCollectTokens token every AutoCollectInterval blocks,
+// using the safe mode callpath while the DEX is in safe mode. When the nativedex module is the DEX treasury the
+// proceeds are sent on to the AutoCollectDestination. A token which fails is skipped until the next interval
+func autoCollectTreasury(ctx sdk.Context, k keeper.Keeper) {
+	interval := k.GetAutoCollectInterval(ctx)
+	if interval == 0 || uint64(ctx.BlockHeight())%interval != 0 {
+		return
+	}
+	tokens := k.GetAutoCollectTokens(ctx)
+	if len(tokens) == 0 {
+		return
+	}
+
+	status, err := k.GetDexStatus(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to read the DEX status for treasury collection", "err", err)
+		return
+	}
+	destination := k.GetAutoCollectDestination(ctx)
+	if common.HexToAddress(status.Treasury) != types.ModuleEVMAddress {
+		// The fees are paid to a treasury the module does not control
+		destination = ""
+	}
+
+	for _, token := range tokens {
+		// nolint: exhaustruct
+		event := types.EventAutoCollectTreasury{TokenAddress: common.HexToAddress(token).Hex(), Destination: destination}
+		cacheCtx, writeCache := ctx.CacheContext()
+		proceeds, err := collectTreasuryToken(cacheCtx, k, common.HexToAddress(token), status.SafeMode, destination)
+		if err != nil {
+			k.Logger(ctx).Error("Automatic treasury collection failed", "token", token, "err", err)
+			event.Error = err.Error()
+		} else {
+			writeCache()
+			event.Success = true
+			event.Proceeds = proceeds.String()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			k.Logger(ctx).Error("Unable to emit treasury collection event", "err", err)
+		}
+	}
+}
+
+// collectTreasuryToken pays the protocol fees of token to the DEX treasury, then sends what the module received to
+// destination unless it is empty
+func collectTreasuryToken(ctx sdk.Context, k keeper.Keeper, token common.Address, inSafeMode bool, destination string) (sdk.Coins, error) {
+	var before sdk.Int
+	if destination != "" {
+		balance, err := k.GetModuleTokenBalance(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		before = balance
+	}
+
+	md := types.CollectTreasuryMetadata{TokenAddress: token.Hex()}
+	if err := executeCollectTreasury(ctx, &k, md, inSafeMode); err != nil {
+		return nil, err
+	}
+	if destination == "" {
+		return sdk.Coins{}, nil
+	}
+
+	after, err := k.GetModuleTokenBalance(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return k.RouteTreasuryProceeds(ctx, token, after.Sub(before), destination)
+}
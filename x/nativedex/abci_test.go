@@ -0,0 +1,39 @@
+package nativedex_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// TestEndBlockerBeforeMigration checks that the EndBlocker runs on a chain which has not yet run the v4 migration,
+// where none of the params it reads are set
+func TestEndBlockerBeforeMigration(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	nativedexKey := sdk.NewKVStoreKey(types.StoreKey)
+	tNativedexKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(nativedexKey, tNativedexKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, nativedexKey, tNativedexKey, types.ModuleName)
+	k := keeper.NewKeeper(nativedexKey, encCfg.Codec, paramstore, nil, nil, nil, nil, nil)
+
+	_, err := k.GetParamsIfSet(ctx)
+	require.Error(t, err)
+	require.Equal(t, uint64(0), k.GetAutoCollectInterval(ctx))
+	require.Equal(t, types.DefaultParams().OracleMaxObservations, k.GetOracleMaxObservations(ctx))
+
+	for _, height := range []int64{1, 10, 720, 1000} {
+		require.NotPanics(t, func() { nativedex.EndBlocker(ctx.WithBlockHeight(height), k) })
+	}
+}
@@ -23,6 +23,17 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryPool())
+	cmd.AddCommand(CmdQueryPoolTemplate())
+	cmd.AddCommand(CmdQueryPriceObservations())
+	cmd.AddCommand(CmdQueryTwap())
+	cmd.AddCommand(CmdQueryDexStatus())
+	cmd.AddCommand(CmdQueryPolicyRoles())
+	cmd.AddCommand(CmdQueryPositions())
+	cmd.AddCommand(CmdQueryQueuedProposals())
+	cmd.AddCommand(CmdQueryDecodeProposal())
+	cmd.AddCommand(CmdQueryIncentivePrograms())
+	cmd.AddCommand(CmdQueryIncentiveRewards())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,350 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func CmdQueryPool() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "pool [base] [quote] [pool-idx]",
+		Short: "shows the price, liquidity, and parameters of a DEX pool",
+		Long:  "shows the price, liquidity, and parameters (including the protocol take rate) of a DEX pool. base and quote are EVM token addresses, use 0x0000000000000000000000000000000000000000 for the native token",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			poolIdx, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pool(context.Background(), &types.QueryPoolRequest{Base: args[0], Quote: args[1], PoolIdx: poolIdx})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPoolTemplate() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "pool-template [pool-idx]",
+		Short: "shows the parameters new DEX pools will be created with for a pool index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			poolIdx, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolTemplate(context.Background(), &types.QueryPoolTemplateRequest{PoolIdx: poolIdx})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPriceObservations() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "price-observations [base] [quote] [pool-idx]",
+		Short: "shows the spot prices of a DEX pool recorded by the price oracle, oldest first",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			poolIdx, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceObservations(context.Background(), &types.QueryPriceObservationsRequest{Base: args[0], Quote: args[1], PoolIdx: poolIdx})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTwap() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote] [pool-idx] [window-seconds]",
+		Short: "shows the time weighted average price of a DEX pool tracked by the price oracle",
+		Long:  "shows the time weighted average value of one quote token base unit in base token base units, over the window ending at the latest block",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			poolIdx, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			window, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Twap(context.Background(), &types.QueryTwapRequest{Base: args[0], Quote: args[1], PoolIdx: poolIdx, WindowSeconds: window})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDexStatus() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "dex-status",
+		Short: "shows the treasury, safe mode status, and authority of the DEX contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DexStatus(context.Background(), &types.QueryDexStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPolicyRoles() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "policy-roles",
+		Short: "shows the ops, treasury, and emergency role addresses held by the CrocPolicy contract",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PolicyRoles(context.Background(), &types.QueryPolicyRolesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPositions() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "positions [owner]",
+		Short: "shows the DEX liquidity positions an account has opened via nativedex txs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Positions(context.Background(), &types.QueryPositionsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryQueuedProposals() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "queued-proposals",
+		Short: "shows the passed proposals waiting in the timelock queue",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDecodeProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "decode-proposal [proposal-id|json]",
+		Short: "decodes a nativedex proposal's metadata, including the commands of Ops proposals, into named and typed fields",
+		Long: `decodes a nativedex proposal's metadata, including the ABI encoded commands of Ops proposals and batch actions,
+into named and typed fields. The proposal is either the id of a submitted governance proposal, or the proposal content
+as JSON (inline or a path to a file) including its type, e.g. {"@type":"/althea.nativedex.v1.OpsProposal", ...}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var contentAny *codectypes.Any
+			if proposalID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				govClient := govv1beta1.NewQueryClient(clientCtx)
+				res, err := govClient.Proposal(context.Background(), &govv1beta1.QueryProposalRequest{ProposalId: proposalID})
+				if err != nil {
+					return err
+				}
+				contentAny = res.Proposal.Content
+			} else {
+				contentJSON := []byte(args[0])
+				if !strings.HasPrefix(strings.TrimSpace(args[0]), "{") {
+					contentJSON, err = os.ReadFile(filepath.Clean(args[0]))
+					if err != nil {
+						return err
+					}
+				}
+				var content govv1beta1.Content
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(contentJSON, &content); err != nil {
+					return errorsmod.Wrap(err, "invalid proposal content")
+				}
+				contentAny, err = codectypes.NewAnyWithValue(content)
+				if err != nil {
+					return errorsmod.Wrap(err, "invalid proposal content")
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DecodeProposal(context.Background(), &types.QueryDecodeProposalRequest{Content: contentAny})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIncentivePrograms() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "incentive-programs",
+		Short: "shows the liquidity incentive programs with epochs left to pay",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentivePrograms(context.Background(), &types.QueryIncentiveProgramsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIncentiveRewards() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "incentive-rewards [owner]",
+		Short: "shows the liquidity incentive rewards an account can claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncentiveRewards(context.Background(), &types.QueryIncentiveRewardsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -34,23 +34,37 @@ func GetTxCmd(storeKey string) *cobra.Command {
 	}
 
 	nativedexTxCmd.AddCommand([]*cobra.Command{
-		NewUpgradeProxyProposalCmd(),
-		NewCollectTreasuryProposalCmd(),
-		NewSetTreasuryProposalCmd(),
-		NewAuthorityTransferProposalCmd(),
-		NewHotPathOpenProposalCmd(),
-		NewSetSafeModeProposalCmd(),
-		NewTransferGovernanceProposalCmd(),
-		NewOpsProposalCmd(),
-		NewOpsDisableTemplateCmd(),
-		NewOpsSetTemplateCmd(),
-		NewOpsRevisePoolCmd(),
-		NewOpsSetTakeRateCmd(),
-		NewOpsSetRelayerTakeRateCmd(),
-		NewOpsResyncTakeRateCmd(),
-		NewOpsSetNewPoolLiqCmd(),
-		NewOpsPegPriceImproveCmd(),
-		NewExecuteContractProposalCmd(),
+		WithProposalDryRun(NewUpgradeProxyProposalCmd()),
+		WithProposalDryRun(NewCollectTreasuryProposalCmd()),
+		WithProposalDryRun(NewSetTreasuryProposalCmd()),
+		WithProposalDryRun(NewAuthorityTransferProposalCmd()),
+		WithProposalDryRun(NewHotPathOpenProposalCmd()),
+		WithProposalDryRun(NewSetSafeModeProposalCmd()),
+		WithProposalDryRun(NewTransferGovernanceProposalCmd()),
+		WithProposalDryRun(NewOpsProposalCmd()),
+		WithProposalDryRun(NewOpsDisableTemplateCmd()),
+		WithProposalDryRun(NewOpsSetTemplateCmd()),
+		WithProposalDryRun(NewOpsRevisePoolCmd()),
+		WithProposalDryRun(NewOpsSetTakeRateCmd()),
+		WithProposalDryRun(NewOpsSetRelayerTakeRateCmd()),
+		WithProposalDryRun(NewOpsResyncTakeRateCmd()),
+		WithProposalDryRun(NewOpsSetNewPoolLiqCmd()),
+		WithProposalDryRun(NewOpsPegPriceImproveCmd()),
+		WithProposalDryRun(NewExecuteContractProposalCmd()),
+		WithProposalDryRun(NewBatchDexProposalCmd()),
+		WithProposalDryRun(NewCancelTimelockedProposalCmd()),
+		WithProposalDryRun(NewAddWhitelistedContractProposalCmd()),
+		WithProposalDryRun(NewRemoveWhitelistedContractProposalCmd()),
+		WithProposalDryRun(NewCreateIncentiveProgramProposalCmd()),
+		WithProposalDryRun(NewCancelIncentiveProgramProposalCmd()),
+		NewSwapCmd(),
+		NewMintAmbientLiquidityCmd(),
+		NewMintRangeLiquidityCmd(),
+		NewBurnLiquidityCmd(),
+		NewHarvestCmd(),
+		NewCancelTimelockedCmd(),
+		NewClaimIncentivesCmd(),
+		NewRegisterIncentivePositionCmd(),
 	}...)
 
 	return nativedexTxCmd
@@ -588,6 +602,9 @@ func buildAndBroadcastOpsProposal(cmd *cobra.Command, title string, description
 	if err := msg.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "invalid proposal message")
 	}
+	if cliCtx.Simulate {
+		return SimulateProposal(cliCtx, proposal)
+	}
 	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 
 }
@@ -933,8 +950,9 @@ func NewExecuteContractProposalCmd() *cobra.Command {
 		Short: "Submit an ExecuteContract proposal",
 		Long: `Submit a proposal to execute an arbitrary contract call from the nativedex module account.
 The contract address must be whitelisted in the module params.
-The hex-data should be the encoded function call data (starting with 0x).`,
-		Example: fmt.Sprintf(`$ %s tx nativedex execute-contract 1000000aalthea "Call contract" "Execute contract call" 0x1234... 0xa9059cbb... --from=<key_or_address> --chain-id=<chain-id>`,
+The hex-data should be the encoded function call data (starting with 0x).
+The --value flag sends an amount of the native token from the nativedex module account with the call.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex execute-contract 1000000aalthea "Call contract" "Execute contract call" 0x1234... 0xa9059cbb... --value=1000000000000000000 --from=<key_or_address> --chain-id=<chain-id>`,
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -965,9 +983,18 @@ The hex-data should be the encoded function call data (starting with 0x).`,
 			}
 			cosmosAddr := clientCtx.GetFromAddress()
 
+			value, err := cmd.Flags().GetString(FlagValue)
+			if err != nil {
+				return err
+			}
+
 			propMetaData := types.ExecuteContractMetadata{
 				ContractAddress: contractAddress,
 				Data:            hexData,
+				Value:           value,
+			}
+			if err := propMetaData.ValidateBasic(); err != nil {
+				return err
 			}
 
 			content := types.NewExecuteContractProposal(title, description, propMetaData)
@@ -976,6 +1003,60 @@ The hex-data should be the encoded function call data (starting with 0x).`,
 		},
 	}
 
+	cmd.Flags().String(FlagValue, "", "the amount of the native token (in its base denom) to send with the call")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBatchDexProposalCmd implements the command to submit a BatchDexProposal
+func NewBatchDexProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "batch-dex [initial-deposit] [title] [description] [actions]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a BatchDex proposal",
+		Long: `Submit a proposal to execute several DEX governance actions atomically, in order.
+If any action fails then none of them take effect. Each action sets exactly one of the metadata fields
+used by the single proposal types (upgrade_proxy, collect_treasury, set_treasury, authority_transfer, hot_path_open,
+set_safe_mode, transfer_governance, ops, execute_contract) or treasury_cmd for a raw treasuryResolution command,
+along with in_safe_mode where relevant. The actions must be supplied via a JSON file, binary cmd_args are base64 encoded.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex batch-dex <deposit> <title> <description> <path/to/actions.json> --from=<key_or_address> --chain-id=<chain-id>
+
+Where actions.json contains (example):
+
+[
+	{"ops": {"callpath": 3, "cmd_args": "<base64 encoded setTemplate cmd>"}},
+	{"ops": {"callpath": 3, "cmd_args": "<base64 encoded revisePool cmd>"}},
+	{"set_treasury": {"treasury_address": "<hex address>"}, "in_safe_mode": false}
+]`, version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			actionsFile := args[3]
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+			actions, err := ParseBatchDexActions(clientCtx.Codec, actionsFile)
+			if err != nil {
+				return errorsmod.Wrap(err, "Failure to parse JSON object")
+			}
+
+			content := types.NewBatchDexProposal(title, description, actions)
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// NewCreateIncentiveProgramProposalCmd implements the command to submit a CreateIncentiveProgramProposal
+func NewCreateIncentiveProgramProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "create-incentive-program [initial-deposit] [title] [description] [base] [quote] [pool-idx] [reward-per-epoch] [epochs]",
+		Args:  cobra.ExactArgs(8),
+		Short: "Submit a CreateIncentiveProgram proposal",
+		Long: `Submit a proposal to reward the liquidity providers of a DEX pool from the community pool.
+Upon passing, reward-per-epoch times epochs is withdrawn from the community pool, and at the end of each epoch
+reward-per-epoch is split by each account's time weighted in range and ambient liquidity out of the pool's total.
+Only positions opened via nativedex txs or registered with register-incentive-position earn rewards.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex create-incentive-program 1000000000aalthea "Incentivize USDC" "Rewards USDC liquidity for 30 epochs" 0x0000000000000000000000000000000000000000 0x1234... 36000 1000000000000000000aalthea 30 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			if !common.IsHexAddress(args[3]) || !common.IsHexAddress(args[4]) {
+				return fmt.Errorf("base and quote must be EVM addresses")
+			}
+			poolIdx, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid pool index")
+			}
+			rewardPerEpoch, err := sdk.ParseCoinNormalized(args[6])
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid reward per epoch")
+			}
+			epochs, err := strconv.ParseUint(args[7], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid epochs")
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+
+			content := types.NewCreateIncentiveProgramProposal(
+				title, description, common.HexToAddress(args[3]), common.HexToAddress(args[4]), poolIdx, rewardPerEpoch, epochs,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelIncentiveProgramProposalCmd implements the command to submit a CancelIncentiveProgramProposal
+func NewCancelIncentiveProgramProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "cancel-incentive-program [initial-deposit] [title] [description] [program-id]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a CancelIncentiveProgram proposal",
+		Long:  `Submit a proposal to end a liquidity incentive program early, returning its remaining funds to the community pool.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex cancel-incentive-program 1000000000aalthea "Cancel incentives" "Ends incentive program 2" 2 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			programID, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid program id")
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+
+			content := types.NewCancelIncentiveProgramProposal(title, description, programID)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimIncentivesCmd implements the command to claim liquidity incentive rewards
+func NewClaimIncentivesCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "claim-incentives",
+		Args:  cobra.NoArgs,
+		Short: "Claim the liquidity incentive rewards paid to your positions",
+		Long:  `Collect every liquidity incentive reward paid to the sender's positions.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex claim-incentives --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimIncentives(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterIncentivePositionCmd implements the command to register a DEX position opened through the EVM for the
+// liquidity incentive programs
+func NewRegisterIncentivePositionCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "register-incentive-position [base] [quote] [pool-idx] [lower-tick] [upper-tick]",
+		Args:  cobra.RangeArgs(3, 5),
+		Short: "Register a native DEX position opened through the EVM for liquidity incentives",
+		Long: `Record the sender's existing position in the native DEX's (base, quote, pool-idx) pool, so that it earns liquidity
+incentive rewards. The pool must have an incentive program, and the position must be held by the sender's EVM address
+and have at least the IncentiveMinLiquidity.
+Range positions are identified by lower-tick and upper-tick, ambient positions by setting --ambient instead.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex register-incentive-position 0x1234... 0x5678... 36000 -- -1024 1024 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			base, quote, poolIdx, err := parsePoolArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			ambient, err := cmd.Flags().GetBool(FlagAmbient)
+			if err != nil {
+				return err
+			}
+			var lowerTick, upperTick int32
+			if !ambient {
+				if len(args) != 5 {
+					return fmt.Errorf("range positions require lower-tick and upper-tick, or set --%s", FlagAmbient)
+				}
+				lowerTick, upperTick, err = parseTicks(args[3], args[4])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterIncentivePosition(clientCtx.GetFromAddress().String(), base, quote, poolIdx, ambient, lowerTick, upperTick)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAmbient, false, "register the ambient position instead of a range position")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
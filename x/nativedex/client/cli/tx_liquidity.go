@@ -0,0 +1,267 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+const (
+	FlagAmbient     = "ambient"
+	FlagMinBaseOut  = "min-base-out"
+	FlagMinQuoteOut = "min-quote-out"
+)
+
+// NewMintAmbientLiquidityCmd implements the command to submit a MsgMintAmbientLiquidity
+func NewMintAmbientLiquidityCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "mint-ambient [base] [quote] [pool-idx] [amount] [max-other]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Provide full range liquidity to the native DEX from Cosmos coins",
+		Long: `Provide full range liquidity to the native DEX's (base, quote, pool-idx) pool without an EVM wallet.
+Exactly amount is deposited on one side of the pool, and at most max-other on the other side. Any unused coins are
+returned. Both coins must be registered with the erc20 module as the pool's tokens.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex mint-ambient 0x1234... 0x5678... 36000 1000000ibc/ABCD... 2000000ibc/EF01... --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			base, quote, poolIdx, err := parsePoolArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			amount, maxOther, err := parseMintAmounts(args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintAmbientLiquidity(clientCtx.GetFromAddress().String(), base, quote, poolIdx, amount, maxOther)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMintRangeLiquidityCmd implements the command to submit a MsgMintRangeLiquidity
+func NewMintRangeLiquidityCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "mint-range [base] [quote] [pool-idx] [lower-tick] [upper-tick] [amount] [max-other]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Provide concentrated liquidity to the native DEX from Cosmos coins",
+		Long: `Provide concentrated liquidity between lower-tick and upper-tick to the native DEX's (base, quote, pool-idx) pool
+without an EVM wallet. Exactly amount is deposited on one side of the pool, and at most max-other on the other side.
+Any unused coins are returned. Both coins must be registered with the erc20 module as the pool's tokens.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex mint-range 0x1234... 0x5678... 36000 -- -1024 1024 1000000ibc/ABCD... 2000000ibc/EF01... --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			base, quote, poolIdx, err := parsePoolArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			lowerTick, upperTick, err := parseTicks(args[3], args[4])
+			if err != nil {
+				return err
+			}
+			amount, maxOther, err := parseMintAmounts(args[5], args[6])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintRangeLiquidity(
+				clientCtx.GetFromAddress().String(), base, quote, poolIdx, lowerTick, upperTick, amount, maxOther,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBurnLiquidityCmd implements the command to submit a MsgBurnLiquidity
+func NewBurnLiquidityCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "burn [base] [quote] [pool-idx] [liquidity] [lower-tick] [upper-tick]",
+		Args:  cobra.RangeArgs(4, 6),
+		Short: "Withdraw liquidity from a native DEX position",
+		Long: `Burn liquidity units from the sender's position in the native DEX's (base, quote, pool-idx) pool.
+Range positions are identified by lower-tick and upper-tick, ambient positions by setting --ambient instead.
+The withdrawn tokens are returned as Cosmos coins unless --receive-erc20 is set, and the burn fails if less than
+--min-base-out or --min-quote-out is withdrawn.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex burn 0x1234... 0x5678... 36000 1048576 -- -1024 1024 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			base, quote, poolIdx, err := parsePoolArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			liquidity, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid liquidity %s", args[3])
+			}
+			ambient, err := cmd.Flags().GetBool(FlagAmbient)
+			if err != nil {
+				return err
+			}
+			var lowerTick, upperTick int32
+			if !ambient {
+				if len(args) != 6 {
+					return fmt.Errorf("range positions require lower-tick and upper-tick, or set --%s", FlagAmbient)
+				}
+				lowerTick, upperTick, err = parseTicks(args[4], args[5])
+				if err != nil {
+					return err
+				}
+			}
+			var minOuts [2]sdk.Int
+			for i, flag := range []string{FlagMinBaseOut, FlagMinQuoteOut} {
+				minOutStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				minOut, ok := sdk.NewIntFromString(minOutStr)
+				if !ok {
+					return fmt.Errorf("invalid --%s %s", flag, minOutStr)
+				}
+				minOuts[i] = minOut
+			}
+			receiveErc20, err := cmd.Flags().GetBool(FlagReceiveErc20)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnLiquidity(
+				clientCtx.GetFromAddress().String(), base, quote, poolIdx, ambient, lowerTick, upperTick, liquidity,
+				minOuts[0], minOuts[1], receiveErc20,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAmbient, false, "burn from the ambient position instead of a range position")
+	cmd.Flags().String(FlagMinBaseOut, "0", "the minimum amount of the base token to withdraw, protecting against slippage")
+	cmd.Flags().String(FlagMinQuoteOut, "0", "the minimum amount of the quote token to withdraw, protecting against slippage")
+	cmd.Flags().Bool(FlagReceiveErc20, false, "leave the withdrawn tokens as ERC20 tokens on the sender's EVM address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewHarvestCmd implements the command to submit a MsgHarvest
+func NewHarvestCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "harvest [base] [quote] [pool-idx] [lower-tick] [upper-tick]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Collect the rewards of a concentrated native DEX position",
+		Example: fmt.Sprintf(`$ %s tx nativedex harvest 0x1234... 0x5678... 36000 -- -1024 1024 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			base, quote, poolIdx, err := parsePoolArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			lowerTick, upperTick, err := parseTicks(args[3], args[4])
+			if err != nil {
+				return err
+			}
+			receiveErc20, err := cmd.Flags().GetBool(FlagReceiveErc20)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgHarvest(clientCtx.GetFromAddress().String(), base, quote, poolIdx, lowerTick, upperTick, receiveErc20)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagReceiveErc20, false, "leave the rewards as ERC20 tokens on the sender's EVM address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parsePoolArgs(baseArg, quoteArg, poolIdxArg string) (base, quote common.Address, poolIdx uint64, err error) {
+	if !common.IsHexAddress(baseArg) || !common.IsHexAddress(quoteArg) {
+		return common.Address{}, common.Address{}, 0, errorsmod.Wrap(types.ErrInvalidEvmAddress, "base and quote must be EVM addresses")
+	}
+	poolIdx, err = strconv.ParseUint(poolIdxArg, 10, 64)
+	if err != nil {
+		return common.Address{}, common.Address{}, 0, errorsmod.Wrap(err, "invalid pool index")
+	}
+	return common.HexToAddress(baseArg), common.HexToAddress(quoteArg), poolIdx, nil
+}
+
+func parseTicks(lowerArg, upperArg string) (lowerTick, upperTick int32, err error) {
+	lower, err := strconv.ParseInt(lowerArg, 10, 32)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "invalid lower tick")
+	}
+	upper, err := strconv.ParseInt(upperArg, 10, 32)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "invalid upper tick")
+	}
+	return int32(lower), int32(upper), nil
+}
+
+// nolint: exhaustruct
+func parseMintAmounts(amountArg, maxOtherArg string) (amount, maxOther sdk.Coin, err error) {
+	amount, err = sdk.ParseCoinNormalized(amountArg)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(err, "invalid amount")
+	}
+	maxOther, err = sdk.ParseCoinNormalized(maxOtherArg)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(err, "invalid max-other")
+	}
+	return amount, maxOther, nil
+}
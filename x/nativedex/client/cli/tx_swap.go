@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+const (
+	FlagMinOut       = "min-out"
+	FlagReceiveErc20 = "receive-erc20"
+)
+
+// NewSwapCmd implements the command to submit a MsgSwap
+func NewSwapCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "swap [base] [quote] [pool-idx] [amount-in]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Sell Cosmos coins on the native DEX",
+		Long: `Sell amount-in on the native DEX's (base, quote, pool-idx) pool without an EVM wallet.
+base and quote are the EVM token addresses of the pool, and amount-in must be the native token or a Cosmos coin
+registered with the erc20 module as one of them. The proceeds are returned as Cosmos coins unless --receive-erc20 is
+set, and any input the pool does not take is refunded.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex swap 0x0000000000000000000000000000000000000000 0x1234... 36000 1000000ibc/ABCD... --min-out=990000 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if !common.IsHexAddress(args[0]) || !common.IsHexAddress(args[1]) {
+				return errorsmod.Wrap(types.ErrInvalidEvmAddress, "base and quote must be EVM addresses")
+			}
+			poolIdx, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid pool index")
+			}
+			amountIn, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid amount-in")
+			}
+
+			minOutStr, err := cmd.Flags().GetString(FlagMinOut)
+			if err != nil {
+				return err
+			}
+			minOut, ok := sdk.NewIntFromString(minOutStr)
+			if !ok {
+				return fmt.Errorf("invalid --%s %s", FlagMinOut, minOutStr)
+			}
+			receiveErc20, err := cmd.Flags().GetBool(FlagReceiveErc20)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwap(
+				clientCtx.GetFromAddress().String(), common.HexToAddress(args[0]), common.HexToAddress(args[1]),
+				poolIdx, amountIn, minOut, receiveErc20,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMinOut, "0", "the minimum amount of the other token to receive, protecting against slippage")
+	cmd.Flags().Bool(FlagReceiveErc20, false, "leave the proceeds as ERC20 tokens on the sender's EVM address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// NewCancelTimelockedProposalCmd implements the command to submit a CancelTimelockedProposal
+func NewCancelTimelockedProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "cancel-timelocked-proposal [initial-deposit] [title] [description] [queued-id]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a CancelTimelockedProposal",
+		Long:  `Submit a proposal to remove a passed proposal from the nativedex timelock queue before it executes.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex cancel-timelocked-proposal 1000000000aalthea "Cancel upgrade" "Cancels queued proxy upgrade 3" 3 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			queuedID, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid queued id")
+			}
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+
+			content := types.NewCancelTimelockedProposal(title, description, queuedID)
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelTimelockedCmd implements the command to submit a MsgCancelTimelockedProposal
+func NewCancelTimelockedCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "cancel-timelocked [queued-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a timelocked proposal as the DEX emergency authority",
+		Long: `Remove a passed proposal from the nativedex timelock queue before it executes.
+The sender must be the account of the CrocPolicy emergency authority.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex cancel-timelocked 3 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queuedID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid queued id")
+			}
+
+			msg := types.NewMsgCancelTimelockedProposal(clientCtx.GetFromAddress().String(), queuedID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
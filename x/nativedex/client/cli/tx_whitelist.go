@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+const (
+	FlagValue     = "value"
+	FlagSelectors = "selectors"
+)
+
+// NewAddWhitelistedContractProposalCmd implements the command to submit an AddWhitelistedContractProposal
+func NewAddWhitelistedContractProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "add-whitelisted-contract [initial-deposit] [title] [description] [contract-address]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit an AddWhitelistedContract proposal",
+		Long: `Submit a proposal to allow ExecuteContract proposals to call contract-address.
+The --selectors flag restricts the calls to the given function selectors, without it any call is allowed.
+If the contract is already whitelisted its allowed selectors are replaced.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex add-whitelisted-contract 1000000aalthea "Whitelist treasury" "Allow transfers from the treasury token" 0x1234... --selectors=0xa9059cbb,0x095ea7b3 --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			selectors, err := cmd.Flags().GetStringSlice(FlagSelectors)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+
+			content := types.NewAddWhitelistedContractProposal(title, description, args[3], selectors)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagSelectors, []string{}, "comma separated function selectors the contract may be called with, e.g. 0xa9059cbb")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveWhitelistedContractProposalCmd implements the command to submit a RemoveWhitelistedContractProposal
+func NewRemoveWhitelistedContractProposalCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "remove-whitelisted-contract [initial-deposit] [title] [description] [contract-address]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a RemoveWhitelistedContract proposal",
+		Long:  `Submit a proposal to stop ExecuteContract proposals from calling contract-address.`,
+		Example: fmt.Sprintf(`$ %s tx nativedex remove-whitelisted-contract 1000000aalthea "Remove treasury" "The treasury token was migrated" 0x1234... --from=<key_or_address> --chain-id=<chain-id>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return errorsmod.Wrap(err, "bad initial deposit amount")
+			}
+			title := args[1]
+			description := args[2]
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := clientCtx.GetFromAddress()
+
+			content := types.NewRemoveWhitelistedContractProposal(title, description, args[3])
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return GenericProposalCmdBroadcast(cmd, clientCtx, content, initialDeposit, cosmosAddr)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -78,10 +80,39 @@ func GenericProposalCmdBroadcast(cmd *cobra.Command, clientCtx client.Context, c
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
+	if clientCtx.Simulate {
+		return SimulateProposal(clientCtx, content)
+	}
 
 	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 }
 
+// SimulateProposal executes the proposal content against the latest chain state with the SimulateProposal query and
+// prints the outcome, this is used instead of broadcasting when --dry-run is set
+func SimulateProposal(clientCtx client.Context, content govv1beta1.Content) error {
+	contentAny, err := codectypes.NewAnyWithValue(content)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid proposal content")
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SimulateProposal(context.Background(), &types.QuerySimulateProposalRequest{Content: contentAny})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// WithProposalDryRun documents that --dry-run simulates the proposal's execution on a proposal command
+func WithProposalDryRun(cmd *cobra.Command) *cobra.Command {
+	if f := cmd.Flags().Lookup(flags.FlagDryRun); f != nil {
+		f.Usage = "simulate the proposal's execution against the latest state and print the outcome instead of submitting it, " +
+			"state changes are only reported for the DEX and CrocPolicy authorities, treasury, and safe mode"
+	}
+	return cmd
+}
+
 func AddGenericProposalCommandFlags(cmd *cobra.Command) {
 	cmd.Flags().String(flags.FlagHome, "", "The application home directory")
 	// nolint: staticcheck
@@ -247,3 +278,19 @@ func ParseExecuteContractMetadata(cdc codec.JSONCodec, metadataFile string) (typ
 
 	return propMetaData, nil
 }
+
+// ParseBatchDexActions reads the JSON list of actions of a BatchDexProposal
+func ParseBatchDexActions(cdc codec.JSONCodec, actionsFile string) ([]types.DexAction, error) {
+	actions := []types.DexAction{}
+
+	contents, err := os.ReadFile(filepath.Clean(actionsFile))
+	if err != nil {
+		return actions, err
+	}
+
+	if err = json.Unmarshal(contents, &actions); err != nil {
+		return actions, err
+	}
+
+	return actions, nil
+}
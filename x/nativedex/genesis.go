@@ -13,16 +13,32 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper authkeeper.AccountKeeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+	}
+	k.InitQueuedProposals(ctx, genState.QueuedProposals)
+	k.InitPriceObservations(ctx, genState.OracleObservations)
+	k.InitIncentives(ctx, genState.IncentivePrograms, genState.IncentiveRewards, genState.IncentiveSamples, genState.IncentiveAccruals)
 
 	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
 		panic("the nativedex module account has not been set")
 	}
+	if acc := accountKeeper.GetModuleAccount(ctx, types.IncentivesModuleName); acc == nil {
+		panic("the nativedex incentives module account has not been set")
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.Positions = k.GetPositions(ctx)
+	genesis.QueuedProposals = k.GetQueuedProposals(ctx)
+	genesis.OracleObservations = k.GetAllPriceObservations(ctx)
+	genesis.IncentivePrograms = k.GetIncentivePrograms(ctx)
+	genesis.IncentiveRewards = k.GetAllIncentiveRewards(ctx)
+	genesis.IncentiveSamples = k.GetAllIncentiveSamples(ctx)
+	genesis.IncentiveAccruals = k.GetAllIncentiveAccruals(ctx)
 
 	return genesis
 }
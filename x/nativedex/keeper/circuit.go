@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	circuittypes "github.com/AltheaFoundation/althea-L1/x/circuit/types"
+)
+
+// checkCircuits returns an error if msg, which the module is about to execute on a user's behalf, or any of the
+// contracts it is about to call has been disabled by a tripped circuit. The module's conversions and EVM calls do not
+// pass through the circuit ante decorator, MsgServiceRouter or EVM hook, so they are checked here. msg may be nil when
+// only contracts are called.
+func (k Keeper) checkCircuits(ctx sdk.Context, msg sdk.Msg, contracts ...common.Address) error {
+	if msg != nil {
+		typeURL := sdk.MsgTypeURL(msg)
+		allowed, err := k.CircuitKeeper.IsAllowed(sdk.WrapSDKContext(ctx), typeURL)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errorsmod.Wrapf(circuittypes.ErrCircuitTripped, "%s is disabled", typeURL)
+		}
+	}
+	for _, contract := range contracts {
+		if k.CircuitKeeper.IsContractTripped(ctx, contract) {
+			return errorsmod.Wrapf(circuittypes.ErrCircuitTripped, "contract %s is disabled", contract.Hex())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// GetDexStatus reads the treasury, safe mode status, and authority of the DEX contract. CrocSwapDex has no getters
+// for these, so they are read from its storage slots
+func (k Keeper) GetDexStatus(ctx sdk.Context) (types.QueryDexStatusResponse, error) {
+	flags, err := k.readDexSlot(ctx, contracts.CrocFlagsSlot)
+	if err != nil {
+		return types.QueryDexStatusResponse{}, err
+	}
+	authority, err := k.readDexSlot(ctx, contracts.CrocAuthoritySlot)
+	if err != nil {
+		return types.QueryDexStatusResponse{}, err
+	}
+	treasury, err := k.readDexSlot(ctx, contracts.CrocTreasurySlot)
+	if err != nil {
+		return types.QueryDexStatusResponse{}, err
+	}
+
+	return types.QueryDexStatusResponse{
+		Treasury:  contracts.CrocSlotAddress(treasury).Hex(),
+		SafeMode:  contracts.CrocSlotByte(flags, contracts.CrocSafeModeOffset) != 0,
+		Authority: contracts.CrocSlotAddress(authority).Hex(),
+	}, nil
+}
+
+// readDexSlot reads a storage slot of the DEX contract with CrocSwapDex.readSlot()
+func (k Keeper) readDexSlot(ctx sdk.Context, slot uint64) (*big.Int, error) {
+	dex := k.GetNativeDexAddress(ctx)
+	if dex == (common.Address{}) {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvmAddress, "VerifiedNativeDexAddress has not been set")
+	}
+
+	// CrocSwapDex ABI: readSlot (uint256 slot) returns (uint256 data)
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocSwapDexABI, types.ModuleEVMAddress, dex, false, "readSlot", new(big.Int).SetUint64(slot))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to read DEX storage slot %d", slot)
+	}
+	out, err := contracts.CrocSwapDexABI.Unpack("readSlot", res.Ret)
+	if err != nil || len(out) != 1 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unable to decode DEX storage slot %d: %v", slot, err)
+	}
+	value, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unexpected DEX storage slot %d type", slot)
+	}
+
+	return value, nil
+}
+
+// GetPolicyRoles reads the ops, treasury, and emergency role addresses from the CrocPolicy contract
+func (k Keeper) GetPolicyRoles(ctx sdk.Context) (types.QueryPolicyRolesResponse, error) {
+	policy := k.GetVerifiedCrocPolicyAddress(ctx)
+	if policy == (common.Address{}) {
+		return types.QueryPolicyRolesResponse{}, errorsmod.Wrap(types.ErrInvalidEvmAddress, "VerifiedCrocPolicyAddress has not been set")
+	}
+
+	policyABI := contracts.CrocPolicyContract.ABI
+	ops, err := k.callViewAddress(ctx, policyABI, policy, "opsAuthority_")
+	if err != nil {
+		return types.QueryPolicyRolesResponse{}, err
+	}
+	treasury, err := k.callViewAddress(ctx, policyABI, policy, "treasuryAuthority_")
+	if err != nil {
+		return types.QueryPolicyRolesResponse{}, err
+	}
+	emergency, err := k.callViewAddress(ctx, policyABI, policy, "emergencyAuthority_")
+	if err != nil {
+		return types.QueryPolicyRolesResponse{}, err
+	}
+
+	return types.QueryPolicyRolesResponse{
+		OpsAuthority:       ops.Hex(),
+		TreasuryAuthority:  treasury.Hex(),
+		EmergencyAuthority: emergency.Hex(),
+	}, nil
+}
+
+// callView simulates a call to a view function with no arguments and a single output, returning the decoded output
+func (k Keeper) callView(ctx sdk.Context, contractABI abi.ABI, contract common.Address, method string) ([]interface{}, error) {
+	res, err := k.EVMKeeper.CallEVM(ctx, contractABI, types.ModuleEVMAddress, contract, false, method)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to call %s()", method)
+	}
+	out, err := contractABI.Unpack(method, res.Ret)
+	if err != nil || len(out) != 1 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unable to decode %s() result: %v", method, err)
+	}
+
+	return out, nil
+}
+
+// callViewAddress simulates a call to a view function which returns a single address
+func (k Keeper) callViewAddress(ctx sdk.Context, contractABI abi.ABI, contract common.Address, method string) (common.Address, error) {
+	out, err := k.callView(ctx, contractABI, contract, method)
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, ok := out[0].(common.Address)
+	if !ok {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unexpected %s() result type", method)
+	}
+
+	return addr, nil
+}
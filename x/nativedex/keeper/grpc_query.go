@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,3 +25,202 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Base) || !common.IsHexAddress(req.Quote) {
+		return nil, status.Error(codes.InvalidArgument, "base and quote must be EVM addresses")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	base, quote := common.HexToAddress(req.Base), common.HexToAddress(req.Quote)
+
+	priceRoot, err := k.GetPoolPriceRoot(ctx, base, quote, req.PoolIdx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	liquidity, err := k.GetPoolLiquidity(ctx, base, quote, req.PoolIdx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	poolParams, err := k.GetPoolParams(ctx, base, quote, req.PoolIdx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolResponse{
+		Price:      SqrtPriceToPrice(priceRoot),
+		PriceRoot:  sdk.NewIntFromBigInt(priceRoot),
+		Liquidity:  liquidity,
+		PoolParams: poolParams,
+	}, nil
+}
+
+func (k Keeper) PoolTemplate(c context.Context, req *types.QueryPoolTemplateRequest) (*types.QueryPoolTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	template, err := k.GetPoolTemplate(ctx, req.PoolIdx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolTemplateResponse{Template: template}, nil
+}
+
+func (k Keeper) DexStatus(c context.Context, req *types.QueryDexStatusRequest) (*types.QueryDexStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.GetDexStatus(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &res, nil
+}
+
+func (k Keeper) PolicyRoles(c context.Context, req *types.QueryPolicyRolesRequest) (*types.QueryPolicyRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.GetPolicyRoles(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &res, nil
+}
+
+func (k Keeper) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	positions := k.GetPositionsByOwner(ctx, owner)
+	infos := make([]types.PositionInfo, 0, len(positions))
+	for _, position := range positions {
+		info, err := k.GetPositionInfo(ctx, position)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		infos = append(infos, info)
+	}
+
+	return &types.QueryPositionsResponse{Positions: infos}, nil
+}
+
+func (k Keeper) QueuedProposals(c context.Context, req *types.QueryQueuedProposalsRequest) (*types.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryQueuedProposalsResponse{QueuedProposals: k.GetQueuedProposals(ctx)}, nil
+}
+
+func (k Keeper) PriceObservations(c context.Context, req *types.QueryPriceObservationsRequest) (*types.QueryPriceObservationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Base) || !common.IsHexAddress(req.Quote) {
+		return nil, status.Error(codes.InvalidArgument, "base and quote must be EVM addresses")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	base, quote := common.HexToAddress(req.Base), common.HexToAddress(req.Quote)
+
+	return &types.QueryPriceObservationsResponse{Observations: k.GetPriceObservations(ctx, base, quote, req.PoolIdx)}, nil
+}
+
+func (k Keeper) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !common.IsHexAddress(req.Base) || !common.IsHexAddress(req.Quote) {
+		return nil, status.Error(codes.InvalidArgument, "base and quote must be EVM addresses")
+	}
+	if req.WindowSeconds == 0 || req.WindowSeconds > uint64(math.MaxInt64/int64(time.Second)) {
+		return nil, status.Error(codes.InvalidArgument, "invalid window")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	base, quote := common.HexToAddress(req.Base), common.HexToAddress(req.Quote)
+
+	twap, start, err := k.computeTwap(ctx, base, quote, req.PoolIdx, time.Duration(req.WindowSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryTwapResponse{Twap: twap, StartTime: start}, nil
+}
+
+func (k Keeper) SimulateProposal(c context.Context, req *types.QuerySimulateProposalRequest) (*types.QuerySimulateProposalResponse, error) {
+	if req == nil || req.Content == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var content govv1beta1.Content
+	if err := k.cdc.UnpackAny(req.Content, &content); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to unpack proposal content: %v", err)
+	}
+	if content.ProposalRoute() != types.RouterKey {
+		return nil, status.Errorf(codes.InvalidArgument, "%T is not a %s proposal", content, types.ModuleName)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := k.SimulateProposalContent(ctx, content)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &res, nil
+}
+
+func (k Keeper) DecodeProposal(c context.Context, req *types.QueryDecodeProposalRequest) (*types.QueryDecodeProposalResponse, error) {
+	if req == nil || req.Content == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var content govv1beta1.Content
+	if err := k.cdc.UnpackAny(req.Content, &content); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to unpack proposal content: %v", err)
+	}
+	if content.ProposalRoute() != types.RouterKey {
+		return nil, status.Errorf(codes.InvalidArgument, "%T is not a %s proposal", content, types.ModuleName)
+	}
+
+	res, err := types.DecodeProposal(content)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &res, nil
+}
+
+func (k Keeper) IncentivePrograms(c context.Context, req *types.QueryIncentiveProgramsRequest) (*types.QueryIncentiveProgramsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIncentiveProgramsResponse{Programs: k.GetIncentivePrograms(ctx)}, nil
+}
+
+func (k Keeper) IncentiveRewards(c context.Context, req *types.QueryIncentiveRewardsRequest) (*types.QueryIncentiveRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIncentiveRewardsResponse{Rewards: k.GetIncentiveRewards(ctx, owner)}, nil
+}
@@ -0,0 +1,593 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// CreateIncentiveProgram withdraws the funds for every epoch of a new incentive program from the community pool into
+// the incentives module account and stores the program, which starts paying at the end of the current epoch
+func (k Keeper) CreateIncentiveProgram(
+	ctx sdk.Context, base, quote common.Address, poolIdx uint64, rewardPerEpoch sdk.Coin, epochs uint64,
+) (types.IncentiveProgram, error) {
+	if k.GetIncentiveEpochBlocks(ctx) == 0 {
+		return types.IncentiveProgram{}, errorsmod.Wrap(types.ErrInvalidIncentive, "IncentiveEpochBlocks must be set before funding a program")
+	}
+	if err := types.ValidateIncentiveProgram(base.Hex(), quote.Hex(), poolIdx, rewardPerEpoch, epochs); err != nil {
+		return types.IncentiveProgram{}, err
+	}
+
+	id := k.getNextIncentiveProgramID(ctx)
+	program := types.NewIncentiveProgram(id, base, quote, poolIdx, rewardPerEpoch, epochs, uint64(ctx.BlockHeight()))
+	if err := k.DistrKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(program.Remaining), types.IncentivesModuleAddress); err != nil {
+		return types.IncentiveProgram{}, errorsmod.Wrap(err, "unable to fund the program from the community pool")
+	}
+	k.SetIncentiveProgram(ctx, program)
+	k.setNextIncentiveProgramID(ctx, id+1)
+
+	k.Logger(ctx).Info("Created incentive program", "id", id, "pool", program.PoolIdx, "funds", program.Remaining)
+	return program, nil
+}
+
+// CancelIncentiveProgram ends an incentive program early, returning its remaining funds to the community pool
+func (k Keeper) CancelIncentiveProgram(ctx sdk.Context, id uint64) (sdk.Coin, error) {
+	program, found := k.GetIncentiveProgram(ctx, id)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no incentive program with id %d", id)
+	}
+	k.DeleteIncentiveProgram(ctx, id)
+	k.deleteIncentiveAccruals(ctx, id)
+	if err := k.refundIncentiveProgram(ctx, program); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.Logger(ctx).Info("Cancelled incentive program", "id", id, "refund", program.Remaining)
+	return program.Remaining, nil
+}
+
+// DistributeIncentives runs the incentive programs every block: it adds the active liquidity of each incentivized pool
+// to its programs, samples the next IncentivePositionsPerBlock positions, and every IncentiveEpochBlocks blocks pays
+// each program's epoch reward to the accounts which earned liquidity-blocks in its pool. The DEX is read once per pool
+// and once per sampled position, however many positions and programs there are. Positions are still sampled without
+// any programs, so that those which can earn nothing are forgotten. A program whose epoch fails is not charged for the
+// epoch and pays at the next one instead
+func (k Keeper) DistributeIncentives(ctx sdk.Context) {
+	epochBlocks := k.GetIncentiveEpochBlocks(ctx)
+	if epochBlocks == 0 {
+		return
+	}
+
+	pools := newIncentivePools(k.GetIncentivePrograms(ctx))
+	k.accrueIncentivePools(ctx, pools)
+	k.sampleIncentivePositions(ctx, pools)
+	if uint64(ctx.BlockHeight())%epochBlocks != 0 {
+		return
+	}
+
+	for _, program := range k.GetIncentivePrograms(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		event, err := k.payIncentiveEpoch(cacheCtx, program)
+		if err != nil {
+			k.Logger(ctx).Error("Incentive program epoch failed", "id", program.Id, "err", err)
+			// nolint: exhaustruct
+			event = types.EventIncentiveEpoch{ProgramId: program.Id, Error: err.Error(), EpochsPaid: program.EpochsPaid}
+		} else {
+			writeCache()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			k.Logger(ctx).Error("Unable to emit incentive epoch event", "err", err)
+		}
+	}
+}
+
+// payIncentiveEpoch splits the program's epoch reward between the accounts which earned liquidity-blocks in its pool,
+// out of the pool's liquidity-blocks over the epoch, then starts the next epoch. Only the store is read, the DEX was
+// read as the liquidity was sampled. After the final epoch the program is removed and its remaining funds, including
+// the share of liquidity which earned nothing, are returned to the community pool
+func (k Keeper) payIncentiveEpoch(ctx sdk.Context, program types.IncentiveProgram) (types.EventIncentiveEpoch, error) {
+	accruals := k.GetIncentiveAccruals(ctx, program.Id)
+	earned := make([]sdk.Int, len(accruals))
+	totalEarned := sdk.ZeroInt()
+	for i, accrual := range accruals {
+		earned[i] = accrual.LiquidityBlocks
+		totalEarned = totalEarned.Add(accrual.LiquidityBlocks)
+	}
+
+	reward := program.EpochReward()
+	distributed := sdk.ZeroInt()
+	recipients := uint64(0)
+	for i, share := range types.SplitIncentiveReward(reward.Amount, program.GetPoolLiquidityBlocks(), earned) {
+		if !share.IsPositive() {
+			continue
+		}
+		k.AddIncentiveRewards(ctx, sdk.MustAccAddressFromBech32(accruals[i].Owner), sdk.NewCoins(sdk.NewCoin(reward.Denom, share)))
+		distributed = distributed.Add(share)
+		recipients++
+	}
+	k.deleteIncentiveAccruals(ctx, program.Id)
+
+	// nolint: exhaustruct
+	event := types.EventIncentiveEpoch{
+		ProgramId:       program.Id,
+		Success:         true,
+		Distributed:     sdk.NewCoin(reward.Denom, distributed).String(),
+		Recipients:      recipients,
+		Liquidity:       program.GetPoolLiquidityBlocks().String(),
+		EarnedLiquidity: totalEarned.String(),
+	}
+	program.Remaining = program.Remaining.Sub(sdk.NewCoin(reward.Denom, distributed))
+	program.PoolLiquidityBlocks = sdk.ZeroInt()
+	program.EpochsPaid++
+	event.EpochsPaid = program.EpochsPaid
+	if !program.Finished() {
+		k.SetIncentiveProgram(ctx, program)
+		return event, nil
+	}
+
+	k.DeleteIncentiveProgram(ctx, program.Id)
+	if err := k.refundIncentiveProgram(ctx, program); err != nil {
+		return types.EventIncentiveEpoch{}, err
+	}
+	event.Refund = program.Remaining.String()
+	k.Logger(ctx).Info("Incentive program finished", "id", program.Id, "refund", program.Remaining)
+	return event, nil
+}
+
+// incentivePoolID identifies a DEX pool
+type incentivePoolID struct {
+	base, quote common.Address
+	poolIdx     uint64
+}
+
+// incentivePool holds the programs of an incentivized pool and caches the pool's tick while it is sampled
+type incentivePool struct {
+	id       incentivePoolID
+	programs []types.IncentiveProgram
+	tick     *int32
+}
+
+// incentivePools are the incentivized pools in the order of their first program, so that they are processed
+// deterministically, indexed by pool
+type incentivePools struct {
+	ordered []*incentivePool
+	byID    map[incentivePoolID]*incentivePool
+}
+
+// newIncentivePools groups the programs by pool
+func newIncentivePools(programs []types.IncentiveProgram) incentivePools {
+	pools := incentivePools{ordered: nil, byID: make(map[incentivePoolID]*incentivePool)}
+	for _, program := range programs {
+		base, quote := program.Tokens()
+		id := incentivePoolID{base: base, quote: quote, poolIdx: program.PoolIdx}
+		pool, found := pools.byID[id]
+		if !found {
+			pool = &incentivePool{id: id, programs: nil, tick: nil}
+			pools.byID[id] = pool
+			pools.ordered = append(pools.ordered, pool)
+		}
+		pool.programs = append(pool.programs, program)
+	}
+	return pools
+}
+
+// get returns the incentivized pool of the position, or nil if its pool has no programs
+func (p incentivePools) get(position types.Position) *incentivePool {
+	return p.byID[incentivePoolID{
+		base: common.HexToAddress(position.Base), quote: common.HexToAddress(position.Quote), poolIdx: position.PoolIdx,
+	}]
+}
+
+// getTick reads the pool's current tick from the DEX the first time it is needed
+func (p *incentivePool) getTick(ctx sdk.Context, k Keeper) (int32, error) {
+	if p.tick == nil {
+		tick, err := k.GetPoolTick(ctx, p.id.base, p.id.quote, p.id.poolIdx)
+		if err != nil {
+			return 0, err
+		}
+		p.tick = &tick
+	}
+	return *p.tick, nil
+}
+
+// accrueIncentivePools adds each pool's current active liquidity to the liquidity-blocks of its programs, except for
+// programs funded in this block which start counting from the next one. A pool which can not be read accrues nothing
+// for the block, slightly raising the share of its sampled positions
+func (k Keeper) accrueIncentivePools(ctx sdk.Context, pools incentivePools) {
+	height := uint64(ctx.BlockHeight())
+	for _, pool := range pools.ordered {
+		liquidity, err := k.GetPoolLiquidity(ctx, pool.id.base, pool.id.quote, pool.id.poolIdx)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to read incentivized pool liquidity", "pool", pool.id.poolIdx, "err", err)
+			continue
+		}
+		for i, program := range pool.programs {
+			if program.CreatedHeight >= height {
+				continue
+			}
+			program.PoolLiquidityBlocks = program.GetPoolLiquidityBlocks().Add(liquidity)
+			k.SetIncentiveProgram(ctx, program)
+			pool.programs[i] = program
+		}
+	}
+}
+
+// sampleIncentivePositions samples the IncentivePositionsPerBlock positions following the cursor, starting again from
+// the first position once every position has been sampled. Positions in pools without a program count towards the
+// limit without being read from the DEX, so each block's store and DEX reads are both bounded. A position which can
+// not be sampled keeps its previous sample, and earns at its next sample instead. Positions in pools without a program
+// and positions the DEX reports as empty are forgotten, so the recorded positions can not grow without bound
+func (k Keeper) sampleIncentivePositions(ctx sdk.Context, pools incentivePools) {
+	limit := k.GetIncentivePositionsPerBlock(ctx)
+	minLiquidity := k.GetIncentiveMinLiquidity(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.PositionKeyPrefix
+	if cursor := store.Get(types.IncentiveSampleCursorKey); cursor != nil {
+		// The first key after the cursor
+		start = append(append([]byte{}, cursor...), 0x0)
+	}
+	var positions []types.Position
+	var last []byte
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.PositionKeyPrefix))
+	for ; iter.Valid() && uint64(len(positions)) < limit; iter.Next() {
+		var position types.Position
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		positions = append(positions, position)
+		last = append([]byte{}, iter.Key()...)
+	}
+	finished := !iter.Valid()
+	iter.Close()
+	if finished {
+		store.Delete(types.IncentiveSampleCursorKey)
+	} else {
+		store.Set(types.IncentiveSampleCursorKey, last)
+	}
+
+	for _, position := range positions {
+		pool := pools.get(position)
+		if pool == nil {
+			k.DeletePosition(ctx, position)
+			continue
+		}
+		empty, err := k.sampleIncentivePosition(ctx, position, pool, minLiquidity)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to sample incentivized position", "owner", position.Owner, "pool", position.PoolIdx, "err", err)
+			continue
+		}
+		if empty {
+			k.DeletePosition(ctx, position)
+		}
+	}
+}
+
+// sampleIncentivePosition reads the liquidity the position can currently earn with, credits each of the pool's
+// programs with the liquidity-blocks the position earned since its previous sample, and records the new sample. Blocks
+// before a program was funded earn nothing in it. Returns true if the DEX reports the position as empty
+func (k Keeper) sampleIncentivePosition(
+	ctx sdk.Context, position types.Position, pool *incentivePool, minLiquidity sdk.Int,
+) (empty bool, err error) {
+	liquidity, empty, err := k.getIncentiveLiquidity(ctx, position, pool, minLiquidity)
+	if err != nil {
+		return false, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if previous, found := k.GetIncentiveSample(ctx, position); found {
+		owner := sdk.MustAccAddressFromBech32(position.Owner)
+		for _, program := range pool.programs {
+			from := previous.Height
+			if program.CreatedHeight > from {
+				from = program.CreatedHeight
+			}
+			if height <= from {
+				continue
+			}
+			earned := types.EarnedLiquidityBlocks(previous.Liquidity, liquidity, height-from)
+			if earned.IsPositive() {
+				k.addIncentiveAccrual(ctx, program.Id, owner, earned)
+			}
+		}
+	}
+	k.SetIncentiveSample(ctx, types.IncentiveSample{Position: position, Height: height, Liquidity: liquidity})
+	return empty, nil
+}
+
+// getIncentiveLiquidity returns the position's DEX liquidity if it is ambient or in range and at least minLiquidity,
+// otherwise zero, and true if the DEX reports the position as empty. Range positions out of range are not read from
+// the DEX
+func (k Keeper) getIncentiveLiquidity(
+	ctx sdk.Context, position types.Position, pool *incentivePool, minLiquidity sdk.Int,
+) (liquidity sdk.Int, empty bool, err error) {
+	if !position.Ambient {
+		tick, err := pool.getTick(ctx, k)
+		if err != nil {
+			return sdk.Int{}, false, err
+		}
+		if tick < position.LowerTick || tick >= position.UpperTick {
+			return sdk.ZeroInt(), false, nil
+		}
+	}
+	info, err := k.GetPositionInfo(ctx, position)
+	if err != nil {
+		return sdk.Int{}, false, err
+	}
+	if info.Liquidity.IsZero() {
+		return sdk.ZeroInt(), true, nil
+	}
+	if info.Liquidity.LT(minLiquidity) {
+		return sdk.ZeroInt(), false, nil
+	}
+	return info.Liquidity, false, nil
+}
+
+// checkpointIncentives samples a position before the nativedex Msgs change its liquidity, crediting the liquidity it
+// held until now. A new position is sampled as empty, so it earns from its next sample on. A failed sample only
+// delays the position's earnings to its next sample, so it does not fail the Msg
+func (k Keeper) checkpointIncentives(ctx sdk.Context, position types.Position) {
+	if !k.HasPosition(ctx, position) {
+		k.SetIncentiveSample(ctx, types.IncentiveSample{Position: position, Height: uint64(ctx.BlockHeight()), Liquidity: sdk.ZeroInt()})
+		return
+	}
+	if k.GetIncentiveEpochBlocks(ctx) == 0 {
+		return
+	}
+	pool := newIncentivePools(k.GetIncentivePrograms(ctx)).get(position)
+	if pool == nil {
+		return
+	}
+	if _, err := k.sampleIncentivePosition(ctx, position, pool, k.GetIncentiveMinLiquidity(ctx)); err != nil {
+		k.Logger(ctx).Error("Unable to sample incentivized position", "owner", position.Owner, "pool", position.PoolIdx, "err", err)
+	}
+}
+
+// RegisterIncentivePosition records a position its owner opened through the EVM so that the incentive programs sample
+// it. The position's pool must have an incentive program and the position must hold at least IncentiveMinLiquidity,
+// it is sampled as empty so it earns from its next sample
+func (k Keeper) RegisterIncentivePosition(ctx sdk.Context, position types.Position) error {
+	if k.HasPosition(ctx, position) {
+		return errorsmod.Wrap(types.ErrInvalidPosition, "the position is already recorded")
+	}
+	if newIncentivePools(k.GetIncentivePrograms(ctx)).get(position) == nil {
+		return errorsmod.Wrapf(types.ErrInvalidIncentive, "pool %d of the position has no incentive program", position.PoolIdx)
+	}
+	info, err := k.GetPositionInfo(ctx, position)
+	if err != nil {
+		return err
+	}
+	if !info.Liquidity.IsPositive() || info.Liquidity.LT(k.GetIncentiveMinLiquidity(ctx)) {
+		return errorsmod.Wrapf(types.ErrInvalidPosition, "the position's liquidity %s is below the incentive minimum", info.Liquidity)
+	}
+
+	k.checkpointIncentives(ctx, position)
+	k.SetPosition(ctx, position)
+	return nil
+}
+
+// refundIncentiveProgram returns a removed program's remaining funds to the community pool
+func (k Keeper) refundIncentiveProgram(ctx sdk.Context, program types.IncentiveProgram) error {
+	if !program.Remaining.IsPositive() {
+		return nil
+	}
+	if err := k.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(program.Remaining), types.IncentivesModuleAddress); err != nil {
+		return errorsmod.Wrapf(err, "unable to refund incentive program %d", program.Id)
+	}
+	return nil
+}
+
+// ClaimIncentives sends owner all of their unclaimed incentive rewards
+func (k Keeper) ClaimIncentives(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	rewards := k.GetIncentiveRewards(ctx, owner)
+	if rewards.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidIncentive, "%s has no incentive rewards to claim", owner)
+	}
+	k.SetIncentiveRewards(ctx, owner, sdk.Coins{})
+	if err := k.BankKeeper.SendCoins(ctx, types.IncentivesModuleAddress, owner, rewards); err != nil {
+		return nil, errorsmod.Wrap(err, "unable to send incentive rewards")
+	}
+
+	return rewards, ctx.EventManager().EmitTypedEvent(&types.EventIncentivesClaimed{
+		Owner:   owner.String(),
+		Rewards: rewards.String(),
+	})
+}
+
+// SetIncentiveProgram stores an incentive program under its id
+func (k Keeper) SetIncentiveProgram(ctx sdk.Context, program types.IncentiveProgram) {
+	ctx.KVStore(k.storeKey).Set(types.GetIncentiveProgramKey(program.Id), k.cdc.MustMarshal(&program))
+}
+
+// GetIncentiveProgram returns the incentive program with the given id, if any
+// nolint: exhaustruct
+func (k Keeper) GetIncentiveProgram(ctx sdk.Context, id uint64) (types.IncentiveProgram, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetIncentiveProgramKey(id))
+	if bz == nil {
+		return types.IncentiveProgram{}, false
+	}
+	var program types.IncentiveProgram
+	k.cdc.MustUnmarshal(bz, &program)
+	return program, true
+}
+
+// DeleteIncentiveProgram removes an incentive program, its remaining funds must be refunded separately
+func (k Keeper) DeleteIncentiveProgram(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetIncentiveProgramKey(id))
+}
+
+// GetIncentivePrograms returns every incentive program in id order
+func (k Keeper) GetIncentivePrograms(ctx sdk.Context) []types.IncentiveProgram {
+	programs := []types.IncentiveProgram{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveProgramKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var program types.IncentiveProgram
+		k.cdc.MustUnmarshal(iter.Value(), &program)
+		programs = append(programs, program)
+	}
+	return programs
+}
+
+// GetIncentiveRewards returns the unclaimed incentive rewards of owner
+func (k Keeper) GetIncentiveRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetIncentiveRewardsKey(owner))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+	var rewards types.IncentiveRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards.Rewards
+}
+
+// SetIncentiveRewards stores the unclaimed incentive rewards of owner, removing the record if rewards is empty
+func (k Keeper) SetIncentiveRewards(ctx sdk.Context, owner sdk.AccAddress, rewards sdk.Coins) {
+	key := types.GetIncentiveRewardsKey(owner)
+	if rewards.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	record := types.IncentiveRewards{Owner: owner.String(), Rewards: rewards}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// AddIncentiveRewards adds rewards to the unclaimed incentive rewards of owner
+func (k Keeper) AddIncentiveRewards(ctx sdk.Context, owner sdk.AccAddress, rewards sdk.Coins) {
+	k.SetIncentiveRewards(ctx, owner, k.GetIncentiveRewards(ctx, owner).Add(rewards...))
+}
+
+// GetAllIncentiveRewards returns the unclaimed incentive rewards of every account
+func (k Keeper) GetAllIncentiveRewards(ctx sdk.Context) []types.IncentiveRewards {
+	all := []types.IncentiveRewards{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveRewardsKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.IncentiveRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		all = append(all, rewards)
+	}
+	return all
+}
+
+// InitIncentives stores the genesis incentive programs, unclaimed rewards, samples, and accruals, continuing program
+// ids after the largest program id
+func (k Keeper) InitIncentives(
+	ctx sdk.Context, programs []types.IncentiveProgram, rewards []types.IncentiveRewards,
+	samples []types.IncentiveSample, accruals []types.IncentiveAccrual,
+) {
+	nextID := uint64(1)
+	for _, program := range programs {
+		program.PoolLiquidityBlocks = program.GetPoolLiquidityBlocks()
+		k.SetIncentiveProgram(ctx, program)
+		if program.Id >= nextID {
+			nextID = program.Id + 1
+		}
+	}
+	k.setNextIncentiveProgramID(ctx, nextID)
+
+	for _, r := range rewards {
+		k.SetIncentiveRewards(ctx, sdk.MustAccAddressFromBech32(r.Owner), r.Rewards)
+	}
+	for _, sample := range samples {
+		k.SetIncentiveSample(ctx, sample)
+	}
+	for _, accrual := range accruals {
+		k.addIncentiveAccrual(ctx, accrual.ProgramId, sdk.MustAccAddressFromBech32(accrual.Owner), accrual.LiquidityBlocks)
+	}
+}
+
+// SetIncentiveSample stores the latest incentive sample of a position
+func (k Keeper) SetIncentiveSample(ctx sdk.Context, sample types.IncentiveSample) {
+	ctx.KVStore(k.storeKey).Set(types.GetIncentiveSampleKey(sample.Position), k.cdc.MustMarshal(&sample))
+}
+
+// GetIncentiveSample returns the latest incentive sample of a position, if it has been sampled
+// nolint: exhaustruct
+func (k Keeper) GetIncentiveSample(ctx sdk.Context, position types.Position) (types.IncentiveSample, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetIncentiveSampleKey(position))
+	if bz == nil {
+		return types.IncentiveSample{}, false
+	}
+	var sample types.IncentiveSample
+	k.cdc.MustUnmarshal(bz, &sample)
+	return sample, true
+}
+
+// GetAllIncentiveSamples returns the latest incentive sample of every sampled position
+func (k Keeper) GetAllIncentiveSamples(ctx sdk.Context) []types.IncentiveSample {
+	samples := []types.IncentiveSample{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.IncentiveSampleKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sample types.IncentiveSample
+		k.cdc.MustUnmarshal(iter.Value(), &sample)
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// addIncentiveAccrual adds liquidityBlocks to those owner has earned in the current epoch of a program
+func (k Keeper) addIncentiveAccrual(ctx sdk.Context, programID uint64, owner sdk.AccAddress, liquidityBlocks sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetIncentiveAccrualKey(programID, owner)
+	accrual := types.IncentiveAccrual{ProgramId: programID, Owner: owner.String(), LiquidityBlocks: liquidityBlocks}
+	if bz := store.Get(key); bz != nil {
+		var previous types.IncentiveAccrual
+		k.cdc.MustUnmarshal(bz, &previous)
+		accrual.LiquidityBlocks = accrual.LiquidityBlocks.Add(previous.LiquidityBlocks)
+	}
+	store.Set(key, k.cdc.MustMarshal(&accrual))
+}
+
+// GetIncentiveAccruals returns the liquidity-blocks each account has earned in the current epoch of a program
+func (k Keeper) GetIncentiveAccruals(ctx sdk.Context, programID uint64) []types.IncentiveAccrual {
+	return k.getIncentiveAccruals(ctx, types.GetIncentiveAccrualsKey(programID))
+}
+
+// GetAllIncentiveAccruals returns the liquidity-blocks each account has earned in the current epoch of every program
+func (k Keeper) GetAllIncentiveAccruals(ctx sdk.Context) []types.IncentiveAccrual {
+	return k.getIncentiveAccruals(ctx, types.IncentiveAccrualKeyPrefix)
+}
+
+func (k Keeper) getIncentiveAccruals(ctx sdk.Context, keyPrefix []byte) []types.IncentiveAccrual {
+	accruals := []types.IncentiveAccrual{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var accrual types.IncentiveAccrual
+		k.cdc.MustUnmarshal(iter.Value(), &accrual)
+		accruals = append(accruals, accrual)
+	}
+	return accruals
+}
+
+// deleteIncentiveAccruals removes every accrual of a program, once its epoch has been paid or it has been cancelled
+func (k Keeper) deleteIncentiveAccruals(ctx sdk.Context, programID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, accrual := range k.GetIncentiveAccruals(ctx, programID) {
+		store.Delete(types.GetIncentiveAccrualKey(programID, sdk.MustAccAddressFromBech32(accrual.Owner)))
+	}
+}
+
+func (k Keeper) getNextIncentiveProgramID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextIncentiveProgramIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextIncentiveProgramID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextIncentiveProgramIDKey, sdk.Uint64ToBigEndian(id))
+}
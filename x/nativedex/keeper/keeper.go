@@ -20,7 +20,13 @@ type (
 		cdc        codec.BinaryCodec
 		paramSpace paramtypes.Subspace
 
-		EVMKeeper types.EVMKeeper
+		EVMKeeper     types.EVMKeeper
+		Erc20Keeper   types.Erc20Keeper
+		BankKeeper    types.BankKeeper
+		DistrKeeper   types.DistributionKeeper
+		CircuitKeeper types.CircuitKeeper
+
+		proposalHandlerFactory ProposalHandlerFactory
 	}
 )
 
@@ -30,7 +36,10 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	ek types.EVMKeeper,
-
+	erc20k types.Erc20Keeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	ck types.CircuitKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -38,10 +47,16 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramSpace: ps,
-		EVMKeeper:  ek,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    ps,
+		EVMKeeper:     ek,
+		Erc20Keeper:   erc20k,
+		BankKeeper:    bk,
+		DistrKeeper:   dk,
+		CircuitKeeper: ck,
+
+		proposalHandlerFactory: nil,
 	}
 }
 
@@ -68,6 +83,19 @@ func (k Keeper) GetParamsIfSet(ctx sdk.Context) (params types.Params, err error)
 		VerifiedNativeDexAddress:     "",
 		VerifiedCrocPolicyAddress:    "",
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     "",
+		TimelockedProposalTypes:      []string{},
+		TimelockBlocks:               0,
+		ContractAllowedSelectors:     []types.ContractSelectors{},
+		AutoCollectTokens:            []string{},
+		AutoCollectInterval:          0,
+		AutoCollectDestination:       "",
+		OraclePools:                  []types.OraclePool{},
+		OracleInterval:               0,
+		OracleMaxObservations:        0,
+		IncentiveEpochBlocks:         0,
+		IncentivePositionsPerBlock:   0,
+		IncentiveMinLiquidity:        sdk.ZeroInt(),
 	}
 	for _, pair := range tempParams.ParamSetPairs() {
 		if !k.paramSpace.Has(ctx, pair.Key) {
@@ -95,3 +123,64 @@ func (k Keeper) GetVerifiedCrocPolicyAddress(ctx sdk.Context) common.Address {
 func (k Keeper) GetWhitelistedContractAddresses(ctx sdk.Context) []string {
 	return k.GetParams(ctx).WhitelistedContractAddresses
 }
+
+func (k Keeper) GetVerifiedCrocQueryAddress(ctx sdk.Context) common.Address {
+	return common.HexToAddress(k.GetParams(ctx).VerifiedCrocQueryAddress)
+}
+
+// The getters below read the single params used by the EndBlocker. If the param is not set yet (e.g. before the v4
+// migration), the default param value is used, so that the EndBlocker does not panic before the upgrade runs
+
+func (k Keeper) GetAutoCollectTokens(ctx sdk.Context) []string {
+	tokens := types.DefaultParams().AutoCollectTokens
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyAutoCollectTokens), &tokens)
+	return tokens
+}
+
+func (k Keeper) GetAutoCollectInterval(ctx sdk.Context) uint64 {
+	interval := types.DefaultParams().AutoCollectInterval
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyAutoCollectInterval), &interval)
+	return interval
+}
+
+func (k Keeper) GetAutoCollectDestination(ctx sdk.Context) string {
+	destination := types.DefaultParams().AutoCollectDestination
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyAutoCollectDestination), &destination)
+	return destination
+}
+
+func (k Keeper) GetOraclePools(ctx sdk.Context) []types.OraclePool {
+	pools := types.DefaultParams().OraclePools
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyOraclePools), &pools)
+	return pools
+}
+
+func (k Keeper) GetOracleInterval(ctx sdk.Context) uint64 {
+	interval := types.DefaultParams().OracleInterval
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyOracleInterval), &interval)
+	return interval
+}
+
+func (k Keeper) GetOracleMaxObservations(ctx sdk.Context) uint64 {
+	maxObservations := types.DefaultParams().OracleMaxObservations
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyOracleMaxObservations), &maxObservations)
+	return maxObservations
+}
+
+func (k Keeper) GetIncentiveEpochBlocks(ctx sdk.Context) uint64 {
+	epochBlocks := types.DefaultParams().IncentiveEpochBlocks
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyIncentiveEpochBlocks), &epochBlocks)
+	return epochBlocks
+}
+
+func (k Keeper) GetIncentivePositionsPerBlock(ctx sdk.Context) uint64 {
+	positions := types.DefaultParams().IncentivePositionsPerBlock
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyIncentivePositionsPerBlock), &positions)
+	return positions
+}
+
+func (k Keeper) GetIncentiveMinLiquidity(ctx sdk.Context) sdk.Int {
+	minLiquidity := types.DefaultParams().IncentiveMinLiquidity
+	k.paramSpace.GetIfExists(ctx, []byte(types.ParamsStoreKeyIncentiveMinLiquidity), &minLiquidity)
+	return minLiquidity
+}
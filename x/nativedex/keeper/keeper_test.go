@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -18,6 +21,9 @@ import (
 	"github.com/tendermint/tendermint/version"
 
 	althea "github.com/AltheaFoundation/althea-L1/app"
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	circuittypes "github.com/AltheaFoundation/althea-L1/x/circuit/types"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
 	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
 )
 
@@ -101,6 +107,7 @@ func (suite *KeeperTestSuite) TestGetParamsIfSet_ParamsSet() {
 		VerifiedNativeDexAddress:     testDexAddr,
 		VerifiedCrocPolicyAddress:    testPolicyAddr,
 		WhitelistedContractAddresses: testWhitelist,
+		VerifiedCrocQueryAddress:     "",
 	}
 
 	suite.app.NativedexKeeper.SetParams(suite.ctx, newParams)
@@ -138,6 +145,7 @@ func (suite *KeeperTestSuite) TestSetAndGetParams() {
 		VerifiedNativeDexAddress:     testDexAddr,
 		VerifiedCrocPolicyAddress:    testPolicyAddr,
 		WhitelistedContractAddresses: testWhitelist,
+		VerifiedCrocQueryAddress:     "",
 	}
 
 	// Set params
@@ -159,3 +167,186 @@ func (suite *KeeperTestSuite) TestSetAndGetParams() {
 	// Both methods should return identical params
 	suite.Require().True(params1.Equal(params2), "GetParams and GetParamsIfSet should return identical params")
 }
+
+// TestDexStateQueries_Unconfigured tests that the DEX state queries fail cleanly before the DEX contracts are configured
+func (suite *KeeperTestSuite) TestDexStateQueries_Unconfigured() {
+	k := suite.app.NativedexKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890").Hex()
+
+	// Malformed token addresses are rejected before any EVM call
+	_, err := k.Pool(ctx, &types.QueryPoolRequest{Base: "not an address", Quote: token, PoolIdx: 36000})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// The default params leave every DEX contract address unset
+	_, err = k.Pool(ctx, &types.QueryPoolRequest{Base: common.Address{}.Hex(), Quote: token, PoolIdx: 36000})
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "VerifiedCrocQueryAddress has not been set")
+
+	_, err = k.PoolTemplate(ctx, &types.QueryPoolTemplateRequest{PoolIdx: 36000})
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "VerifiedCrocQueryAddress has not been set")
+
+	_, err = k.DexStatus(ctx, &types.QueryDexStatusRequest{})
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "VerifiedNativeDexAddress has not been set")
+
+	_, err = k.PolicyRoles(ctx, &types.QueryPolicyRolesRequest{})
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "VerifiedCrocPolicyAddress has not been set")
+
+	// A configured address without deployed code can not be decoded
+	params := k.GetParams(suite.ctx)
+	params.VerifiedCrocPolicyAddress = token
+	k.SetParams(suite.ctx, params)
+	_, err = k.PolicyRoles(ctx, &types.QueryPolicyRolesRequest{})
+	suite.Require().Error(err)
+}
+
+// TestSwap_Rejected tests that MsgSwap refuses inputs it can not convert before touching the DEX
+func (suite *KeeperTestSuite) TestSwap_Rejected() {
+	msgServer := keeper.NewMsgServerImpl(*suite.app.NativedexKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	// The native token is always the base side, so it can not be sold on a pool of two ERC20s
+	other := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	msg := types.NewMsgSwap(sender, other, token, 36000, sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(1000)), sdk.ZeroInt(), false)
+	_, err := msgServer.Swap(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidSwap)
+
+	// Native input is sent as the swap's value, which requires the DEX to be set
+	msg = types.NewMsgSwap(sender, common.Address{}, token, 36000, sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(1000)), sdk.ZeroInt(), false)
+	_, err = msgServer.Swap(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidEvmAddress)
+
+	// Denoms without an erc20 module token pair can not be converted to ERC20
+	msg = types.NewMsgSwap(sender, common.Address{}, token, 36000, sdk.NewCoin("ibc/UNREGISTERED", sdk.NewInt(1000)), sdk.ZeroInt(), false)
+	_, err = msgServer.Swap(ctx, msg)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "has no registered token pair")
+}
+
+// TestSwap_CircuitTripped tests that MsgSwap respects circuits on the DEX contract, since its EVM calls bypass the
+// circuit ante decorator and EVM hook
+func (suite *KeeperTestSuite) TestSwap_CircuitTripped() {
+	msgServer := keeper.NewMsgServerImpl(*suite.app.NativedexKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	dex := common.HexToAddress("0xd263DC98dEc57828e26F69bA8687281BA5D052E0")
+
+	params := suite.app.NativedexKeeper.GetParams(suite.ctx)
+	params.VerifiedNativeDexAddress = dex.Hex()
+	suite.app.NativedexKeeper.SetParams(suite.ctx, params)
+	suite.app.CircuitKeeper.SetTrippedCircuit(suite.ctx, circuittypes.TrippedCircuit{
+		CircuitType: circuittypes.CIRCUIT_TYPE_CONTRACT,
+		Target:      dex.Hex(),
+		TrippedBy:   sender,
+		ExpiresAt:   time.Time{},
+	})
+
+	msg := types.NewMsgSwap(sender, common.Address{}, token, 36000, sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(1000)), sdk.ZeroInt(), false)
+	_, err := msgServer.Swap(ctx, msg)
+	suite.Require().ErrorIs(err, circuittypes.ErrCircuitTripped)
+}
+
+// TestPositions tests that positions are indexed by owner
+func (suite *KeeperTestSuite) TestPositions() {
+	k := suite.app.NativedexKeeper
+	alice := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	bob := sdk.AccAddress(common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes())
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	aliceAmbient := types.NewAmbientPosition(alice, common.Address{}, token, 36000)
+	aliceRange := types.NewRangePosition(alice, common.Address{}, token, 36000, -1024, 1024)
+	bobRange := types.NewRangePosition(bob, common.Address{}, token, 36000, -1024, 1024)
+	for _, p := range []types.Position{aliceAmbient, aliceRange, bobRange} {
+		k.SetPosition(suite.ctx, p)
+	}
+
+	suite.Require().Len(k.GetPositions(suite.ctx), 3)
+	suite.Require().ElementsMatch([]types.Position{aliceAmbient, aliceRange}, k.GetPositionsByOwner(suite.ctx, alice))
+	suite.Require().Equal([]types.Position{bobRange}, k.GetPositionsByOwner(suite.ctx, bob))
+
+	k.DeletePosition(suite.ctx, aliceRange)
+	suite.Require().False(k.HasPosition(suite.ctx, aliceRange))
+	suite.Require().Equal([]types.Position{aliceAmbient}, k.GetPositionsByOwner(suite.ctx, alice))
+	suite.Require().True(k.HasPosition(suite.ctx, bobRange))
+}
+
+// TestMintLiquidity_Rejected tests that liquidity msgs refuse inputs they can not convert before touching the DEX
+func (suite *KeeperTestSuite) TestMintLiquidity_Rejected() {
+	msgServer := keeper.NewMsgServerImpl(*suite.app.NativedexKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	native := sdk.NewCoin(altheacfg.BaseDenom, sdk.NewInt(1000))
+	unregistered := sdk.NewCoin("ibc/UNREGISTERED", sdk.NewInt(1000))
+
+	// Pools with the native token as base can not be funded since module EVM calls do not transfer value
+	_, err := msgServer.MintAmbientLiquidity(ctx, types.NewMsgMintAmbientLiquidity(sender, common.Address{}, token, 36000, unregistered, native))
+	suite.Require().ErrorIs(err, types.ErrInvalidPosition)
+
+	// Denoms without an erc20 module token pair can not be converted to ERC20
+	other := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	_, err = msgServer.MintRangeLiquidity(ctx, types.NewMsgMintRangeLiquidity(
+		sender, token, other, 36000, -1024, 1024, unregistered, sdk.NewCoin("ibc/OTHER", sdk.NewInt(1000)),
+	))
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "has no registered token pair")
+
+	suite.Require().Empty(suite.app.NativedexKeeper.GetPositionsByOwner(suite.ctx, sdk.MustAccAddressFromBech32(sender)))
+}
+
+// TestPriceOracle checks that recorded observations are averaged over time and can value tokens in either direction
+func (suite *KeeperTestSuite) TestPriceOracle() {
+	k := suite.app.NativedexKeeper
+	base := common.Address{}
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	other := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	params := k.GetParams(suite.ctx)
+	params.OraclePools = []types.OraclePool{types.NewOraclePool(base, quote, 36000)}
+	k.SetParams(suite.ctx, params)
+
+	_, err := k.GetTwap(suite.ctx, base, quote, 36000, time.Minute)
+	suite.Require().Error(err, "a pool without observations has no TWAP")
+
+	start := suite.ctx.BlockTime()
+	k.SetPriceObservation(suite.ctx, base, quote, 36000, types.PriceObservation{Height: 10, Time: start, Price: sdk.NewDec(2)})
+	k.SetPriceObservation(suite.ctx, base, quote, 36000, types.PriceObservation{Height: 20, Time: start.Add(30 * time.Second), Price: sdk.NewDec(4)})
+	ctx := suite.ctx.WithBlockTime(start.Add(60 * time.Second))
+
+	twap, err := k.GetTwap(ctx, base, quote, 36000, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3), twap)
+	twap, err = k.GetTwap(ctx, base, quote, 36000, 30*time.Second)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(4), twap)
+	_, err = k.GetTwap(ctx, base, quote, 36000, 10*time.Second)
+	suite.Require().Error(err, "the latest observation is older than the window")
+
+	// The pool's price is quote valued in base, and is inverted to value base in quote
+	price, err := k.GetTokenPrice(ctx, quote, base, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3), price)
+	price, err = k.GetTokenPrice(ctx, base, quote, time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec().Quo(sdk.NewDec(3)), price)
+	_, err = k.GetTokenPrice(ctx, other, base, time.Minute)
+	suite.Require().Error(err)
+
+	_, found := k.GetNativePrice(ctx, "ibc/unregistered")
+	suite.Require().False(found, "a denom without an ERC20 has no native price")
+
+	res, err := k.Twap(sdk.WrapSDKContext(ctx), &types.QueryTwapRequest{Base: base.Hex(), Quote: quote.Hex(), PoolIdx: 36000, WindowSeconds: 3600})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3), res.Twap)
+	suite.Require().True(start.Equal(res.StartTime), "the TWAP starts at the oldest observation")
+
+	all := k.GetAllPriceObservations(ctx)
+	suite.Require().Len(all, 1)
+	suite.Require().Equal(params.OraclePools[0], all[0].Pool)
+	suite.Require().Len(all[0].Observations, 2)
+}
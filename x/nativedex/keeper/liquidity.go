@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// MintLiquidity adds liquidity to the position from its owner's EVM address. Exactly amount is deposited on one side
+// of the pool and at most maxOther on the other side, both are converted to ERC20 tokens and approved for the DEX.
+// The owner's previous DEX allowances are restored afterwards, anything not taken by the DEX is converted back to
+// Cosmos coins, and the position is recorded for the owner. The
+// position's incentive sample is taken first, so the added liquidity earns from the position's next sample on.
+func (k Keeper) MintLiquidity(ctx sdk.Context, position types.Position, amount, maxOther sdk.Coin) (deposited, refunded sdk.Coins, err error) {
+	owner := sdk.MustAccAddressFromBech32(position.Owner)
+	ownerEVM := common.BytesToAddress(owner.Bytes())
+	base, quote := common.HexToAddress(position.Base), common.HexToAddress(position.Quote)
+	if base == (common.Address{}) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPosition, "pools with the native token are not supported, use the EVM instead")
+	}
+
+	amountPair, err := k.getTokenPair(ctx, amount.Denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	otherPair, err := k.getTokenPair(ctx, maxOther.Denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	inBase := amountPair.GetERC20Contract() == base
+	otherToken := base
+	if inBase {
+		otherToken = quote
+	}
+	if !inBase && amountPair.GetERC20Contract() != quote {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPosition, "%s is not in the pool", amount.Denom)
+	}
+	if otherPair.GetERC20Contract() != otherToken {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPosition, "%s is not the other side of the pool", maxOther.Denom)
+	}
+
+	// Move the funds into the owner's EVM account and allow the DEX to take them
+	for _, coin := range []sdk.Coin{amount, maxOther} {
+		if !coin.IsPositive() {
+			continue
+		}
+		convert := &erc20types.MsgConvertCoin{
+			Coin:     coin,
+			Receiver: ownerEVM.Hex(),
+			Sender:   position.Owner,
+		}
+		if err := k.checkCircuits(ctx, convert, base, quote); err != nil {
+			return nil, nil, err
+		}
+		if _, err := k.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), convert); err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "unable to convert %s to ERC20", coin)
+		}
+	}
+	prevAmountAllowance, err := k.dexAllowance(ctx, ownerEVM, amountPair.GetERC20Contract())
+	if err != nil {
+		return nil, nil, err
+	}
+	prevOtherAllowance, err := k.dexAllowance(ctx, ownerEVM, otherToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.approveDex(ctx, ownerEVM, amountPair.GetERC20Contract(), amount.Amount); err != nil {
+		return nil, nil, err
+	}
+	if err := k.approveDex(ctx, ownerEVM, otherToken, maxOther.Amount); err != nil {
+		return nil, nil, err
+	}
+
+	k.checkpointIncentives(ctx, position)
+	var code uint8
+	switch {
+	case position.Ambient && inBase:
+		code = contracts.CrocMintAmbientBaseCode
+	case position.Ambient:
+		code = contracts.CrocMintAmbientQuoteCode
+	case inBase:
+		code = contracts.CrocMintRangeBaseCode
+	default:
+		code = contracts.CrocMintRangeQuoteCode
+	}
+	baseFlow, quoteFlow, err := k.warmPathCmd(ctx, code, position, amount.Amount.BigInt())
+	if err != nil {
+		return nil, nil, err
+	}
+	if baseFlow.Sign() < 0 || quoteFlow.Sign() < 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPosition, "unexpected negative flow when minting liquidity")
+	}
+
+	// Restore the owner's own allowances and return whatever the DEX did not take
+	if err := k.approveDex(ctx, ownerEVM, amountPair.GetERC20Contract(), prevAmountAllowance); err != nil {
+		return nil, nil, err
+	}
+	if err := k.approveDex(ctx, ownerEVM, otherToken, prevOtherAllowance); err != nil {
+		return nil, nil, err
+	}
+	amountPaid, otherPaid := sdk.NewIntFromBigInt(quoteFlow), sdk.NewIntFromBigInt(baseFlow)
+	if inBase {
+		amountPaid, otherPaid = otherPaid, amountPaid
+	}
+	deposited = sdk.NewCoins(sdk.NewCoin(amount.Denom, amountPaid), sdk.NewCoin(maxOther.Denom, otherPaid))
+	refunded = sdk.NewCoins()
+	for _, unused := range []struct {
+		coin  sdk.Coin
+		token common.Address
+		paid  sdk.Int
+	}{{amount, amountPair.GetERC20Contract(), amountPaid}, {maxOther, otherToken, otherPaid}} {
+		leftover := unused.coin.Amount.Sub(unused.paid)
+		if !leftover.IsPositive() {
+			continue
+		}
+		coins, err := k.receiveOutput(ctx, owner, unused.token, leftover, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		refunded = refunded.Add(coins...)
+	}
+
+	k.SetPosition(ctx, position)
+	k.emitLiquidityEvent(ctx, types.EventTypeMintLiquidity, position, baseFlow, quoteFlow)
+	return deposited, refunded, nil
+}
+
+// BurnLiquidity removes liquidity from the position, paying the withdrawn tokens to its owner as Cosmos coins unless
+// keepErc20 is set. The burn fails if less than minBaseOut or minQuoteOut is withdrawn. The position's incentive sample
+// is taken first, so it earns for the liquidity it held until now. The position is forgotten once all of its liquidity
+// has been burned.
+func (k Keeper) BurnLiquidity(
+	ctx sdk.Context, position types.Position, liquidity, minBaseOut, minQuoteOut sdk.Int, keepErc20 bool,
+) (baseOut, quoteOut sdk.Int, coinsOut sdk.Coins, err error) {
+	code := contracts.CrocBurnRangeLiqCode
+	if position.Ambient {
+		code = contracts.CrocBurnAmbientLiqCode
+	}
+	if k.HasPosition(ctx, position) {
+		k.checkpointIncentives(ctx, position)
+	}
+	baseFlow, quoteFlow, err := k.warmPathCmd(ctx, code, position, liquidity.BigInt())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, nil, err
+	}
+	// Flows are from the owner's perspective, so the withdrawn amounts are negative
+	if withdrawn := new(big.Int).Neg(baseFlow); withdrawn.Cmp(minBaseOut.BigInt()) < 0 {
+		return sdk.Int{}, sdk.Int{}, nil, errorsmod.Wrapf(types.ErrInsufficientOut, "withdrew %s base, expected at least %s", withdrawn, minBaseOut)
+	}
+	if withdrawn := new(big.Int).Neg(quoteFlow); withdrawn.Cmp(minQuoteOut.BigInt()) < 0 {
+		return sdk.Int{}, sdk.Int{}, nil, errorsmod.Wrapf(types.ErrInsufficientOut, "withdrew %s quote, expected at least %s", withdrawn, minQuoteOut)
+	}
+	baseOut, quoteOut, coinsOut, err = k.receiveFlows(ctx, position, baseFlow, quoteFlow, keepErc20)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, nil, err
+	}
+
+	if k.HasPosition(ctx, position) {
+		info, err := k.GetPositionInfo(ctx, position)
+		if err == nil && info.Liquidity.IsZero() {
+			k.DeletePosition(ctx, position)
+		}
+	}
+	k.emitLiquidityEvent(ctx, types.EventTypeBurnLiquidity, position, baseFlow, quoteFlow)
+	return baseOut, quoteOut, coinsOut, nil
+}
+
+// Harvest collects the accumulated rewards of a range position, paying them to its owner as Cosmos coins unless
+// keepErc20 is set
+func (k Keeper) Harvest(ctx sdk.Context, position types.Position, keepErc20 bool) (baseOut, quoteOut sdk.Int, coinsOut sdk.Coins, err error) {
+	if position.Ambient {
+		return sdk.Int{}, sdk.Int{}, nil, errorsmod.Wrap(types.ErrInvalidPosition, "ambient positions compound their rewards")
+	}
+	baseFlow, quoteFlow, err := k.warmPathCmd(ctx, contracts.CrocHarvestCode, position, big.NewInt(0))
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, nil, err
+	}
+	baseOut, quoteOut, coinsOut, err = k.receiveFlows(ctx, position, baseFlow, quoteFlow, keepErc20)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, nil, err
+	}
+
+	k.emitLiquidityEvent(ctx, types.EventTypeHarvest, position, baseFlow, quoteFlow)
+	return baseOut, quoteOut, coinsOut, nil
+}
+
+// warmPathCmd calls CrocSwapDex.userCmd() on the warm path from the position owner's EVM address, returning the
+// resulting token flows. Flows are from the owner's perspective: positive values are paid to the pool, negative values
+// are received.
+func (k Keeper) warmPathCmd(ctx sdk.Context, code uint8, position types.Position, qty *big.Int) (baseFlow, quoteFlow *big.Int, err error) {
+	dex := k.GetNativeDexAddress(ctx)
+	if dex == (common.Address{}) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidEvmAddress, "VerifiedNativeDexAddress has not been set")
+	}
+	owner := common.BytesToAddress(sdk.MustAccAddressFromBech32(position.Owner).Bytes())
+	if err := k.checkCircuits(ctx, nil, dex, common.HexToAddress(position.Base), common.HexToAddress(position.Quote)); err != nil {
+		return nil, nil, err
+	}
+
+	// WarmPath ABI: (uint8 code, address base, address quote, uint256 poolIdx, int24 bidTick, int24 askTick, uint128 qty,
+	// uint128 limitLower, uint128 limitHigher, uint8 reserveFlags, address lpConduit)
+	cmd, err := contracts.EncodeTypes(
+		[]string{"uint8", "address", "address", "uint256", "int24", "int24", "uint128", "uint128", "uint128", "uint8", "address"},
+		[]interface{}{
+			code, common.HexToAddress(position.Base), common.HexToAddress(position.Quote), new(big.Int).SetUint64(position.PoolIdx),
+			big.NewInt(int64(position.LowerTick)), big.NewInt(int64(position.UpperTick)), qty,
+			contracts.CrocMinSqrtPrice, contracts.CrocMaxSqrtPrice, uint8(0), common.Address{},
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPosition, err.Error())
+	}
+
+	// CrocSwapDex ABI: userCmd (uint16 callpath, bytes cmd) returns (bytes)
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocSwapDexABI, owner, dex, true, "userCmd", contracts.CrocWarmPath, cmd)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unable to call CrocSwapDex.userCmd()")
+	}
+	out, err := contracts.CrocSwapDexABI.Unpack("userCmd", res.Ret)
+	if err != nil || len(out) != 1 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPosition, "unable to decode CrocSwapDex.userCmd() result: %v", err)
+	}
+	ret, ok := out[0].([]byte)
+	if !ok {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPosition, "unexpected CrocSwapDex.userCmd() result type")
+	}
+	// WarmPath returns (int128 baseFlow, int128 quoteFlow)
+	flows, err := contracts.DecodeTypes([]string{"int128", "int128"}, ret)
+	if err != nil || len(flows) != 2 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPosition, "unable to decode warm path flows: %v", err)
+	}
+	baseFlow, baseOk := flows[0].(*big.Int)
+	quoteFlow, quoteOk := flows[1].(*big.Int)
+	if !baseOk || !quoteOk {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidPosition, "unexpected warm path flow types")
+	}
+	return baseFlow, quoteFlow, nil
+}
+
+// receiveFlows pays out the tokens received by the position owner's EVM address from a burn or harvest
+func (k Keeper) receiveFlows(
+	ctx sdk.Context, position types.Position, baseFlow, quoteFlow *big.Int, keepErc20 bool,
+) (baseOut, quoteOut sdk.Int, coinsOut sdk.Coins, err error) {
+	owner := sdk.MustAccAddressFromBech32(position.Owner)
+	baseOut = sdk.NewIntFromBigInt(new(big.Int).Neg(baseFlow))
+	quoteOut = sdk.NewIntFromBigInt(new(big.Int).Neg(quoteFlow))
+	coinsOut = sdk.NewCoins()
+	for _, out := range []struct {
+		token  common.Address
+		amount sdk.Int
+	}{{common.HexToAddress(position.Base), baseOut}, {common.HexToAddress(position.Quote), quoteOut}} {
+		if !out.amount.IsPositive() {
+			continue
+		}
+		coins, err := k.receiveOutput(ctx, owner, out.token, out.amount, keepErc20)
+		if err != nil {
+			return sdk.Int{}, sdk.Int{}, nil, err
+		}
+		coinsOut = coinsOut.Add(coins...)
+	}
+	return baseOut, quoteOut, coinsOut, nil
+}
+
+// dexAllowance reads the DEX's allowance to spend owner's token
+func (k Keeper) dexAllowance(ctx sdk.Context, owner, token common.Address) (sdk.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := k.EVMKeeper.CallEVM(ctx, erc20, owner, token, false, "allowance", owner, k.GetNativeDexAddress(ctx))
+	if err != nil {
+		return sdk.Int{}, errorsmod.Wrapf(err, "unable to read the DEX's %s allowance", token.Hex())
+	}
+	out, err := erc20.Unpack("allowance", res.Ret)
+	if err != nil || len(out) != 1 {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unable to decode allowance() result: %v", err)
+	}
+	allowance, ok := out[0].(*big.Int)
+	if !ok {
+		return sdk.Int{}, errorsmod.Wrap(types.ErrInvalidEvmAddress, "unexpected allowance() result type")
+	}
+	return sdk.NewIntFromBigInt(allowance), nil
+}
+
+// approveDex sets the DEX's allowance to spend owner's token to amount
+func (k Keeper) approveDex(ctx sdk.Context, owner, token common.Address, amount sdk.Int) error {
+	_, err := k.EVMKeeper.CallEVM(
+		ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, owner, token, true, "approve", k.GetNativeDexAddress(ctx), amount.BigInt(),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to approve %s for the DEX", token.Hex())
+	}
+	return nil
+}
+
+func (k Keeper) emitLiquidityEvent(ctx sdk.Context, eventType string, position types.Position, baseFlow, quoteFlow *big.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.LiquidityKeyOwner, position.Owner),
+		sdk.NewAttribute(types.LiquidityKeyPoolIdx, strconv.FormatUint(position.PoolIdx, 10)),
+		sdk.NewAttribute(types.LiquidityKeyAmbient, strconv.FormatBool(position.Ambient)),
+		sdk.NewAttribute(types.LiquidityKeyLowerTick, strconv.FormatInt(int64(position.LowerTick), 10)),
+		sdk.NewAttribute(types.LiquidityKeyUpperTick, strconv.FormatInt(int64(position.UpperTick), 10)),
+		sdk.NewAttribute(types.LiquidityKeyBaseFlow, baseFlow.String()),
+		sdk.NewAttribute(types.LiquidityKeyQuoteFlow, quoteFlow.String()),
+	))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	lockuptypes "github.com/AltheaFoundation/althea-L1/x/lockup/types"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// LockupMsgInspectors returns the lockup module MsgInspectors for the nativedex Msgs moving funds out of an account,
+// allowing governance to lock them
+// nolint: exhaustruct
+func (k Keeper) LockupMsgInspectors() map[string]lockuptypes.MsgInspector {
+	return map[string]lockuptypes.MsgInspector{
+		sdk.MsgTypeURL(&types.MsgSwap{}): func(_ sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgSwap, ok := msg.(*types.MsgSwap)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return []lockuptypes.MovedFunds{{Sender: msgSwap.Sender, Coins: sdk.Coins{msgSwap.AmountIn}}}, nil
+		},
+		sdk.MsgTypeURL(&types.MsgMintAmbientLiquidity{}): func(_ sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgMint, ok := msg.(*types.MsgMintAmbientLiquidity)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return []lockuptypes.MovedFunds{{Sender: msgMint.Sender, Coins: sdk.NewCoins(msgMint.Amount).Add(msgMint.MaxOther)}}, nil
+		},
+		sdk.MsgTypeURL(&types.MsgMintRangeLiquidity{}): func(_ sdk.Context, msg sdk.Msg) ([]lockuptypes.MovedFunds, error) {
+			msgMint, ok := msg.(*types.MsgMintRangeLiquidity)
+			if !ok {
+				return nil, unexpectedLockupMsgError(msg)
+			}
+			return []lockuptypes.MovedFunds{{Sender: msgMint.Sender, Coins: sdk.NewCoins(msgMint.Amount).Add(msgMint.MaxOther)}}, nil
+		},
+	}
+}
+
+func unexpectedLockupMsgError(msg sdk.Msg) error {
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "nativedex lockup MsgInspector called with unexpected Msg %T", msg)
+}
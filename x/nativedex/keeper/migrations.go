@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v3 "github.com/AltheaFoundation/althea-L1/x/nativedex/migrations/v3"
+	v4 "github.com/AltheaFoundation/althea-L1/x/nativedex/migrations/v4"
+)
+
+// nolint: exhaustruct
+var _ module.MigrationHandler = Migrator{}.Migrate2to3
+
+// nolint: exhaustruct
+var _ module.MigrationHandler = Migrator{}.Migrate3to4
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.UpdateParams(ctx, &m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.UpdateParams(ctx, &m.keeper.paramSpace)
+}
@@ -1,6 +1,16 @@
 package keeper
 
 import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
 	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
 )
 
@@ -16,3 +26,240 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 // nolint: exhaustruct
 var _ types.MsgServer = msgServer{}
+
+// Swap converts the sender's Cosmos coins to ERC20 tokens, sells them on the native DEX from the sender's EVM address,
+// and (unless ReceiveErc20 is set) converts the proceeds back to Cosmos coins. Native token input is sent as the value
+// of the swap, and any input the pool did not take is refunded as Cosmos coins.
+func (m msgServer) Swap(c context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	senderEVM := common.BytesToAddress(sender.Bytes())
+	base, quote := common.HexToAddress(msg.Base), common.HexToAddress(msg.Quote)
+
+	// The native token is represented by the zero address and needs no conversion
+	var inputToken common.Address
+	if msg.AmountIn.Denom != altheacfg.BaseDenom {
+		inputPair, err := m.getTokenPair(ctx, msg.AmountIn.Denom)
+		if err != nil {
+			return nil, err
+		}
+		inputToken = inputPair.GetERC20Contract()
+	}
+	var sellBase bool
+	switch inputToken {
+	case base:
+		sellBase = true
+	case quote:
+		sellBase = false
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidSwap, "%s is registered as %s, which is not in the pool", msg.AmountIn.Denom, inputToken.Hex())
+	}
+
+	if inputToken != (common.Address{}) {
+		convert := &erc20types.MsgConvertCoin{
+			Coin:     msg.AmountIn,
+			Receiver: senderEVM.Hex(),
+			Sender:   msg.Sender,
+		}
+		if err := m.checkCircuits(ctx, convert, inputToken); err != nil {
+			return nil, err
+		}
+		if _, err := m.Erc20Keeper.ConvertCoin(c, convert); err != nil {
+			return nil, errorsmod.Wrap(err, "unable to convert swap input to ERC20")
+		}
+	}
+
+	amountPaid, amountOut, err := m.SwapExactIn(ctx, senderEVM, base, quote, msg.PoolIdx, sellBase, msg.AmountIn.Amount.BigInt(), msg.MinOut.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	out := sdk.NewIntFromBigInt(amountOut)
+	if out.LT(msg.MinOut) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientOut, "received %s, expected at least %s", out, msg.MinOut)
+	}
+
+	outputToken := quote
+	if !sellBase {
+		outputToken = base
+	}
+	coinOut, err := m.receiveOutput(ctx, sender, outputToken, out, msg.ReceiveErc20)
+	if err != nil {
+		return nil, err
+	}
+	refunded := sdk.NewCoins()
+	if unsold := msg.AmountIn.Amount.Sub(sdk.NewIntFromBigInt(amountPaid)); unsold.IsPositive() {
+		refunded, err = m.receiveOutput(ctx, sender, inputToken, unsold, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwap,
+		sdk.NewAttribute(types.SwapKeySender, msg.Sender),
+		sdk.NewAttribute(types.SwapKeyPoolIdx, strconv.FormatUint(msg.PoolIdx, 10)),
+		sdk.NewAttribute(types.SwapKeyAmountIn, msg.AmountIn.String()),
+		sdk.NewAttribute(types.SwapKeyTokenOut, outputToken.Hex()),
+		sdk.NewAttribute(types.SwapKeyAmountOut, out.String()),
+	))
+
+	return &types.MsgSwapResponse{
+		TokenOut:  outputToken.Hex(),
+		AmountOut: out,
+		CoinOut:   coinOut,
+		Refunded:  refunded,
+	}, nil
+}
+
+// MintAmbientLiquidity deposits the sender's Cosmos coins into a full range DEX position
+func (m msgServer) MintAmbientLiquidity(c context.Context, msg *types.MsgMintAmbientLiquidity) (*types.MsgMintLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+	position := types.NewAmbientPosition(
+		sdk.MustAccAddressFromBech32(msg.Sender), common.HexToAddress(msg.Base), common.HexToAddress(msg.Quote), msg.PoolIdx,
+	)
+
+	deposited, refunded, err := m.MintLiquidity(ctx, position, msg.Amount, msg.MaxOther)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMintLiquidityResponse{Deposited: deposited, Refunded: refunded}, nil
+}
+
+// MintRangeLiquidity deposits the sender's Cosmos coins into a concentrated DEX position
+func (m msgServer) MintRangeLiquidity(c context.Context, msg *types.MsgMintRangeLiquidity) (*types.MsgMintLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+	position := types.NewRangePosition(
+		sdk.MustAccAddressFromBech32(msg.Sender), common.HexToAddress(msg.Base), common.HexToAddress(msg.Quote), msg.PoolIdx,
+		msg.LowerTick, msg.UpperTick,
+	)
+
+	deposited, refunded, err := m.MintLiquidity(ctx, position, msg.Amount, msg.MaxOther)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMintLiquidityResponse{Deposited: deposited, Refunded: refunded}, nil
+}
+
+// BurnLiquidity withdraws liquidity from one of the sender's DEX positions
+func (m msgServer) BurnLiquidity(c context.Context, msg *types.MsgBurnLiquidity) (*types.MsgBurnLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+
+	baseOut, quoteOut, coinsOut, err := m.Keeper.BurnLiquidity(ctx, msg.Position(), msg.Liquidity, msg.MinBaseOut, msg.MinQuoteOut, msg.ReceiveErc20)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgBurnLiquidityResponse{BaseOut: baseOut, QuoteOut: quoteOut, CoinsOut: coinsOut}, nil
+}
+
+// Harvest collects the rewards of one of the sender's concentrated DEX positions
+func (m msgServer) Harvest(c context.Context, msg *types.MsgHarvest) (*types.MsgHarvestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+
+	baseOut, quoteOut, coinsOut, err := m.Keeper.Harvest(ctx, msg.Position(), msg.ReceiveErc20)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgHarvestResponse{BaseOut: baseOut, QuoteOut: quoteOut, CoinsOut: coinsOut}, nil
+}
+
+// CancelTimelockedProposal removes a queued proposal from the timelock on behalf of the CrocPolicy emergency role
+func (m msgServer) CancelTimelockedProposal(c context.Context, msg *types.MsgCancelTimelockedProposal) (*types.MsgCancelTimelockedProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := m.RequireEmergencyAuthority(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	if err := m.CancelQueuedProposal(ctx, msg.QueuedId, msg.Sender); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelTimelockedProposalResponse{}, nil
+}
+
+// ClaimIncentives sends the sender their unclaimed liquidity incentive rewards
+func (m msgServer) ClaimIncentives(c context.Context, msg *types.MsgClaimIncentives) (*types.MsgClaimIncentivesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+
+	rewards, err := m.Keeper.ClaimIncentives(ctx, sdk.MustAccAddressFromBech32(msg.Sender))
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimIncentivesResponse{Rewards: rewards}, nil
+}
+
+// RegisterIncentivePosition records one of the sender's DEX positions opened through the EVM for the incentive programs
+func (m msgServer) RegisterIncentivePosition(c context.Context, msg *types.MsgRegisterIncentivePosition) (*types.MsgRegisterIncentivePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid msg")
+	}
+
+	if err := m.Keeper.RegisterIncentivePosition(ctx, msg.Position()); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterIncentivePositionResponse{}, nil
+}
+
+// receiveOutput converts the amount of token received by sender's EVM address into Cosmos coins, returning the
+// coins received. Native token proceeds are already Cosmos coins, and ERC20 proceeds are left alone if keepErc20 is set
+func (k Keeper) receiveOutput(ctx sdk.Context, sender sdk.AccAddress, token common.Address, amount sdk.Int, keepErc20 bool) (sdk.Coins, error) {
+	if token == (common.Address{}) {
+		return sdk.NewCoins(sdk.NewCoin(altheacfg.BaseDenom, amount)), nil
+	}
+	if keepErc20 || !amount.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	pair, err := k.getTokenPair(ctx, token.Hex())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unable to convert output, set receive_erc20 to keep the ERC20 tokens")
+	}
+	convert := &erc20types.MsgConvertERC20{
+		ContractAddress: token.Hex(),
+		Amount:          amount,
+		Receiver:        sender.String(),
+		Sender:          common.BytesToAddress(sender.Bytes()).Hex(),
+	}
+	if err := k.checkCircuits(ctx, convert, token); err != nil {
+		return nil, err
+	}
+	if _, err := k.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), convert); err != nil {
+		return nil, errorsmod.Wrap(err, "unable to convert output to Cosmos coins")
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(pair.Denom, amount)), nil
+}
+
+// getTokenPair returns the erc20 module token pair registered for token, which may be a denom or an ERC20 address
+// nolint: exhaustruct
+func (k Keeper) getTokenPair(ctx sdk.Context, token string) (erc20types.TokenPair, error) {
+	pair, found := k.Erc20Keeper.GetTokenPair(ctx, k.Erc20Keeper.GetTokenPairID(ctx, token))
+	if !found {
+		return erc20types.TokenPair{}, errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "%s has no registered token pair", token)
+	}
+	if !pair.Enabled {
+		return erc20types.TokenPair{}, errorsmod.Wrapf(erc20types.ErrERC20TokenPairDisabled, "%s token pair is disabled", token)
+	}
+	return pair, nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+var _ types.PriceOracle = Keeper{}
+
+// RecordOraclePrices records the spot price of each OraclePools pool every OracleInterval blocks, dropping the oldest
+// observations of a pool beyond OracleMaxObservations. A pool whose price cannot be read is skipped
+func (k Keeper) RecordOraclePrices(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	interval := k.GetOracleInterval(ctx)
+	if interval == 0 || height%interval != 0 {
+		return
+	}
+
+	maxObservations := k.GetOracleMaxObservations(ctx)
+	for _, pool := range k.GetOraclePools(ctx) {
+		base, quote := pool.Tokens()
+		price, err := k.GetPoolPrice(ctx, base, quote, pool.PoolIdx)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to record oracle price", "base", pool.Base, "quote", pool.Quote, "pool_idx", pool.PoolIdx, "err", err)
+			continue
+		}
+		k.SetPriceObservation(ctx, base, quote, pool.PoolIdx, types.PriceObservation{Height: height, Time: ctx.BlockTime(), Price: price})
+		k.prunePriceObservations(ctx, base, quote, pool.PoolIdx, maxObservations)
+	}
+}
+
+// SetPriceObservation stores a pool's price observation under its height
+func (k Keeper) SetPriceObservation(ctx sdk.Context, base, quote common.Address, poolIdx uint64, observation types.PriceObservation) {
+	key := types.GetPriceObservationKey(base, quote, poolIdx, observation.Height)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&observation))
+}
+
+// GetPriceObservations returns the recorded observations of a pool, oldest first
+func (k Keeper) GetPriceObservations(ctx sdk.Context, base, quote common.Address, poolIdx uint64) []types.PriceObservation {
+	observations := []types.PriceObservation{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPriceObservationsKey(base, quote, poolIdx))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iter.Value(), &observation)
+		observations = append(observations, observation)
+	}
+	return observations
+}
+
+// GetAllPriceObservations returns the observations of every pool with recorded prices, including pools which have
+// since been removed from OraclePools
+func (k Keeper) GetAllPriceObservations(ctx sdk.Context) []types.OracleObservations {
+	all := []types.OracleObservations{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceObservationKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	// Keys are [base][quote][poolIdx][height], so the observations of a pool are adjacent
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		pool := types.NewOraclePool(common.BytesToAddress(key[:20]), common.BytesToAddress(key[20:40]), sdk.BigEndianToUint64(key[40:48]))
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iter.Value(), &observation)
+		if len(all) == 0 || all[len(all)-1].Pool != pool {
+			all = append(all, types.OracleObservations{Pool: pool, Observations: []types.PriceObservation{}})
+		}
+		all[len(all)-1].Observations = append(all[len(all)-1].Observations, observation)
+	}
+	return all
+}
+
+// InitPriceObservations stores the genesis price oracle observations
+func (k Keeper) InitPriceObservations(ctx sdk.Context, all []types.OracleObservations) {
+	for _, o := range all {
+		base, quote := o.Pool.Tokens()
+		for _, observation := range o.Observations {
+			k.SetPriceObservation(ctx, base, quote, o.Pool.PoolIdx, observation)
+		}
+	}
+}
+
+// GetTwap implements types.PriceOracle
+func (k Keeper) GetTwap(ctx sdk.Context, base, quote common.Address, poolIdx uint64, window time.Duration) (sdk.Dec, error) {
+	twap, _, err := k.computeTwap(ctx, base, quote, poolIdx, window)
+	return twap, err
+}
+
+// GetTokenPrice implements types.PriceOracle
+func (k Keeper) GetTokenPrice(ctx sdk.Context, token, denom common.Address, window time.Duration) (sdk.Dec, error) {
+	for _, pool := range k.GetOraclePools(ctx) {
+		base, quote := pool.Tokens()
+		switch {
+		case base == denom && quote == token:
+			return k.GetTwap(ctx, base, quote, pool.PoolIdx, window)
+		case base == token && quote == denom:
+			twap, err := k.GetTwap(ctx, base, quote, pool.PoolIdx, window)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			if !twap.IsPositive() {
+				return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidOracle, "%s has no value in %s", denom.Hex(), token.Hex())
+			}
+			return sdk.OneDec().Quo(twap), nil
+		}
+	}
+	return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidOracle, "no oracle pool trades %s for %s", token.Hex(), denom.Hex())
+}
+
+// GetNativePrice values one base unit of denom in native token base units for the gasfree module, using the
+// NativePriceTwapWindow TWAP of the oracle pool trading the denom's ERC20 against the native token. Returns false if
+// the denom has no ERC20 or no oracle pool, or the pool has no recent observations
+func (k Keeper) GetNativePrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	pair, found := k.Erc20Keeper.GetTokenPair(ctx, k.Erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found {
+		return sdk.Dec{}, false
+	}
+	// The DEX represents the native token with the zero address
+	price, err := k.GetTokenPrice(ctx, common.HexToAddress(pair.Erc20Address), common.Address{}, types.NativePriceTwapWindow)
+	if err != nil || !price.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return price, true
+}
+
+// computeTwap returns the TWAP of a pool over the window ending at the current block, and the time it starts from
+func (k Keeper) computeTwap(ctx sdk.Context, base, quote common.Address, poolIdx uint64, window time.Duration) (sdk.Dec, time.Time, error) {
+	if window <= 0 {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrap(types.ErrInvalidOracle, "the TWAP window must be positive")
+	}
+	end := ctx.BlockTime()
+	twap, start, err := types.ComputeTwap(k.GetPriceObservations(ctx, base, quote, poolIdx), end.Add(-window), end)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(err, "pool %s/%s/%d", base.Hex(), quote.Hex(), poolIdx)
+	}
+	return twap, start, nil
+}
+
+// prunePriceObservations deletes the oldest observations of a pool until at most maxObservations remain
+func (k Keeper) prunePriceObservations(ctx sdk.Context, base, quote common.Address, poolIdx uint64, maxObservations uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPriceObservationsKey(base, quote, poolIdx))
+	iter := store.ReverseIterator(nil, nil)
+	var stale [][]byte
+	for count := uint64(0); iter.Valid(); iter.Next() {
+		count++
+		if count > maxObservations {
+			stale = append(stale, append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// q128 is 2^128, used to square the Q64.64 fixed point square root prices stored by the DEX
+var q128 = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// GetPoolPrice reads the current price of the (base, quote, poolIdx) pool from the DEX via the CrocQuery contract.
+// The DEX measures price as base tokens per quote token, so the result is the value of one quote token base unit in
+// base token base units. The native token is represented by the zero address, and is always the base side of a pool.
+func (k Keeper) GetPoolPrice(ctx sdk.Context, base, quote common.Address, poolIdx uint64) (sdk.Dec, error) {
+	priceRoot, err := k.GetPoolPriceRoot(ctx, base, quote, poolIdx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return SqrtPriceToPrice(priceRoot), nil
+}
+
+// GetPoolPriceRoot reads the current Q64.64 fixed point square root price of the (base, quote, poolIdx) pool from the
+// DEX via the CrocQuery contract, returning an error if the pool has not been initialized
+func (k Keeper) GetPoolPriceRoot(ctx sdk.Context, base, quote common.Address, poolIdx uint64) (*big.Int, error) {
+	// CrocQuery ABI: queryPrice (address base, address quote, uint256 poolIdx) returns (uint128)
+	out, err := k.callCrocQuery(ctx, "queryPrice", base, quote, new(big.Int).SetUint64(poolIdx))
+	if err != nil {
+		return nil, err
+	}
+	priceRoot, ok := out[0].(*big.Int)
+	if !ok || priceRoot.Sign() == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool (%s, %s, %d) is not initialized", base.Hex(), quote.Hex(), poolIdx)
+	}
+
+	return priceRoot, nil
+}
+
+// GetPoolLiquidity reads the total active liquidity of the (base, quote, poolIdx) pool at its current price
+func (k Keeper) GetPoolLiquidity(ctx sdk.Context, base, quote common.Address, poolIdx uint64) (sdk.Int, error) {
+	// CrocQuery ABI: queryLiquidity (address base, address quote, uint256 poolIdx) returns (uint128)
+	out, err := k.callCrocQuery(ctx, "queryLiquidity", base, quote, new(big.Int).SetUint64(poolIdx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	liquidity, ok := out[0].(*big.Int)
+	if !ok {
+		return sdk.Int{}, errorsmod.Wrap(types.ErrInvalidPool, "unexpected CrocQuery.queryLiquidity() result type")
+	}
+
+	return sdk.NewIntFromBigInt(liquidity), nil
+}
+
+// GetPoolTick reads the tick of the (base, quote, poolIdx) pool's current price, range positions are in range when
+// their lower tick is at or below it and their upper tick is above it
+func (k Keeper) GetPoolTick(ctx sdk.Context, base, quote common.Address, poolIdx uint64) (int32, error) {
+	// CrocQuery ABI: queryCurveTick (address base, address quote, uint256 poolIdx) returns (int24)
+	out, err := k.callCrocQuery(ctx, "queryCurveTick", base, quote, new(big.Int).SetUint64(poolIdx))
+	if err != nil {
+		return 0, err
+	}
+	tick, ok := out[0].(*big.Int)
+	if !ok || !tick.IsInt64() || tick.Int64() < types.MinTick || tick.Int64() > types.MaxTick {
+		return 0, errorsmod.Wrap(types.ErrInvalidPool, "unexpected CrocQuery.queryCurveTick() result")
+	}
+
+	return int32(tick.Int64()), nil
+}
+
+// GetPoolParams reads the parameters of the (base, quote, poolIdx) pool, including its protocol take rate
+func (k Keeper) GetPoolParams(ctx sdk.Context, base, quote common.Address, poolIdx uint64) (types.PoolSpec, error) {
+	// CrocQuery ABI: queryPoolParams (address base, address quote, uint256 poolIdx) returns (PoolSpecs.Pool)
+	out, err := k.callCrocQuery(ctx, "queryPoolParams", base, quote, new(big.Int).SetUint64(poolIdx))
+	if err != nil {
+		return types.PoolSpec{}, err
+	}
+
+	return poolSpecFromABI(out)
+}
+
+// GetPoolTemplate reads the parameters new pools with the given poolIdx will be initialized with
+func (k Keeper) GetPoolTemplate(ctx sdk.Context, poolIdx uint64) (types.PoolSpec, error) {
+	// CrocQuery ABI: queryPoolTemplate (uint256 poolIdx) returns (PoolSpecs.Pool)
+	out, err := k.callCrocQuery(ctx, "queryPoolTemplate", new(big.Int).SetUint64(poolIdx))
+	if err != nil {
+		return types.PoolSpec{}, err
+	}
+
+	return poolSpecFromABI(out)
+}
+
+// callCrocQuery simulates a call to a CrocQuery view function and returns its decoded outputs
+func (k Keeper) callCrocQuery(ctx sdk.Context, method string, args ...interface{}) ([]interface{}, error) {
+	crocQuery := k.GetVerifiedCrocQueryAddress(ctx)
+	if crocQuery == (common.Address{}) {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvmAddress, "VerifiedCrocQueryAddress has not been set")
+	}
+
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocQueryABI, types.ModuleEVMAddress, crocQuery, false, method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to call CrocQuery.%s()", method)
+	}
+	out, err := contracts.CrocQueryABI.Unpack(method, res.Ret)
+	if err != nil || len(out) != len(contracts.CrocQueryABI.Methods[method].Outputs) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPool, "unable to decode CrocQuery.%s() result: %v", method, err)
+	}
+
+	return out, nil
+}
+
+// poolSpecFromABI converts the decoded fields of a PoolSpecs.Pool struct into a PoolSpec
+func poolSpecFromABI(out []interface{}) (types.PoolSpec, error) {
+	if len(out) != 7 {
+		return types.PoolSpec{}, errorsmod.Wrapf(types.ErrInvalidPool, "expected 7 pool fields, got %d", len(out))
+	}
+	schema, ok1 := out[0].(uint8)
+	feeRate, ok2 := out[1].(uint16)
+	protocolTake, ok3 := out[2].(uint8)
+	tickSize, ok4 := out[3].(uint16)
+	jitThresh, ok5 := out[4].(uint8)
+	knockoutBits, ok6 := out[5].(uint8)
+	oracleFlags, ok7 := out[6].(uint8)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 || !ok6 || !ok7 {
+		return types.PoolSpec{}, errorsmod.Wrap(types.ErrInvalidPool, "unexpected pool field types")
+	}
+
+	return types.PoolSpec{
+		Schema:       uint32(schema),
+		FeeRate:      uint32(feeRate),
+		ProtocolTake: uint32(protocolTake),
+		TickSize:     uint32(tickSize),
+		JitThresh:    uint32(jitThresh),
+		KnockoutBits: uint32(knockoutBits),
+		OracleFlags:  uint32(oracleFlags),
+	}, nil
+}
+
+// SqrtPriceToPrice converts a Q64.64 fixed point square root price, as stored by the DEX, into a decimal price
+func SqrtPriceToPrice(priceRoot *big.Int) sdk.Dec {
+	price := new(big.Int).Mul(priceRoot, priceRoot)
+	price.Mul(price, sdk.NewIntWithDecimal(1, sdk.Precision).BigInt())
+	price.Quo(price, q128)
+	return sdk.NewDecFromBigIntWithPrec(price, sdk.Precision)
+}
+
+// SwapExactIn sells exactly amountIn of one side of the (base, quote, poolIdx) pool from the from address, returning
+// the amount of the input actually paid to the pool and the amount of the other side received. If sellBase is true
+// base tokens are sold for quote tokens, otherwise quote tokens are sold for base tokens. The swap reverts if less
+// than minOut would be received. The swap stops at the pool's price limit, so less than amountIn may be paid, and the
+// unpaid input is left with the from address.
+//
+// ERC20 input is approved for the DEX before swapping and the from address's previous DEX allowance is restored
+// afterwards. Native token input, which is always the base side, is sent as the value of the swap call.
+func (k Keeper) SwapExactIn(
+	ctx sdk.Context, from common.Address, base, quote common.Address, poolIdx uint64, sellBase bool, amountIn, minOut *big.Int,
+) (amountPaid, amountOut *big.Int, err error) {
+	if amountIn == nil || amountIn.Sign() <= 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSwap, "swap amount must be positive")
+	}
+	if minOut == nil || minOut.Sign() < 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSwap, "minimum output must not be negative")
+	}
+	dex := k.GetNativeDexAddress(ctx)
+	if dex == (common.Address{}) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidEvmAddress, "VerifiedNativeDexAddress has not been set")
+	}
+
+	inputToken := quote
+	limitPrice := contracts.CrocMinSqrtPrice
+	if sellBase {
+		inputToken = base
+		limitPrice = contracts.CrocMaxSqrtPrice
+	}
+	if err := k.checkCircuits(ctx, nil, dex, base, quote); err != nil {
+		return nil, nil, err
+	}
+	value := big.NewInt(0)
+	if inputToken == (common.Address{}) {
+		value = amountIn
+	} else {
+		prevAllowance, err := k.dexAllowance(ctx, from, inputToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := k.approveDex(ctx, from, inputToken, sdk.NewIntFromBigInt(amountIn)); err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			if err == nil {
+				err = k.approveDex(ctx, from, inputToken, prevAllowance)
+			}
+		}()
+	}
+
+	// CrocSwapDex ABI: swap (address base, address quote, uint256 poolIdx, bool isBuy, bool inBaseQty, uint128 qty,
+	// uint16 tip, uint128 limitPrice, uint128 minOut, uint8 reserveFlags) returns (int128 baseFlow, int128 quoteFlow)
+	data, err := contracts.CrocSwapDexABI.Pack(
+		"swap", base, quote, new(big.Int).SetUint64(poolIdx), sellBase, sellBase, amountIn, uint16(0), limitPrice, minOut, uint8(0),
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSwap, err.Error())
+	}
+	res, err := k.EVMKeeper.CallEVMWithValue(ctx, from, &dex, data, value, true)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unable to call CrocSwapDex.swap()")
+	}
+	out, err := contracts.CrocSwapDexABI.Unpack("swap", res.Ret)
+	if err != nil || len(out) != 2 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidSwap, "unable to decode CrocSwapDex.swap() result: %v", err)
+	}
+	baseFlow, baseOk := out[0].(*big.Int)
+	quoteFlow, quoteOk := out[1].(*big.Int)
+	if !baseOk || !quoteOk {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSwap, "unexpected CrocSwapDex.swap() result types")
+	}
+
+	// Flows are from the swapper's perspective: positive values are paid to the pool, negative values are received
+	inputFlow, outputFlow := quoteFlow, baseFlow
+	if sellBase {
+		inputFlow, outputFlow = baseFlow, quoteFlow
+	}
+	return inputFlow, new(big.Int).Neg(outputFlow), nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
+)
+
+func TestSqrtPriceToPrice(t *testing.T) {
+	one := new(big.Int).Lsh(big.NewInt(1), 64)
+	require.Equal(t, sdk.OneDec(), keeper.SqrtPriceToPrice(one))
+
+	// sqrt(4) = 2 in Q64.64
+	two := new(big.Int).Lsh(big.NewInt(2), 64)
+	require.Equal(t, sdk.NewDec(4), keeper.SqrtPriceToPrice(two))
+
+	// sqrt(0.25) = 0.5 in Q64.64
+	half := new(big.Int).Lsh(big.NewInt(1), 63)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), keeper.SqrtPriceToPrice(half))
+}
+
+func TestCrocSlotDecoding(t *testing.T) {
+	// treasuryStartTime_ is packed above treasury_ in its slot
+	treasury := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	slot := new(big.Int).Lsh(big.NewInt(12345), 160)
+	slot.Or(slot, new(big.Int).SetBytes(treasury.Bytes()))
+	require.Equal(t, treasury, contracts.CrocSlotAddress(slot))
+
+	// inSafeMode_ and relayerTakeRate_ are packed above lockHolder_ and the other flags
+	flags := new(big.Int).Lsh(big.NewInt(1), 8*contracts.CrocSafeModeOffset)
+	flags.Or(flags, new(big.Int).Lsh(big.NewInt(64), 8*contracts.CrocRelayerTakeRateOffset))
+	flags.Or(flags, new(big.Int).SetBytes(treasury.Bytes()))
+	require.Equal(t, uint8(1), contracts.CrocSlotByte(flags, contracts.CrocSafeModeOffset))
+	require.Equal(t, uint8(0), contracts.CrocSlotByte(flags, contracts.CrocHotPathOpenOffset))
+	require.Equal(t, uint8(64), contracts.CrocSlotByte(flags, contracts.CrocRelayerTakeRateOffset))
+
+	// newPoolLiq_ fills the low 16 bytes of its slot, below protocolTakeRate_
+	newPool := new(big.Int).Lsh(big.NewInt(32), 8*contracts.CrocProtocolTakeRateOffset)
+	newPool.Or(newPool, big.NewInt(10000))
+	require.Equal(t, big.NewInt(10000), contracts.CrocSlotUint128(newPool))
+	require.Equal(t, uint8(32), contracts.CrocSlotByte(newPool, contracts.CrocProtocolTakeRateOffset))
+
+	require.Equal(t, uint64(1), contracts.CrocProxyPathSlot(0))
+	require.Equal(t, uint64(10000), contracts.CrocProxyPathSlot(9999))
+}
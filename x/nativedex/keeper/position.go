@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// SetPosition records that the position's owner has liquidity in the DEX, so that the incentive programs sample it
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	ctx.KVStore(k.storeKey).Set(position.Key(), k.cdc.MustMarshal(&position))
+}
+
+// HasPosition returns true if the position has been recorded
+func (k Keeper) HasPosition(ctx sdk.Context, position types.Position) bool {
+	return ctx.KVStore(k.storeKey).Has(position.Key())
+}
+
+// DeletePosition removes the record of a position and its incentive sample, once its liquidity is fully burned or its
+// pool has no incentive program
+func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
+	ctx.KVStore(k.storeKey).Delete(position.Key())
+	ctx.KVStore(k.storeKey).Delete(types.GetIncentiveSampleKey(position))
+}
+
+// IteratePositions calls cb on every recorded position with the given key prefix, stopping if cb returns true
+func (k Keeper) IteratePositions(ctx sdk.Context, keyPrefix []byte, cb func(position types.Position) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var position types.Position
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// GetPositions returns every recorded position
+func (k Keeper) GetPositions(ctx sdk.Context) []types.Position {
+	positions := []types.Position{}
+	k.IteratePositions(ctx, types.PositionKeyPrefix, func(position types.Position) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return positions
+}
+
+// GetPositionsByOwner returns every recorded position held by owner
+func (k Keeper) GetPositionsByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.Position {
+	positions := []types.Position{}
+	k.IteratePositions(ctx, types.GetPositionsByOwnerKey(owner), func(position types.Position) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return positions
+}
+
+// GetPositionInfo reads the current liquidity of the position and the tokens it would pay out on burning from the DEX
+// via the CrocQuery contract
+// nolint: exhaustruct
+func (k Keeper) GetPositionInfo(ctx sdk.Context, position types.Position) (types.PositionInfo, error) {
+	owner := common.BytesToAddress(sdk.MustAccAddressFromBech32(position.Owner).Bytes())
+	base, quote := common.HexToAddress(position.Base), common.HexToAddress(position.Quote)
+	poolIdx := new(big.Int).SetUint64(position.PoolIdx)
+
+	var out []interface{}
+	var err error
+	if position.Ambient {
+		// CrocQuery ABI: queryAmbientTokens (address owner, address base, address quote, uint256 poolIdx)
+		// returns (uint128 liq, uint128 baseQty, uint128 quoteQty)
+		out, err = k.callCrocQuery(ctx, "queryAmbientTokens", owner, base, quote, poolIdx)
+	} else {
+		// CrocQuery ABI: queryRangeTokens (address owner, address base, address quote, uint256 poolIdx, int24 lowerTick,
+		// int24 upperTick) returns (uint128 liq, uint128 baseQty, uint128 quoteQty)
+		out, err = k.callCrocQuery(
+			ctx, "queryRangeTokens", owner, base, quote, poolIdx,
+			big.NewInt(int64(position.LowerTick)), big.NewInt(int64(position.UpperTick)),
+		)
+	}
+	if err != nil {
+		return types.PositionInfo{}, err
+	}
+	liq, liqOk := out[0].(*big.Int)
+	baseQty, baseOk := out[1].(*big.Int)
+	quoteQty, quoteOk := out[2].(*big.Int)
+	if !liqOk || !baseOk || !quoteOk {
+		return types.PositionInfo{}, errorsmod.Wrap(types.ErrInvalidPosition, "unexpected CrocQuery position result types")
+	}
+
+	return types.PositionInfo{
+		Position:  position,
+		Liquidity: sdk.NewIntFromBigInt(liq),
+		BaseQty:   sdk.NewIntFromBigInt(baseQty),
+		QuoteQty:  sdk.NewIntFromBigInt(quoteQty),
+	}, nil
+}
@@ -2,14 +2,17 @@ package keeper_test
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -22,7 +25,9 @@ import (
 
 	althea "github.com/AltheaFoundation/althea-L1/app"
 	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/testutil"
 	"github.com/AltheaFoundation/althea-L1/x/nativedex"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/keeper"
 	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
 )
 
@@ -201,6 +206,378 @@ func (suite *ProposalHandlerTestSuite) TestExecuteContractProposal_NotWhiteliste
 	suite.Require().Equal(transferAmount.String(), moduleBalance.String(), "Module should still have the tokens")
 }
 
+// TestSimulateProposal checks that a simulated proposal reports its outcome without changing any state
+func (suite *ProposalHandlerTestSuite) TestSimulateProposal() {
+	contractAddr := suite.DeployERC20("TestToken", "TEST", 18)
+	transferAmount := big.NewInt(100)
+	suite.MintERC20Tokens(contractAddr, types.ModuleEVMAddress, transferAmount)
+	recipientAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", recipientAddr, transferAmount)
+	suite.Require().NoError(err)
+	proposal := types.NewExecuteContractProposal("Transfer ERC20 Tokens", "Transfer tokens to recipient", types.ExecuteContractMetadata{
+		ContractAddress: contractAddr.Hex(),
+		Data:            "0x" + common.Bytes2Hex(transferData),
+	})
+	content, err := codectypes.NewAnyWithValue(proposal)
+	suite.Require().NoError(err)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// The contract is not whitelisted yet, so the simulation should report the handler's error
+	res, err := suite.app.NativedexKeeper.SimulateProposal(ctx, &types.QuerySimulateProposalRequest{Content: content})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Success)
+	suite.Require().Contains(res.Error, "not whitelisted")
+
+	params := suite.app.NativedexKeeper.GetParams(suite.ctx)
+	params.WhitelistedContractAddresses = []string{contractAddr.Hex()}
+	suite.app.NativedexKeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.app.NativedexKeeper.SimulateProposal(ctx, &types.QuerySimulateProposalRequest{Content: content})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Success, res.Error)
+	suite.Require().Positive(res.GasUsed)
+	suite.Require().Len(res.Calls, 1)
+	suite.Require().Equal(contractAddr.Hex(), res.Calls[0].Contract)
+	suite.Require().Len(res.Logs, 1, "expected the ERC20 Transfer event")
+	suite.Require().Equal(contractAddr.Hex(), res.Logs[0].Address)
+
+	// Nothing may be committed by the simulation
+	suite.Require().Equal(transferAmount.String(), suite.GetERC20Balance(contractAddr, types.ModuleEVMAddress).String())
+	suite.Require().Equal("0", suite.GetERC20Balance(contractAddr, recipientAddr).String())
+
+	// Only nativedex proposals may be simulated
+	// nolint: exhaustruct
+	textContent, err := codectypes.NewAnyWithValue(&govv1beta1.TextProposal{Title: "title", Description: "description"})
+	suite.Require().NoError(err)
+	_, err = suite.app.NativedexKeeper.SimulateProposal(ctx, &types.QuerySimulateProposalRequest{Content: textContent})
+	suite.Require().Error(err)
+}
+
+// TestBatchDexProposal checks that batch actions are applied together, or not at all
+func (suite *ProposalHandlerTestSuite) TestBatchDexProposal() {
+	whitelisted := suite.DeployERC20("TestToken", "TEST", 18)
+	notWhitelisted := suite.DeployERC20("OtherToken", "OTHER", 18)
+	transferAmount := big.NewInt(100)
+	suite.MintERC20Tokens(whitelisted, types.ModuleEVMAddress, transferAmount)
+	suite.MintERC20Tokens(notWhitelisted, types.ModuleEVMAddress, transferAmount)
+	recipientAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	params := suite.app.NativedexKeeper.GetParams(suite.ctx)
+	params.WhitelistedContractAddresses = []string{whitelisted.Hex()}
+	suite.app.NativedexKeeper.SetParams(suite.ctx, params)
+
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", recipientAddr, big.NewInt(40))
+	suite.Require().NoError(err)
+	transfer := func(contract common.Address) types.DexAction {
+		// nolint: exhaustruct
+		return types.DexAction{ExecuteContract: &types.ExecuteContractMetadata{
+			ContractAddress: contract.Hex(),
+			Data:            "0x" + common.Bytes2Hex(transferData),
+		}}
+	}
+	handler := nativedex.NewNativeDexProposalHandler(suite.app.NativedexKeeper)
+
+	// The second action fails, so the first must not be applied either
+	proposal := types.NewBatchDexProposal("Batch", "Two transfers", []types.DexAction{transfer(whitelisted), transfer(notWhitelisted)})
+	err = handler(suite.ctx, proposal)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "batch action 1")
+	suite.Require().Equal("0", suite.GetERC20Balance(whitelisted, recipientAddr).String())
+
+	// Every action succeeds, so all are applied
+	proposal = types.NewBatchDexProposal("Batch", "Two transfers", []types.DexAction{transfer(whitelisted), transfer(whitelisted)})
+	err = handler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().Equal("80", suite.GetERC20Balance(whitelisted, recipientAddr).String())
+	suite.Require().Equal("20", suite.GetERC20Balance(whitelisted, types.ModuleEVMAddress).String())
+}
+
+// TestTimelockedProposal checks that timelocked proposals only execute once their delay passes, and may be cancelled
+func (suite *ProposalHandlerTestSuite) TestTimelockedProposal() {
+	contractAddr := suite.DeployERC20("TestToken", "TEST", 18)
+	transferAmount := big.NewInt(100)
+	suite.MintERC20Tokens(contractAddr, types.ModuleEVMAddress, transferAmount)
+	recipientAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	params := suite.app.NativedexKeeper.GetParams(suite.ctx)
+	params.WhitelistedContractAddresses = []string{contractAddr.Hex()}
+	params.TimelockedProposalTypes = []string{types.ProposalTypeExecuteContract}
+	params.TimelockBlocks = 10
+	suite.app.NativedexKeeper.SetParams(suite.ctx, params)
+
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", recipientAddr, big.NewInt(40))
+	suite.Require().NoError(err)
+	proposal := types.NewExecuteContractProposal("Transfer ERC20 Tokens", "Transfer tokens to recipient", types.ExecuteContractMetadata{
+		ContractAddress: contractAddr.Hex(),
+		Data:            "0x" + common.Bytes2Hex(transferData),
+	})
+	handler := nativedex.NewNativeDexProposalHandler(suite.app.NativedexKeeper)
+
+	// Both proposals are queued rather than executed
+	suite.Require().NoError(handler(suite.ctx, proposal))
+	suite.Require().NoError(handler(suite.ctx, proposal))
+	queue := suite.app.NativedexKeeper.GetQueuedProposals(suite.ctx)
+	suite.Require().Len(queue, 2)
+	suite.Require().Equal(uint64(suite.ctx.BlockHeight())+params.TimelockBlocks, queue[0].ExecuteHeight)
+	suite.Require().Equal("0", suite.GetERC20Balance(contractAddr, recipientAddr).String())
+
+	// Cancelling is not timelocked, and only the emergency authority may cancel with a msg
+	cancel := types.NewCancelTimelockedProposal("Cancel", "Cancel the second transfer", queue[1].Id)
+	suite.Require().NoError(handler(suite.ctx, cancel))
+	suite.Require().Error(handler(suite.ctx, types.NewCancelTimelockedProposal("Cancel", "Cancel it again", queue[1].Id)))
+	msgServer := keeper.NewMsgServerImpl(*suite.app.NativedexKeeper)
+	sender := sdk.AccAddress(recipientAddr.Bytes()).String()
+	_, err = msgServer.CancelTimelockedProposal(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelTimelockedProposal(sender, queue[0].Id))
+	suite.Require().Error(err)
+	suite.Require().Len(suite.app.NativedexKeeper.GetQueuedProposals(suite.ctx), 1)
+
+	// Nothing executes before the delay passes
+	nativedex.EndBlocker(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight()+9), *suite.app.NativedexKeeper)
+	suite.Require().Len(suite.app.NativedexKeeper.GetQueuedProposals(suite.ctx), 1)
+	suite.Require().Equal("0", suite.GetERC20Balance(contractAddr, recipientAddr).String())
+
+	nativedex.EndBlocker(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight()+10), *suite.app.NativedexKeeper)
+	suite.Require().Empty(suite.app.NativedexKeeper.GetQueuedProposals(suite.ctx))
+	suite.Require().Equal("40", suite.GetERC20Balance(contractAddr, recipientAddr).String())
+}
+
+// TestWhitelistedContractProposals checks that governance can manage the ExecuteContract whitelist, its selector rules,
+// and send native value with a call
+func (suite *ProposalHandlerTestSuite) TestWhitelistedContractProposals() {
+	contractAddr := suite.DeployERC20("TestToken", "TEST", 18)
+	suite.MintERC20Tokens(contractAddr, types.ModuleEVMAddress, big.NewInt(100))
+	recipientAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	handler := nativedex.NewNativeDexProposalHandler(suite.app.NativedexKeeper)
+
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", recipientAddr, big.NewInt(40))
+	suite.Require().NoError(err)
+	// nolint: exhaustruct
+	transfer := types.NewExecuteContractProposal("Transfer", "Transfer tokens to recipient", types.ExecuteContractMetadata{
+		ContractAddress: contractAddr.Hex(),
+		Data:            "0x" + common.Bytes2Hex(transferData),
+	})
+
+	// Whitelisting with a lowercase address still matches the checksummed address, but only approve may be called
+	lowercase := strings.ToLower(contractAddr.Hex())
+	suite.Require().NoError(handler(suite.ctx, types.NewAddWhitelistedContractProposal("Add", "Allow approvals", lowercase, []string{"0x095ea7b3"})))
+	err = handler(suite.ctx, transfer)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "selector is not allowed")
+
+	// Adding the contract again replaces its selectors without duplicating the whitelist entry
+	suite.Require().NoError(handler(suite.ctx, types.NewAddWhitelistedContractProposal("Add", "Allow transfers", contractAddr.Hex(), []string{"0xA9059CBB"})))
+	suite.Require().Len(suite.app.NativedexKeeper.GetWhitelistedContractAddresses(suite.ctx), 1)
+	suite.Require().NoError(handler(suite.ctx, transfer))
+	suite.Require().Equal("40", suite.GetERC20Balance(contractAddr, recipientAddr).String())
+
+	// Native value is sent from the module account
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	value := sdk.NewInt(1000)
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, value))))
+	suite.Require().NoError(handler(suite.ctx, types.NewAddWhitelistedContractProposal("Add", "Allow payments", recipientAddr.Hex(), nil)))
+	payment := types.NewExecuteContractProposal("Pay", "Pay the recipient", types.ExecuteContractMetadata{
+		ContractAddress: recipientAddr.Hex(),
+		Data:            "0x",
+		Value:           value.String(),
+	})
+	suite.Require().NoError(handler(suite.ctx, payment))
+	suite.Require().Equal(value, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(recipientAddr.Bytes()), evmDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, evmDenom).IsZero())
+
+	// Removing the contract stops it being called
+	suite.Require().NoError(handler(suite.ctx, types.NewRemoveWhitelistedContractProposal("Remove", "Disallow calls", lowercase)))
+	suite.Require().Empty(suite.app.NativedexKeeper.GetParams(suite.ctx).ContractAllowedSelectors)
+	err = handler(suite.ctx, transfer)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not whitelisted")
+	suite.Require().Error(handler(suite.ctx, types.NewRemoveWhitelistedContractProposal("Remove", "Disallow calls", lowercase)))
+}
+
+// TestProposalEvents checks that executed proposals emit typed events describing their changes
+func (suite *ProposalHandlerTestSuite) TestProposalEvents() {
+	contractAddr := suite.DeployERC20("TestToken", "TEST", 18)
+	suite.MintERC20Tokens(contractAddr, types.ModuleEVMAddress, big.NewInt(100))
+	recipientAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	handler := nativedex.NewNativeDexProposalHandler(suite.app.NativedexKeeper)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(handler(ctx, types.NewAddWhitelistedContractProposal("Add", "Allow transfers", contractAddr.Hex(), []string{"0xa9059cbb"})))
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", recipientAddr, big.NewInt(40))
+	suite.Require().NoError(err)
+	// nolint: exhaustruct
+	suite.Require().NoError(handler(ctx, types.NewExecuteContractProposal("Transfer", "Transfer tokens to recipient", types.ExecuteContractMetadata{
+		ContractAddress: contractAddr.Hex(),
+		Data:            "0x" + common.Bytes2Hex(transferData),
+	})))
+
+	var whitelisted *types.EventContractWhitelisted
+	var executed *types.EventExecuteContract
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		switch e := typed.(type) {
+		case *types.EventContractWhitelisted:
+			whitelisted = e
+		case *types.EventExecuteContract:
+			executed = e
+		}
+	}
+	suite.Require().NotNil(whitelisted)
+	suite.Require().Equal(contractAddr.Hex(), whitelisted.ContractAddress)
+	suite.Require().Equal([]string{"0xa9059cbb"}, whitelisted.AllowedSelectors)
+	suite.Require().NotNil(executed)
+	suite.Require().Equal(contractAddr.Hex(), executed.ContractAddress)
+	suite.Require().Equal("0xa9059cbb", executed.Selector)
+	suite.Require().Equal("0", executed.Value)
+	suite.Require().Positive(executed.GasUsed)
+}
+
+// TestRouteTreasuryProceeds checks that collected treasury fees held by the module reach the configured destination
+func (suite *ProposalHandlerTestSuite) TestRouteTreasuryProceeds() {
+	k := suite.app.NativedexKeeper
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(1000)))))
+
+	native := common.Address{}
+	balance, err := k.GetModuleTokenBalance(suite.ctx, native)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), balance)
+
+	// Native fees can fund the community pool or be sent to an account
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(evmDenom)
+	proceeds, err := k.RouteTreasuryProceeds(suite.ctx, native, sdk.NewInt(400), types.AutoCollectDestinationCommunityPool)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(400))), proceeds)
+	suite.Require().Equal(poolBefore.Add(sdk.NewDec(400)), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(evmDenom))
+
+	receiver := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	_, err = k.RouteTreasuryProceeds(suite.ctx, native, sdk.NewInt(600), receiver.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(600), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, evmDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, evmDenom).IsZero())
+
+	// ERC20 fees are read from the token contract, and can only be routed once they have a token pair
+	contractAddr := suite.DeployERC20("TestToken", "TEST", 18)
+	suite.MintERC20Tokens(contractAddr, types.ModuleEVMAddress, big.NewInt(100))
+	balance, err = k.GetModuleTokenBalance(suite.ctx, contractAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), balance)
+	_, err = k.RouteTreasuryProceeds(suite.ctx, contractAddr, balance, types.AutoCollectDestinationCommunityPool)
+	suite.Require().Error(err)
+
+	proceeds, err = k.RouteTreasuryProceeds(suite.ctx, contractAddr, sdk.ZeroInt(), types.AutoCollectDestinationCommunityPool)
+	suite.Require().NoError(err)
+	suite.Require().True(proceeds.IsZero())
+}
+
+// TestIncentivePrograms checks that incentive programs are funded from the community pool into the incentives module
+// account, pay every epoch by earned liquidity-blocks, and refund the community pool with anything left when they end
+func (suite *ProposalHandlerTestSuite) TestIncentivePrograms() {
+	k := suite.app.NativedexKeeper
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	funder := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, funder, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(1000)))))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(1000))), funder))
+	communityPool := func() sdk.Dec {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(evmDenom)
+	}
+	escrow := func() sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, types.IncentivesModuleAddress, evmDenom).Amount
+	}
+	poolBefore := communityPool()
+	handler := nativedex.NewNativeDexProposalHandler(suite.app.NativedexKeeper)
+
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	create := types.NewCreateIncentiveProgramProposal("Incentives", "Reward liquidity", common.Address{}, quote, 36000, sdk.NewCoin(evmDenom, sdk.NewInt(100)), 3)
+	suite.Require().Error(handler(suite.ctx, create), "programs can not be funded while epochs are disabled")
+
+	params := k.GetParams(suite.ctx)
+	params.IncentiveEpochBlocks = 10
+	k.SetParams(suite.ctx, params)
+	suite.Require().NoError(handler(suite.ctx, create))
+	programs := k.GetIncentivePrograms(suite.ctx)
+	suite.Require().Len(programs, 1)
+	suite.Require().Equal(uint64(1), programs[0].Id)
+	suite.Require().Equal(sdk.NewCoin(evmDenom, sdk.NewInt(300)), programs[0].Remaining)
+	suite.Require().Equal(poolBefore.Sub(sdk.NewDec(300)), communityPool())
+	suite.Require().Equal(sdk.NewInt(300), escrow())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, evmDenom).IsZero())
+
+	// Epochs without liquidity pay nothing
+	k.DistributeIncentives(suite.ctx.WithBlockHeight(10))
+	k.DistributeIncentives(suite.ctx.WithBlockHeight(15))
+	program, found := k.GetIncentiveProgram(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), program.EpochsPaid)
+	suite.Require().Equal(sdk.NewCoin(evmDenom, sdk.NewInt(300)), program.Remaining)
+
+	// Rewards are split by the liquidity-blocks each account earned out of the pool's, the share of the pool's other
+	// liquidity is kept by the program
+	provider := sdk.AccAddress(quote.Bytes())
+	program.PoolLiquidityBlocks = sdk.NewInt(400)
+	k.InitIncentives(suite.ctx, []types.IncentiveProgram{program}, nil, nil, []types.IncentiveAccrual{
+		{ProgramId: 1, Owner: provider.String(), LiquidityBlocks: sdk.NewInt(100)},
+		{ProgramId: 1, Owner: funder.String(), LiquidityBlocks: sdk.NewInt(200)},
+	})
+	k.DistributeIncentives(suite.ctx.WithBlockHeight(20))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(25))), k.GetIncentiveRewards(suite.ctx, provider))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(50))), k.GetIncentiveRewards(suite.ctx, funder))
+	suite.Require().Empty(k.GetAllIncentiveAccruals(suite.ctx))
+	program, found = k.GetIncentiveProgram(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), program.EpochsPaid)
+	suite.Require().Equal(sdk.NewCoin(evmDenom, sdk.NewInt(225)), program.Remaining)
+	suite.Require().True(program.PoolLiquidityBlocks.IsZero())
+
+	// The unpaid rewards are refunded when the program ends, the paid rewards stay in escrow until they are claimed
+	k.DistributeIncentives(suite.ctx.WithBlockHeight(30))
+	suite.Require().Empty(k.GetIncentivePrograms(suite.ctx))
+	suite.Require().Equal(poolBefore.Sub(sdk.NewDec(75)), communityPool())
+	suite.Require().Equal(sdk.NewInt(75), escrow())
+
+	// Cancelled programs are refunded immediately
+	suite.Require().NoError(handler(suite.ctx, create))
+	suite.Require().Equal(poolBefore.Sub(sdk.NewDec(375)), communityPool())
+	suite.Require().NoError(handler(suite.ctx, types.NewCancelIncentiveProgramProposal("Cancel", "End incentives", 2)))
+	suite.Require().Empty(k.GetIncentivePrograms(suite.ctx))
+	suite.Require().Equal(poolBefore.Sub(sdk.NewDec(75)), communityPool())
+	suite.Require().Error(handler(suite.ctx, types.NewCancelIncentiveProgramProposal("Cancel", "End incentives", 2)))
+
+	// Paid rewards are claimed with MsgClaimIncentives
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.IncentivesModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(50)))))
+	k.AddIncentiveRewards(suite.ctx, provider, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(20))))
+	k.AddIncentiveRewards(suite.ctx, provider, sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(30))))
+	res, err := k.IncentiveRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryIncentiveRewardsRequest{Owner: provider.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(75))), res.Rewards)
+
+	msgServer := keeper.NewMsgServerImpl(*suite.app.NativedexKeeper)
+	claim, err := msgServer.ClaimIncentives(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimIncentives(provider.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewInt(75))), claim.Rewards)
+	suite.Require().Equal(sdk.NewInt(75), suite.app.BankKeeper.GetBalance(suite.ctx, provider, evmDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(50), escrow())
+	suite.Require().True(k.GetIncentiveRewards(suite.ctx, provider).IsZero())
+	_, err = msgServer.ClaimIncentives(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimIncentives(provider.String()))
+	suite.Require().Error(err)
+
+	// Positions opened through the EVM can only be registered in pools with an incentive program
+	_, err = msgServer.RegisterIncentivePosition(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterIncentivePosition(provider.String(), common.Address{}, quote, 36000, true, 0, 0),
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidIncentive)
+	suite.Require().False(k.HasPosition(suite.ctx, types.NewAmbientPosition(provider, common.Address{}, quote, 36000)))
+
+	// Recorded positions in pools without a program are forgotten as they are sampled
+	position := types.NewAmbientPosition(provider, common.Address{}, quote, 36000)
+	k.SetPosition(suite.ctx, position)
+	k.DistributeIncentives(suite.ctx.WithBlockHeight(31))
+	suite.Require().False(k.HasPosition(suite.ctx, position))
+}
+
 func (suite *ProposalHandlerTestSuite) DeployERC20(name, symbol string, decimals uint8) common.Address {
 	// Prepare constructor arguments
 	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("", name, symbol, decimals)
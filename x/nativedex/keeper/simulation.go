@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// ProposalHandlerFactory builds the handler executing nativedex governance proposals around a keeper. The handler lives
+// in the nativedex package, which imports this one, so it is provided by the app to allow proposals to be simulated.
+// Simulated proposals are executed immediately, as they would be once any timelock expires
+type ProposalHandlerFactory func(k *Keeper) govv1beta1.Handler
+
+// SetProposalHandlerFactory sets the factory used to build the proposal handler for SimulateProposalContent,
+// this must be called before the keeper is copied into the module
+func (k *Keeper) SetProposalHandlerFactory(factory ProposalHandlerFactory) {
+	k.proposalHandlerFactory = factory
+}
+
+// SimulateProposalContent runs the nativedex proposal handler against a cached copy of ctx which is never written,
+// recording the EVM calls and logs made by the handler and any change to the DEX governance state. The
+// governanceStateFields are always compared, along with the templates, pools, take rates and proxy paths named by
+// the proposal's protocol commands. Any other state the proposal changes is visible only through its calls and logs
+// nolint: exhaustruct
+func (k Keeper) SimulateProposalContent(ctx sdk.Context, content govv1beta1.Content) (types.QuerySimulateProposalResponse, error) {
+	if k.proposalHandlerFactory == nil {
+		return types.QuerySimulateProposalResponse{}, errorsmod.Wrap(types.ErrInvalidProposal, "proposal handler has not been set")
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+	touched := k.touchedStateFields(content)
+	before := k.governanceState(cacheCtx)
+	readTouchedState(cacheCtx, touched, before)
+
+	recorder := &recordingEVMKeeper{EVMKeeper: k.EVMKeeper}
+	simKeeper := k
+	simKeeper.EVMKeeper = recorder
+	handlerErr := k.proposalHandlerFactory(&simKeeper)(cacheCtx, content)
+
+	res := types.QuerySimulateProposalResponse{
+		Success:      handlerErr == nil,
+		GasUsed:      recorder.gasUsed,
+		Calls:        recorder.calls,
+		Logs:         recorder.logs,
+		StateChanges: []types.StateChange{},
+	}
+	if handlerErr != nil {
+		res.Error = handlerErr.Error()
+		return res, nil
+	}
+
+	after := k.governanceState(cacheCtx)
+	readTouchedState(cacheCtx, touched, after)
+	fields := append([]string{}, governanceStateFields...)
+	for _, field := range touched {
+		fields = append(fields, field.name)
+	}
+	for _, field := range fields {
+		if before[field] != after[field] {
+			res.StateChanges = append(res.StateChanges, types.StateChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	return res, nil
+}
+
+// governanceStateFields are the keys of governanceState, in the order changes are reported
+var governanceStateFields = []string{
+	"dex.authority", "dex.treasury", "dex.safe_mode",
+	"policy.ops_authority", "policy.treasury_authority", "policy.emergency_authority",
+}
+
+// governanceState reads the DEX and CrocPolicy values governance proposals may change, values which can not be read
+// (e.g. because the contracts are not configured) are left empty
+func (k Keeper) governanceState(ctx sdk.Context) map[string]string {
+	state := make(map[string]string, len(governanceStateFields))
+	if status, err := k.GetDexStatus(ctx); err == nil {
+		state["dex.authority"] = status.Authority
+		state["dex.treasury"] = status.Treasury
+		if status.SafeMode {
+			state["dex.safe_mode"] = "true"
+		} else {
+			state["dex.safe_mode"] = "false"
+		}
+	}
+	if roles, err := k.GetPolicyRoles(ctx); err == nil {
+		state["policy.ops_authority"] = roles.OpsAuthority
+		state["policy.treasury_authority"] = roles.TreasuryAuthority
+		state["policy.emergency_authority"] = roles.EmergencyAuthority
+	}
+	return state
+}
+
+// stateField is a value of the DEX state a proposal touches, read before and after it is simulated
+type stateField struct {
+	name string
+	read func(ctx sdk.Context) (string, error)
+}
+
+// touchedStateFields returns the DEX state the proposal's protocol commands may change beyond the
+// governanceStateFields, in the order changes are reported. Commands are found in ops, treasury command and upgrade
+// proxy proposals, including the actions of a batch proposal.
+func (k Keeper) touchedStateFields(content govv1beta1.Content) []stateField {
+	var cmds [][]byte
+	var proxyIdxs []uint64
+	addAction := func(a types.DexAction) {
+		switch {
+		case a.Ops != nil:
+			cmds = append(cmds, a.Ops.CmdArgs)
+		case a.TreasuryCmd != nil:
+			cmds = append(cmds, a.TreasuryCmd.CmdArgs)
+		case a.UpgradeProxy != nil:
+			proxyIdxs = append(proxyIdxs, a.UpgradeProxy.CallpathIndex)
+		}
+	}
+	switch p := content.(type) {
+	case *types.OpsProposal:
+		cmds = append(cmds, p.GetMetadata().CmdArgs)
+	case *types.UpgradeProxyProposal:
+		proxyIdxs = append(proxyIdxs, p.GetMetadata().CallpathIndex)
+	case *types.BatchDexProposal:
+		for _, action := range p.Actions {
+			addAction(action)
+		}
+	}
+
+	fields := []stateField{}
+	seen := map[string]bool{}
+	add := func(field stateField) {
+		if !seen[field.name] {
+			seen[field.name] = true
+			fields = append(fields, field)
+		}
+	}
+	for _, cmd := range cmds {
+		code, args, err := types.DecodeProtocolCmd(cmd)
+		if err != nil {
+			continue
+		}
+		switch types.ProtocolCmdName(code) {
+		case "SetTemplate", "DisableTemplate":
+			add(k.templateStateField(protocolCmdArg(args, "poolIdx")))
+		case "RevisePool", "ResyncTakeRate":
+			add(k.poolStateField(protocolCmdArg(args, "base"), protocolCmdArg(args, "quote"), protocolCmdArg(args, "poolIdx")))
+		case "SetTakeRate":
+			add(k.slotStateField("dex.protocol_take_rate", contracts.CrocNewPoolSlot, func(v *big.Int) string {
+				return strconv.Itoa(int(contracts.CrocSlotByte(v, contracts.CrocProtocolTakeRateOffset)))
+			}))
+		case "SetRelayerTakeRate":
+			add(k.slotStateField("dex.relayer_take_rate", contracts.CrocFlagsSlot, func(v *big.Int) string {
+				return strconv.Itoa(int(contracts.CrocSlotByte(v, contracts.CrocRelayerTakeRateOffset)))
+			}))
+		case "SetNewPoolLiq":
+			add(k.slotStateField("dex.new_pool_liq", contracts.CrocNewPoolSlot, func(v *big.Int) string {
+				return contracts.CrocSlotUint128(v).String()
+			}))
+		case "HotPathOpen":
+			add(k.slotStateField("dex.hot_path_open", contracts.CrocFlagsSlot, func(v *big.Int) string {
+				return strconv.FormatBool(contracts.CrocSlotByte(v, contracts.CrocHotPathOpenOffset) != 0)
+			}))
+		case "UpgradeProxy":
+			if idx, err := strconv.ParseUint(protocolCmdArg(args, "proxyIdx"), 10, 16); err == nil {
+				proxyIdxs = append(proxyIdxs, idx)
+			}
+		}
+	}
+	for _, idx := range proxyIdxs {
+		if idx > math.MaxUint16 {
+			continue
+		}
+		add(k.slotStateField(fmt.Sprintf("dex.proxy_path.%d", idx), contracts.CrocProxyPathSlot(uint16(idx)), func(v *big.Int) string {
+			return contracts.CrocSlotAddress(v).Hex()
+		}))
+	}
+	return fields
+}
+
+// templateStateField reads the pool template poolIdx through CrocQuery
+func (k Keeper) templateStateField(poolIdx string) stateField {
+	return stateField{
+		name: "dex.template." + poolIdx,
+		read: func(ctx sdk.Context) (string, error) {
+			idx, err := strconv.ParseUint(poolIdx, 10, 64)
+			if err != nil {
+				return "", err
+			}
+			spec, err := k.GetPoolTemplate(ctx, idx)
+			return spec.String(), err
+		},
+	}
+}
+
+// poolStateField reads the parameters, including the protocol take rate, of the (base, quote, poolIdx) pool through
+// CrocQuery
+func (k Keeper) poolStateField(base, quote, poolIdx string) stateField {
+	return stateField{
+		name: fmt.Sprintf("dex.pool.%s.%s.%s", common.HexToAddress(base).Hex(), common.HexToAddress(quote).Hex(), poolIdx),
+		read: func(ctx sdk.Context) (string, error) {
+			idx, err := strconv.ParseUint(poolIdx, 10, 64)
+			if err != nil {
+				return "", err
+			}
+			spec, err := k.GetPoolParams(ctx, common.HexToAddress(base), common.HexToAddress(quote), idx)
+			return spec.String(), err
+		},
+	}
+}
+
+// slotStateField reads a value packed into a DEX storage slot
+func (k Keeper) slotStateField(name string, slot uint64, format func(*big.Int) string) stateField {
+	return stateField{
+		name: name,
+		read: func(ctx sdk.Context) (string, error) {
+			value, err := k.readDexSlot(ctx, slot)
+			if err != nil {
+				return "", err
+			}
+			return format(value), nil
+		},
+	}
+}
+
+// readTouchedState adds the touched values to state, values which can not be read are left empty
+func readTouchedState(ctx sdk.Context, touched []stateField, state map[string]string) {
+	for _, field := range touched {
+		if value, err := field.read(ctx); err == nil {
+			state[field.name] = value
+		}
+	}
+}
+
+// protocolCmdArg returns the value of a decoded protocol command argument, or an empty string if it is missing
+func protocolCmdArg(args []types.ProtocolCmdArg, name string) string {
+	for _, arg := range args {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return ""
+}
+
+// recordingEVMKeeper wraps an EVMKeeper to record the calls a proposal handler makes during a simulation
+type recordingEVMKeeper struct {
+	types.EVMKeeper
+
+	calls   []types.EvmCall
+	logs    []types.EvmLog
+	gasUsed uint64
+}
+
+func (r *recordingEVMKeeper) CallEVM(
+	ctx sdk.Context, contractABI abi.ABI, from, contract common.Address, commit bool, method string, args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := r.EVMKeeper.CallEVM(ctx, contractABI, from, contract, commit, method, args...)
+	r.record(contract, method, res, err)
+	return res, err
+}
+
+func (r *recordingEVMKeeper) CallEVMWithData(
+	ctx sdk.Context, from common.Address, contract *common.Address, data []byte, commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := r.EVMKeeper.CallEVMWithData(ctx, from, contract, data, commit)
+	var to common.Address
+	if contract != nil {
+		to = *contract
+	}
+	r.record(to, "", res, err)
+	return res, err
+}
+
+func (r *recordingEVMKeeper) CallEVMWithValue(
+	ctx sdk.Context, from common.Address, contract *common.Address, data []byte, value *big.Int, commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := r.EVMKeeper.CallEVMWithValue(ctx, from, contract, data, value, commit)
+	var to common.Address
+	if contract != nil {
+		to = *contract
+	}
+	r.record(to, "", res, err)
+	return res, err
+}
+
+func (r *recordingEVMKeeper) record(contract common.Address, method string, res *evmtypes.MsgEthereumTxResponse, err error) {
+	call := types.EvmCall{Contract: contract.Hex(), Method: method}
+	if err != nil {
+		call.Error = err.Error()
+	}
+	if res != nil {
+		call.GasUsed = res.GasUsed
+		if res.VmError != "" {
+			call.Error = res.VmError
+		}
+		r.gasUsed += res.GasUsed
+		for _, log := range res.Logs {
+			r.logs = append(r.logs, types.EvmLog{Address: log.Address, Topics: log.Topics, Data: log.Data})
+		}
+	}
+	r.calls = append(r.calls, call)
+}
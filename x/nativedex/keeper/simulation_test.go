@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func stateFieldNames(fields []stateField) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.name
+	}
+	return names
+}
+
+// TestTouchedStateFields checks that the DEX state compared by a proposal simulation is derived from the proposal's
+// protocol commands and proxy upgrades
+func TestTouchedStateFields(t *testing.T) {
+	k := Keeper{}
+	base := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	proxy := common.HexToAddress("0xd263DC98dEc57828e26F69bA8687281BA5D052E0")
+
+	setTemplate, err := contracts.EncodeTypes(
+		[]string{"uint8", "uint256", "uint16", "uint16", "uint8", "uint8", "uint8"},
+		[]interface{}{uint8(110), big.NewInt(36000), uint16(250), uint16(16), uint8(0), uint8(0), uint8(0)},
+	)
+	require.NoError(t, err)
+	resync, err := contracts.EncodeTypes(
+		[]string{"uint8", "address", "address", "uint256"},
+		[]interface{}{uint8(115), base, quote, big.NewInt(36000)},
+	)
+	require.NoError(t, err)
+	takeRate, err := contracts.EncodeTypes([]string{"uint8", "uint8"}, []interface{}{uint8(114), uint8(64)})
+	require.NoError(t, err)
+	upgradeProxy, err := contracts.EncodeTypes([]string{"uint8", "address", "uint16"}, []interface{}{uint8(21), proxy, uint16(4)})
+	require.NoError(t, err)
+
+	ops := &types.OpsProposal{Metadata: types.OpsMetadata{Callpath: 3, CmdArgs: setTemplate}}
+	require.Equal(t, []string{"dex.template.36000"}, stateFieldNames(k.touchedStateFields(ops)))
+
+	upgrade := &types.UpgradeProxyProposal{Metadata: types.UpgradeProxyMetadata{CallpathAddress: proxy.Hex(), CallpathIndex: 5}}
+	require.Equal(t, []string{"dex.proxy_path.5"}, stateFieldNames(k.touchedStateFields(upgrade)))
+
+	batch := &types.BatchDexProposal{Actions: []types.DexAction{
+		{Ops: &types.OpsMetadata{Callpath: 3, CmdArgs: resync}},
+		{Ops: &types.OpsMetadata{Callpath: 3, CmdArgs: takeRate}},
+		{Ops: &types.OpsMetadata{Callpath: 3, CmdArgs: resync}},
+		{TreasuryCmd: &types.TreasuryCmdMetadata{Callpath: 0, CmdArgs: upgradeProxy}},
+		{UpgradeProxy: &types.UpgradeProxyMetadata{CallpathAddress: proxy.Hex(), CallpathIndex: 6}},
+	}}
+	require.Equal(t, []string{
+		"dex.pool." + base.Hex() + "." + quote.Hex() + ".36000",
+		"dex.protocol_take_rate",
+		"dex.proxy_path.4",
+		"dex.proxy_path.6",
+	}, stateFieldNames(k.touchedStateFields(batch)))
+
+	// Undecodable commands and proposals without protocol commands touch nothing beyond the governance fields
+	require.Empty(t, k.touchedStateFields(&types.OpsProposal{Metadata: types.OpsMetadata{Callpath: 3, CmdArgs: []byte{0x1}}}))
+	require.Empty(t, k.touchedStateFields(&types.SetSafeModeProposal{}))
+}
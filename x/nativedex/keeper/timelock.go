@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// QueueProposal stores a passed proposal to be executed once the TimelockBlocks param has elapsed
+func (k Keeper) QueueProposal(ctx sdk.Context, content govv1beta1.Content) (types.QueuedProposal, error) {
+	height := uint64(ctx.BlockHeight())
+	id := k.getNextQueuedProposalID(ctx)
+	queued, err := types.NewQueuedProposal(id, content, height, height+k.GetParams(ctx).TimelockBlocks)
+	if err != nil {
+		return types.QueuedProposal{}, err
+	}
+	k.SetQueuedProposal(ctx, queued)
+	k.setNextQueuedProposalID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposalQueued,
+		sdk.NewAttribute(types.TimelockKeyQueuedID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.TimelockKeyProposalType, content.ProposalType()),
+		sdk.NewAttribute(types.TimelockKeyExecuteHeight, strconv.FormatUint(queued.ExecuteHeight, 10)),
+	))
+	k.Logger(ctx).Info("Queued timelocked proposal", "id", id, "type", content.ProposalType(), "execute_height", queued.ExecuteHeight)
+	return queued, nil
+}
+
+// CancelQueuedProposal removes a proposal from the timelock queue so that it is never executed
+func (k Keeper) CancelQueuedProposal(ctx sdk.Context, id uint64, canceller string) error {
+	queued, found := k.GetQueuedProposal(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrQueuedNotFound, "no queued proposal with id %d", id)
+	}
+	k.DeleteQueuedProposal(ctx, id)
+
+	proposalType := ""
+	if content := queued.ProposalContent(); content != nil {
+		proposalType = content.ProposalType()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposalCancelled,
+		sdk.NewAttribute(types.TimelockKeyQueuedID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.TimelockKeyProposalType, proposalType),
+		sdk.NewAttribute(types.TimelockKeyCanceller, canceller),
+	))
+	k.Logger(ctx).Info("Cancelled timelocked proposal", "id", id, "canceller", canceller)
+	return nil
+}
+
+// RequireEmergencyAuthority returns an error unless addr is the account of the CrocPolicy emergencyAuthority_
+func (k Keeper) RequireEmergencyAuthority(ctx sdk.Context, addr sdk.AccAddress) error {
+	roles, err := k.GetPolicyRoles(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to read the emergency role")
+	}
+	if common.HexToAddress(roles.EmergencyAuthority) != common.BytesToAddress(addr.Bytes()) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s does not hold the emergency role", addr)
+	}
+	return nil
+}
+
+// SetQueuedProposal stores a timelocked proposal under its id
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queued types.QueuedProposal) {
+	ctx.KVStore(k.storeKey).Set(types.GetQueuedProposalKey(queued.Id), k.cdc.MustMarshal(&queued))
+}
+
+// GetQueuedProposal returns the timelocked proposal with the given id, if any
+// nolint: exhaustruct
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, id uint64) (types.QueuedProposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetQueuedProposalKey(id))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queued types.QueuedProposal
+	k.cdc.MustUnmarshal(bz, &queued)
+	return queued, true
+}
+
+// DeleteQueuedProposal removes a timelocked proposal from the queue
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetQueuedProposalKey(id))
+}
+
+// IterateQueuedProposals calls cb on every timelocked proposal in id order, stopping if cb returns true
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queued types.QueuedProposal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var queued types.QueuedProposal
+		k.cdc.MustUnmarshal(iter.Value(), &queued)
+		if cb(queued) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns every timelocked proposal in id order
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) []types.QueuedProposal {
+	queue := []types.QueuedProposal{}
+	k.IterateQueuedProposals(ctx, func(queued types.QueuedProposal) bool {
+		queue = append(queue, queued)
+		return false
+	})
+	return queue
+}
+
+func (k Keeper) getNextQueuedProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextQueuedProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextQueuedProposalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextQueuedProposalIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// InitQueuedProposals stores the genesis timelock queue, continuing ids after the largest queued id
+func (k Keeper) InitQueuedProposals(ctx sdk.Context, queue []types.QueuedProposal) {
+	nextID := uint64(1)
+	for _, queued := range queue {
+		k.SetQueuedProposal(ctx, queued)
+		if queued.Id >= nextID {
+			nextID = queued.Id + 1
+		}
+	}
+	k.setNextQueuedProposalID(ctx, nextID)
+}
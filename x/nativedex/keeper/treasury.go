@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	altheacfg "github.com/AltheaFoundation/althea-L1/config"
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// GetModuleTokenBalance returns the nativedex module's balance of token, the zero address is the native token
+func (k Keeper) GetModuleTokenBalance(ctx sdk.Context, token common.Address) (sdk.Int, error) {
+	if token == (common.Address{}) {
+		return k.BankKeeper.GetBalance(ctx, types.ModuleAddress, altheacfg.BaseDenom).Amount, nil
+	}
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := k.EVMKeeper.CallEVM(ctx, erc20ABI, types.ModuleEVMAddress, token, false, "balanceOf", types.ModuleEVMAddress)
+	if err != nil {
+		return sdk.Int{}, errorsmod.Wrapf(err, "unable to read the %s balance", token.Hex())
+	}
+	out, err := erc20ABI.Unpack("balanceOf", res.Ret)
+	if err != nil || len(out) != 1 {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidEvmAddress, "unable to decode balanceOf() result: %v", err)
+	}
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return sdk.Int{}, errorsmod.Wrap(types.ErrInvalidEvmAddress, "unexpected balanceOf() result type")
+	}
+
+	return sdk.NewIntFromBigInt(balance), nil
+}
+
+// RouteTreasuryProceeds sends amount of token held by the module to destination, which is either the community pool
+// or a bech32 account. ERC20 tokens must have a registered token pair so that they can be sent as Cosmos coins
+func (k Keeper) RouteTreasuryProceeds(ctx sdk.Context, token common.Address, amount sdk.Int, destination string) (sdk.Coins, error) {
+	if !amount.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	coins, err := k.receiveOutput(ctx, types.ModuleAddress, token, amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if destination == types.AutoCollectDestinationCommunityPool {
+		if err := k.DistrKeeper.FundCommunityPool(ctx, coins, types.ModuleAddress); err != nil {
+			return nil, errorsmod.Wrap(err, "unable to fund the community pool")
+		}
+		return coins, nil
+	}
+	receiver, err := sdk.AccAddressFromBech32(destination)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid destination %s", destination)
+	}
+	if err := k.BankKeeper.SendCoins(ctx, types.ModuleAddress, receiver, coins); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to send proceeds to %s", destination)
+	}
+	return coins, nil
+}
@@ -0,0 +1,48 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	v3 "github.com/AltheaFoundation/althea-L1/x/nativedex/migrations/v3"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	nativedexKey := sdk.NewKVStoreKey(nativedextypes.StoreKey)
+	tNativedexKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", nativedextypes.StoreKey))
+	ctx := testutil.DefaultContext(nativedexKey, tNativedexKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, nativedexKey, tNativedexKey, "nativedex",
+	)
+	paramstore = paramstore.WithKeyTable(nativedextypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	key := []byte(nativedextypes.ParamsStoreKeyVerifiedCrocQueryAddress)
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, key))
+
+	// Run migrations
+	err := v3.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, key))
+
+	var crocQueryAddress string
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, key, &crocQueryAddress)
+	})
+	require.Equal(t, nativedextypes.DefaultParams().VerifiedCrocQueryAddress, crocQueryAddress)
+}
@@ -0,0 +1,31 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// UpdateParams sets the params introduced in consensus version 4 to their default values
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	defaults := types.DefaultParams()
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyTimelockedProposalTypes), defaults.TimelockedProposalTypes)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyTimelockBlocks), defaults.TimelockBlocks)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyContractAllowedSelectors), defaults.ContractAllowedSelectors)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyAutoCollectTokens), defaults.AutoCollectTokens)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyAutoCollectInterval), defaults.AutoCollectInterval)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyAutoCollectDestination), defaults.AutoCollectDestination)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyOraclePools), defaults.OraclePools)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyOracleInterval), defaults.OracleInterval)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyOracleMaxObservations), defaults.OracleMaxObservations)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyIncentiveEpochBlocks), defaults.IncentiveEpochBlocks)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyIncentivePositionsPerBlock), defaults.IncentivePositionsPerBlock)
+	paramstore.Set(ctx, []byte(types.ParamsStoreKeyIncentiveMinLiquidity), defaults.IncentiveMinLiquidity)
+	return nil
+}
@@ -0,0 +1,111 @@
+package v4_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	althea "github.com/AltheaFoundation/althea-L1/app"
+	v4 "github.com/AltheaFoundation/althea-L1/x/nativedex/migrations/v4"
+	nativedextypes "github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(althea.ModuleBasicManager)
+	nativedexKey := sdk.NewKVStoreKey(nativedextypes.StoreKey)
+	tNativedexKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", nativedextypes.StoreKey))
+	ctx := testutil.DefaultContext(nativedexKey, tNativedexKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, nativedexKey, tNativedexKey, "nativedex",
+	)
+	paramstore = paramstore.WithKeyTable(nativedextypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	typesKey := []byte(nativedextypes.ParamsStoreKeyTimelockedProposalTypes)
+	blocksKey := []byte(nativedextypes.ParamsStoreKeyTimelockBlocks)
+	selectorsKey := []byte(nativedextypes.ParamsStoreKeyContractAllowedSelectors)
+	collectTokensKey := []byte(nativedextypes.ParamsStoreKeyAutoCollectTokens)
+	collectIntervalKey := []byte(nativedextypes.ParamsStoreKeyAutoCollectInterval)
+	collectDestinationKey := []byte(nativedextypes.ParamsStoreKeyAutoCollectDestination)
+	oraclePoolsKey := []byte(nativedextypes.ParamsStoreKeyOraclePools)
+	oracleIntervalKey := []byte(nativedextypes.ParamsStoreKeyOracleInterval)
+	oracleMaxKey := []byte(nativedextypes.ParamsStoreKeyOracleMaxObservations)
+	incentiveEpochKey := []byte(nativedextypes.ParamsStoreKeyIncentiveEpochBlocks)
+	incentivePositionsKey := []byte(nativedextypes.ParamsStoreKeyIncentivePositionsPerBlock)
+	incentiveMinKey := []byte(nativedextypes.ParamsStoreKeyIncentiveMinLiquidity)
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, typesKey))
+	require.False(t, paramstore.Has(ctx, blocksKey))
+	require.False(t, paramstore.Has(ctx, selectorsKey))
+	require.False(t, paramstore.Has(ctx, collectTokensKey))
+	require.False(t, paramstore.Has(ctx, collectIntervalKey))
+	require.False(t, paramstore.Has(ctx, collectDestinationKey))
+	require.False(t, paramstore.Has(ctx, oraclePoolsKey))
+	require.False(t, paramstore.Has(ctx, oracleIntervalKey))
+	require.False(t, paramstore.Has(ctx, oracleMaxKey))
+	require.False(t, paramstore.Has(ctx, incentiveEpochKey))
+	require.False(t, paramstore.Has(ctx, incentivePositionsKey))
+	require.False(t, paramstore.Has(ctx, incentiveMinKey))
+
+	// Run migrations
+	err := v4.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, typesKey))
+	require.True(t, paramstore.Has(ctx, blocksKey))
+	require.True(t, paramstore.Has(ctx, selectorsKey))
+	require.True(t, paramstore.Has(ctx, collectTokensKey))
+	require.True(t, paramstore.Has(ctx, collectIntervalKey))
+	require.True(t, paramstore.Has(ctx, collectDestinationKey))
+	require.True(t, paramstore.Has(ctx, oraclePoolsKey))
+	require.True(t, paramstore.Has(ctx, oracleIntervalKey))
+	require.True(t, paramstore.Has(ctx, oracleMaxKey))
+	require.True(t, paramstore.Has(ctx, incentiveEpochKey))
+	require.True(t, paramstore.Has(ctx, incentivePositionsKey))
+	require.True(t, paramstore.Has(ctx, incentiveMinKey))
+
+	var timelockedTypes []string
+	var timelockBlocks uint64
+	var selectors []nativedextypes.ContractSelectors
+	var collectTokens []string
+	var collectInterval uint64
+	var collectDestination string
+	var oraclePools []nativedextypes.OraclePool
+	var oracleInterval, oracleMax, incentiveEpochBlocks, incentivePositions uint64
+	var incentiveMin sdk.Int
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, typesKey, &timelockedTypes)
+		paramstore.Get(ctx, blocksKey, &timelockBlocks)
+		paramstore.Get(ctx, selectorsKey, &selectors)
+		paramstore.Get(ctx, collectTokensKey, &collectTokens)
+		paramstore.Get(ctx, collectIntervalKey, &collectInterval)
+		paramstore.Get(ctx, collectDestinationKey, &collectDestination)
+		paramstore.Get(ctx, oraclePoolsKey, &oraclePools)
+		paramstore.Get(ctx, oracleIntervalKey, &oracleInterval)
+		paramstore.Get(ctx, oracleMaxKey, &oracleMax)
+		paramstore.Get(ctx, incentiveEpochKey, &incentiveEpochBlocks)
+		paramstore.Get(ctx, incentivePositionsKey, &incentivePositions)
+		paramstore.Get(ctx, incentiveMinKey, &incentiveMin)
+	})
+	require.Equal(t, nativedextypes.DefaultParams().TimelockedProposalTypes, timelockedTypes)
+	require.Equal(t, nativedextypes.DefaultParams().TimelockBlocks, timelockBlocks)
+	require.Empty(t, selectors)
+	require.Empty(t, collectTokens)
+	require.Zero(t, collectInterval)
+	require.Empty(t, collectDestination)
+	require.Empty(t, oraclePools)
+	require.Zero(t, oracleInterval)
+	require.Equal(t, nativedextypes.DefaultParams().OracleMaxObservations, oracleMax)
+	require.Zero(t, incentiveEpochBlocks)
+	require.Equal(t, nativedextypes.DefaultParams().IncentivePositionsPerBlock, incentivePositions)
+	require.True(t, nativedextypes.DefaultParams().IncentiveMinLiquidity.Equal(incentiveMin))
+}
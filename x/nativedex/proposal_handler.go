@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -27,8 +28,25 @@ const (
 	SET_SAFE_MODE_CMD      uint8 = 23
 )
 
-// Return governance handler to process dex governance proposals
+// Return governance handler to process dex governance proposals. Proposals of the types listed in the
+// TimelockedProposalTypes param are queued and later executed by the EndBlocker, the rest execute immediately
 func NewNativeDexProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
+	execute := NewNativeDexProposalExecutor(k)
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if !k.GetParams(ctx).IsTimelocked(content) {
+			return execute(ctx, content)
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := k.QueueProposal(ctx, content)
+		return err
+	}
+}
+
+// NewNativeDexProposalExecutor returns a handler which executes dex governance proposals immediately, regardless of
+// the timelock. It is used for proposals leaving the timelock queue and for proposal simulations
+func NewNativeDexProposalExecutor(k *keeper.Keeper) govv1beta1.Handler {
 	return func(ctx sdk.Context, content govv1beta1.Content) error {
 		switch c := content.(type) {
 		case *types.UpgradeProxyProposal:
@@ -49,6 +67,18 @@ func NewNativeDexProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 			return handleOpsProposal(ctx, k, c)
 		case *types.ExecuteContractProposal:
 			return handleExecuteContractProposal(ctx, k, c)
+		case *types.BatchDexProposal:
+			return handleBatchDexProposal(ctx, k, c)
+		case *types.CancelTimelockedProposal:
+			return handleCancelTimelockedProposal(ctx, k, c)
+		case *types.AddWhitelistedContractProposal:
+			return handleAddWhitelistedContractProposal(ctx, k, c)
+		case *types.RemoveWhitelistedContractProposal:
+			return handleRemoveWhitelistedContractProposal(ctx, k, c)
+		case *types.CreateIncentiveProgramProposal:
+			return handleCreateIncentiveProgramProposal(ctx, k, c)
+		case *types.CancelIncentiveProgramProposal:
+			return handleCancelIncentiveProgramProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -62,7 +92,11 @@ func handleUpgradeProxyProposal(ctx sdk.Context, k *keeper.Keeper, p *types.Upgr
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeUpgradeProxy(ctx, k, p.GetMetadata())
+}
+
+// nolint: dupl
+func executeUpgradeProxy(ctx sdk.Context, k *keeper.Keeper, md types.UpgradeProxyMetadata) error {
 	callpath := BOOT_PATH
 	cmd_code := UPGRADE_PROXY_CMD
 
@@ -72,14 +106,17 @@ func handleUpgradeProxyProposal(ctx sdk.Context, k *keeper.Keeper, p *types.Upgr
 		ctx.Logger().Error("Encode protocolCmd args UpgradeProxyProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for UpgradeProxyProposal", "err", err)
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventUpgradeProxy{
+		Call:            call,
+		CallpathAddress: common.HexToAddress(md.CallpathAddress).Hex(),
+		CallpathIndex:   md.CallpathIndex,
+	})
 }
 
 // nolint: dupl
@@ -88,9 +125,13 @@ func handleCollectTreasuryProposal(ctx sdk.Context, k *keeper.Keeper, p *types.C
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeCollectTreasury(ctx, k, p.GetMetadata(), p.InSafeMode)
+}
+
+// nolint: dupl
+func executeCollectTreasury(ctx sdk.Context, k *keeper.Keeper, md types.CollectTreasuryMetadata, inSafeMode bool) error {
 	callpath := COLD_PATH
-	if p.InSafeMode {
+	if inSafeMode {
 		callpath = SAFEMODE_PATH
 	}
 	cmd_code := COLLECT_TREASURY_CMD
@@ -101,14 +142,13 @@ func handleCollectTreasuryProposal(ctx sdk.Context, k *keeper.Keeper, p *types.C
 		ctx.Logger().Error("Encode protocolCmd args CollectTreasuryProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy. treasuryResolution() for CollectTreasuryProposal", "err", err)
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventCollectTreasury{Call: call, TokenAddress: common.HexToAddress(md.TokenAddress).Hex()})
 }
 
 // nolint: dupl
@@ -117,9 +157,13 @@ func handleSetTreasuryProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetTr
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeSetTreasury(ctx, k, p.GetMetadata(), p.InSafeMode)
+}
+
+// nolint: dupl
+func executeSetTreasury(ctx sdk.Context, k *keeper.Keeper, md types.SetTreasuryMetadata, inSafeMode bool) error {
 	callpath := COLD_PATH
-	if p.InSafeMode {
+	if inSafeMode {
 		callpath = SAFEMODE_PATH
 	}
 	cmd_code := SET_TREASURY_CMD
@@ -130,13 +174,13 @@ func handleSetTreasuryProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetTr
 		ctx.Logger().Error("Encode protocolCmd args SetTreasuryProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for SetTreasuryProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSetTreasury{Call: call, TreasuryAddress: common.HexToAddress(md.TreasuryAddress).Hex()})
 }
 
 // nolint: dupl
@@ -145,9 +189,13 @@ func handleAuthorityTransferProposal(ctx sdk.Context, k *keeper.Keeper, p *types
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeAuthorityTransfer(ctx, k, p.GetMetadata(), p.InSafeMode)
+}
+
+// nolint: dupl
+func executeAuthorityTransfer(ctx sdk.Context, k *keeper.Keeper, md types.AuthorityTransferMetadata, inSafeMode bool) error {
 	callpath := COLD_PATH
-	if p.InSafeMode {
+	if inSafeMode {
 		callpath = SAFEMODE_PATH
 	}
 	cmd_code := AUTHORITY_TRANSFER_CMD
@@ -158,13 +206,13 @@ func handleAuthorityTransferProposal(ctx sdk.Context, k *keeper.Keeper, p *types
 		ctx.Logger().Error("Encode protocolCmd args AuthorityTransferProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for AuthorityTransferProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAuthorityTransfer{Call: call, AuthAddress: common.HexToAddress(md.AuthAddress).Hex()})
 }
 
 // nolint: dupl
@@ -173,9 +221,13 @@ func handleHotPathOpenProposal(ctx sdk.Context, k *keeper.Keeper, p *types.HotPa
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeHotPathOpen(ctx, k, p.GetMetadata(), p.InSafeMode)
+}
+
+// nolint: dupl
+func executeHotPathOpen(ctx sdk.Context, k *keeper.Keeper, md types.HotPathOpenMetadata, inSafeMode bool) error {
 	callpath := COLD_PATH
-	if p.InSafeMode {
+	if inSafeMode {
 		callpath = SAFEMODE_PATH
 	}
 	cmd_code := HOT_PATH_OPEN_CMD
@@ -186,13 +238,13 @@ func handleHotPathOpenProposal(ctx sdk.Context, k *keeper.Keeper, p *types.HotPa
 		ctx.Logger().Error("Encode protocolCmd args HotPathOpenProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for HotPathOpenProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventHotPathOpen{Call: call, Open: md.Open})
 }
 
 // nolint: dupl
@@ -201,9 +253,13 @@ func handleSetSafeModeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetSa
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeSetSafeMode(ctx, k, p.GetMetadata(), p.InSafeMode)
+}
+
+// nolint: dupl
+func executeSetSafeMode(ctx sdk.Context, k *keeper.Keeper, md types.SetSafeModeMetadata, inSafeMode bool) error {
 	callpath := COLD_PATH
-	if p.InSafeMode {
+	if inSafeMode {
 		callpath = SAFEMODE_PATH
 	}
 	cmd_code := SET_SAFE_MODE_CMD
@@ -214,13 +270,13 @@ func handleSetSafeModeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetSa
 		ctx.Logger().Error("Encode protocolCmd args SetSafeModeProposal", "err", err)
 		return err
 	}
-	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "treasuryResolution", k.GetNativeDexAddress(ctx), callpath, encodedProtocolCmd, true)
+	call, err := callTreasuryResolution(ctx, k, callpath, encodedProtocolCmd)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for SetSafeModeProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSetSafeMode{Call: call, LockDex: md.LockDex})
 }
 
 // nolint: dupl
@@ -229,17 +285,29 @@ func handleTransferGovernanceProposal(ctx sdk.Context, k *keeper.Keeper, p *type
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeTransferGovernance(ctx, k, p.GetMetadata())
+}
+
+// nolint: dupl
+func executeTransferGovernance(ctx sdk.Context, k *keeper.Keeper, md types.TransferGovernanceMetadata) error {
 	// This proposal does not directly work on the DEX, so no callpath nor cmd_code are used
 	// CrocPolicy ABI: transferGovernance (address ops, address treasury, address emergency)
 	ops := common.HexToAddress(md.Ops)
 	emergency := common.HexToAddress(md.Emergency)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "transferGovernance", ops, types.ModuleEVMAddress, emergency)
+	policy := k.GetVerifiedCrocPolicyAddress(ctx)
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, policy, true, "transferGovernance", ops, types.ModuleEVMAddress, emergency)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.transferGovernance() for TransferGovernanceProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransferGovernance{
+		// nolint: exhaustruct
+		Call:      &types.DexCall{PolicyAddress: policy.Hex(), Method: "transferGovernance", GasUsed: res.GasUsed},
+		Ops:       ops.Hex(),
+		Treasury:  types.ModuleEVMAddress.Hex(),
+		Emergency: emergency.Hex(),
+	})
 }
 
 // nolint: dupl
@@ -248,52 +316,248 @@ func handleOpsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.OpsProposal)
 	if err != nil {
 		return err
 	}
-	md := p.GetMetadata()
+	return executeOps(ctx, k, p.GetMetadata())
+}
+
+// nolint: dupl
+func executeOps(ctx sdk.Context, k *keeper.Keeper, md types.OpsMetadata) error {
 	callpath := uint16(md.Callpath)
+	policy := k.GetVerifiedCrocPolicyAddress(ctx)
+	dex := k.GetNativeDexAddress(ctx)
 	// CrocPolicy ABI: opsResolution (address minion, uint16 proxyPath, bytes cmd)
-	_, err = k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, k.GetVerifiedCrocPolicyAddress(ctx), true, "opsResolution", k.GetNativeDexAddress(ctx), callpath, md.CmdArgs)
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, policy, true, "opsResolution", dex, callpath, md.CmdArgs)
 	if err != nil {
 		ctx.Logger().Error("Unable to call CrocPolicy.opsResolution() for OpsProposal", "err", err)
 		return err
 	}
-	return nil
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventOps{Call: newDexCall(policy, dex, "opsResolution", callpath, md.CmdArgs, res.GasUsed)})
 }
 
-// handleExecuteContractProposal executes an arbitrary contract call from the nativedex module account
-// The contract address must be whitelisted in the module params
+// handleExecuteContractProposal validates and executes an ExecuteContractProposal
 func handleExecuteContractProposal(ctx sdk.Context, k *keeper.Keeper, p *types.ExecuteContractProposal) error {
 	err := p.ValidateBasic()
 	if err != nil {
 		return err
 	}
+	return executeExecuteContract(ctx, k, p.GetMetadata())
+}
 
-	md := p.GetMetadata()
+// executeExecuteContract executes an arbitrary contract call from the nativedex module account
+// The contract address must be whitelisted in the module params, and the call must use one of the contract's allowed
+// function selectors if it has any
+func executeExecuteContract(ctx sdk.Context, k *keeper.Keeper, md types.ExecuteContractMetadata) error {
 	contractAddress := common.HexToAddress(md.ContractAddress)
+	data := common.FromHex(md.Data)
+
+	params := k.GetParams(ctx)
+	if !params.IsWhitelistedContract(contractAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract address %s is not whitelisted", md.ContractAddress)
+	}
+	if !params.IsCallAllowed(contractAddress, data) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "function selector is not allowed for contract %s", md.ContractAddress)
+	}
+
+	value, err := md.ValueInt()
+	if err != nil {
+		return err
+	}
+
+	// Execute the contract call from the nativedex module account, sending value from the module's native balance
+	res, err := k.EVMKeeper.CallEVMWithValue(ctx, types.ModuleEVMAddress, &contractAddress, data, value.BigInt(), true)
+	if err != nil {
+		ctx.Logger().Error("Unable to execute contract call for ExecuteContractProposal", "err", err, "contract", md.ContractAddress)
+		return err
+	}
+
+	ctx.Logger().Info("Successfully executed ExecuteContractProposal", "contract", md.ContractAddress, "value", value)
+	selector := ""
+	if len(data) >= types.SelectorLength {
+		selector = "0x" + common.Bytes2Hex(data[:types.SelectorLength])
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventExecuteContract{
+		ContractAddress: contractAddress.Hex(),
+		Selector:        selector,
+		Value:           value.String(),
+		GasUsed:         res.GasUsed,
+	})
+}
+
+// handleAddWhitelistedContractProposal whitelists a contract for ExecuteContractProposal, replacing its allowed
+// function selectors if it is already whitelisted
+func handleAddWhitelistedContractProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddWhitelistedContractProposal) error {
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	params.AddWhitelistedContract(common.HexToAddress(p.ContractAddress), p.AllowedSelectors)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	ctx.Logger().Info("Whitelisted contract for ExecuteContractProposal", "contract", p.ContractAddress, "selectors", p.AllowedSelectors)
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractWhitelisted{
+		ContractAddress:  common.HexToAddress(p.ContractAddress).Hex(),
+		AllowedSelectors: p.AllowedSelectors,
+	})
+}
+
+// handleRemoveWhitelistedContractProposal removes a contract and its allowed function selectors from the whitelist
+func handleRemoveWhitelistedContractProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemoveWhitelistedContractProposal) error {
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if !params.RemoveWhitelistedContract(common.HexToAddress(p.ContractAddress)) {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract address %s is not whitelisted", p.ContractAddress)
+	}
+	k.SetParams(ctx, params)
+
+	ctx.Logger().Info("Removed contract from the ExecuteContractProposal whitelist", "contract", p.ContractAddress)
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractRemovedFromWhitelist{
+		ContractAddress: common.HexToAddress(p.ContractAddress).Hex(),
+	})
+}
 
-	// Check if the contract address is whitelisted
-	whitelistedAddresses := k.GetWhitelistedContractAddresses(ctx)
-	isWhitelisted := false
-	for _, addr := range whitelistedAddresses {
-		if addr == md.ContractAddress {
-			isWhitelisted = true
-			break
+// handleBatchDexProposal executes every action of the proposal in order against a cached context, which is only
+// written if all of the actions succeed
+func handleBatchDexProposal(ctx sdk.Context, k *keeper.Keeper, p *types.BatchDexProposal) error {
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, action := range p.Actions {
+		if err := executeDexAction(cacheCtx, k, action); err != nil {
+			ctx.Logger().Error("Unable to execute BatchDexProposal, no actions were applied", "action", i, "err", err)
+			return errorsmod.Wrapf(err, "batch action %d", i)
 		}
 	}
+	writeCache()
 
-	if !isWhitelisted {
-		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract address %s is not whitelisted", md.ContractAddress)
+	ctx.Logger().Info("Successfully executed BatchDexProposal", "actions", len(p.Actions))
+	return ctx.EventManager().EmitTypedEvent(&types.EventBatchDex{Actions: uint64(len(p.Actions))})
+}
+
+// executeDexAction executes a single BatchDexProposal action exactly like the equivalent proposal
+func executeDexAction(ctx sdk.Context, k *keeper.Keeper, a types.DexAction) error {
+	switch {
+	case a.UpgradeProxy != nil:
+		return executeUpgradeProxy(ctx, k, *a.UpgradeProxy)
+	case a.CollectTreasury != nil:
+		return executeCollectTreasury(ctx, k, *a.CollectTreasury, a.InSafeMode)
+	case a.SetTreasury != nil:
+		return executeSetTreasury(ctx, k, *a.SetTreasury, a.InSafeMode)
+	case a.AuthorityTransfer != nil:
+		return executeAuthorityTransfer(ctx, k, *a.AuthorityTransfer, a.InSafeMode)
+	case a.HotPathOpen != nil:
+		return executeHotPathOpen(ctx, k, *a.HotPathOpen, a.InSafeMode)
+	case a.SetSafeMode != nil:
+		return executeSetSafeMode(ctx, k, *a.SetSafeMode, a.InSafeMode)
+	case a.TransferGovernance != nil:
+		return executeTransferGovernance(ctx, k, *a.TransferGovernance)
+	case a.Ops != nil:
+		return executeOps(ctx, k, *a.Ops)
+	case a.ExecuteContract != nil:
+		return executeExecuteContract(ctx, k, *a.ExecuteContract)
+	case a.TreasuryCmd != nil:
+		return executeTreasuryCmd(ctx, k, *a.TreasuryCmd)
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "dex action has no metadata")
 	}
+}
 
-	// Decode the hex data
-	data := common.FromHex(md.Data)
+// nolint: dupl
+func executeTreasuryCmd(ctx sdk.Context, k *keeper.Keeper, md types.TreasuryCmdMetadata) error {
+	call, err := callTreasuryResolution(ctx, k, uint16(md.Callpath), md.CmdArgs)
+	if err != nil {
+		ctx.Logger().Error("Unable to call CrocPolicy.treasuryResolution() for BatchDexProposal", "err", err)
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTreasuryCmd{Call: call})
+}
 
-	// Execute the contract call from the nativedex module account
-	_, err = k.EVMKeeper.CallEVMWithData(ctx, types.ModuleEVMAddress, &contractAddress, data, true)
+// handleCancelTimelockedProposal removes a passed proposal from the timelock queue
+func handleCancelTimelockedProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelTimelockedProposal) error {
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	return k.CancelQueuedProposal(ctx, p.QueuedId, govtypes.ModuleName)
+}
+
+// handleCreateIncentiveProgramProposal funds a liquidity incentive program from the community pool
+func handleCreateIncentiveProgramProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CreateIncentiveProgramProposal) error {
+	err := p.ValidateBasic()
 	if err != nil {
-		ctx.Logger().Error("Unable to execute contract call for ExecuteContractProposal", "err", err, "contract", md.ContractAddress)
 		return err
 	}
 
-	ctx.Logger().Info("Successfully executed ExecuteContractProposal", "contract", md.ContractAddress)
-	return nil
+	program, err := k.CreateIncentiveProgram(ctx, common.HexToAddress(p.Base), common.HexToAddress(p.Quote), p.PoolIdx, p.RewardPerEpoch, p.Epochs)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventIncentiveProgramCreated{
+		ProgramId:      program.Id,
+		Base:           program.Base,
+		Quote:          program.Quote,
+		PoolIdx:        program.PoolIdx,
+		RewardPerEpoch: program.RewardPerEpoch.String(),
+		Epochs:         program.Epochs,
+		Funds:          program.Remaining.String(),
+	})
+}
+
+// handleCancelIncentiveProgramProposal ends a liquidity incentive program, refunding the community pool
+func handleCancelIncentiveProgramProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelIncentiveProgramProposal) error {
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	refund, err := k.CancelIncentiveProgram(ctx, p.ProgramId)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventIncentiveProgramCancelled{
+		ProgramId: p.ProgramId,
+		Refund:    refund.String(),
+	})
+}
+
+// callTreasuryResolution runs a protocolCmd on the DEX with CrocPolicy.treasuryResolution(), returning a description
+// of the call for the proposal's event
+func callTreasuryResolution(ctx sdk.Context, k *keeper.Keeper, callpath uint16, cmd []byte) (*types.DexCall, error) {
+	policy := k.GetVerifiedCrocPolicyAddress(ctx)
+	dex := k.GetNativeDexAddress(ctx)
+	// CrocPolicy ABI: treasuryResolution (address, uint16, bytes, bool)
+	res, err := k.EVMKeeper.CallEVM(ctx, contracts.CrocPolicyContract.ABI, types.ModuleEVMAddress, policy, true, "treasuryResolution", dex, callpath, cmd, true)
+	if err != nil {
+		return nil, err
+	}
+	return newDexCall(policy, dex, "treasuryResolution", callpath, cmd, res.GasUsed), nil
+}
+
+// newDexCall describes a protocolCmd run on the DEX, the arguments of commands which cannot be decoded are omitted
+func newDexCall(policy, dex common.Address, method string, callpath uint16, cmd []byte, gasUsed uint64) *types.DexCall {
+	code, args, err := types.DecodeProtocolCmd(cmd)
+	if err != nil {
+		args = []types.ProtocolCmdArg{}
+	}
+	return &types.DexCall{
+		PolicyAddress: policy.Hex(),
+		DexAddress:    dex.Hex(),
+		Method:        method,
+		Callpath:      uint32(callpath),
+		CmdCode:       uint32(code),
+		Args:          args,
+		GasUsed:       gasUsed,
+	}
 }
@@ -3,11 +3,21 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
+// nolint: exhaustruct
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSwap{}, "nativedex/MsgSwap", nil)
+	cdc.RegisterConcrete(&MsgMintAmbientLiquidity{}, "nativedex/MsgMintAmbientLiquidity", nil)
+	cdc.RegisterConcrete(&MsgMintRangeLiquidity{}, "nativedex/MsgMintRangeLiquidity", nil)
+	cdc.RegisterConcrete(&MsgBurnLiquidity{}, "nativedex/MsgBurnLiquidity", nil)
+	cdc.RegisterConcrete(&MsgHarvest{}, "nativedex/MsgHarvest", nil)
+	cdc.RegisterConcrete(&MsgCancelTimelockedProposal{}, "nativedex/MsgCancelTimelockedProposal", nil)
+	cdc.RegisterConcrete(&MsgClaimIncentives{}, "nativedex/MsgClaimIncentives", nil)
+	cdc.RegisterConcrete(&MsgRegisterIncentivePosition{}, "nativedex/MsgRegisterIncentivePosition", nil)
 }
 
 // nolint: exhaustruct
@@ -23,6 +33,23 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&TransferGovernanceProposal{},
 		&OpsProposal{},
 		&ExecuteContractProposal{},
+		&BatchDexProposal{},
+		&CancelTimelockedProposal{},
+		&AddWhitelistedContractProposal{},
+		&RemoveWhitelistedContractProposal{},
+		&CreateIncentiveProgramProposal{},
+		&CancelIncentiveProgramProposal{},
+	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSwap{},
+		&MsgMintAmbientLiquidity{},
+		&MsgMintRangeLiquidity{},
+		&MsgBurnLiquidity{},
+		&MsgHarvest{},
+		&MsgCancelTimelockedProposal{},
+		&MsgClaimIncentives{},
+		&MsgRegisterIncentivePosition{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// DecodeProposal describes the changes a nativedex proposal makes using named and typed fields, so that the ABI
+// encoded commands of OpsProposals and raw BatchDexProposal actions can be checked before voting
+// nolint: exhaustruct
+func DecodeProposal(content govv1beta1.Content) (QueryDecodeProposalResponse, error) {
+	res := QueryDecodeProposalResponse{
+		ProposalType: content.ProposalType(),
+		Title:        content.GetTitle(),
+		Actions:      []DecodedAction{},
+	}
+
+	var actions []DexAction
+	switch c := content.(type) {
+	case *UpgradeProxyProposal:
+		actions = []DexAction{{UpgradeProxy: &c.Metadata}}
+	case *CollectTreasuryProposal:
+		actions = []DexAction{{CollectTreasury: &c.Metadata, InSafeMode: c.InSafeMode}}
+	case *SetTreasuryProposal:
+		actions = []DexAction{{SetTreasury: &c.Metadata, InSafeMode: c.InSafeMode}}
+	case *AuthorityTransferProposal:
+		actions = []DexAction{{AuthorityTransfer: &c.Metadata, InSafeMode: c.InSafeMode}}
+	case *HotPathOpenProposal:
+		actions = []DexAction{{HotPathOpen: &c.Metadata, InSafeMode: c.InSafeMode}}
+	case *SetSafeModeProposal:
+		actions = []DexAction{{SetSafeMode: &c.Metadata, InSafeMode: c.InSafeMode}}
+	case *TransferGovernanceProposal:
+		actions = []DexAction{{TransferGovernance: &c.Metadata}}
+	case *OpsProposal:
+		actions = []DexAction{{Ops: &c.Metadata}}
+	case *ExecuteContractProposal:
+		actions = []DexAction{{ExecuteContract: &c.Metadata}}
+	case *BatchDexProposal:
+		actions = c.Actions
+	case *CancelTimelockedProposal:
+		res.Actions = append(res.Actions, newDecodedAction(ProposalTypeCancelTimelocked, "", false,
+			ProtocolCmdArg{Name: "queuedId", Type: "uint64", Value: strconv.FormatUint(c.QueuedId, 10)},
+		))
+		return res, nil
+	case *AddWhitelistedContractProposal:
+		action := newDecodedAction(ProposalTypeAddWhitelisted, "", false)
+		action.ContractAddress = common.HexToAddress(c.ContractAddress).Hex()
+		for _, selector := range c.AllowedSelectors {
+			action.Args = append(action.Args, ProtocolCmdArg{Name: "selector", Type: "bytes4", Value: selector})
+		}
+		res.Actions = append(res.Actions, action)
+		return res, nil
+	case *RemoveWhitelistedContractProposal:
+		action := newDecodedAction(ProposalTypeRemoveWhitelisted, "", false)
+		action.ContractAddress = common.HexToAddress(c.ContractAddress).Hex()
+		res.Actions = append(res.Actions, action)
+		return res, nil
+	case *CreateIncentiveProgramProposal:
+		res.Actions = append(res.Actions, newDecodedAction(ProposalTypeCreateIncentive, "", false,
+			ProtocolCmdArg{Name: "base", Type: "address", Value: common.HexToAddress(c.Base).Hex()},
+			ProtocolCmdArg{Name: "quote", Type: "address", Value: common.HexToAddress(c.Quote).Hex()},
+			ProtocolCmdArg{Name: "poolIdx", Type: "uint64", Value: strconv.FormatUint(c.PoolIdx, 10)},
+			ProtocolCmdArg{Name: "rewardPerEpoch", Type: "coin", Value: c.RewardPerEpoch.String()},
+			ProtocolCmdArg{Name: "epochs", Type: "uint64", Value: strconv.FormatUint(c.Epochs, 10)},
+		))
+		return res, nil
+	case *CancelIncentiveProgramProposal:
+		res.Actions = append(res.Actions, newDecodedAction(ProposalTypeCancelIncentive, "", false,
+			ProtocolCmdArg{Name: "programId", Type: "uint64", Value: strconv.FormatUint(c.ProgramId, 10)},
+		))
+		return res, nil
+	default:
+		return res, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+	}
+
+	for i, action := range actions {
+		decoded, err := DecodeDexAction(action)
+		if err != nil {
+			return res, errorsmod.Wrapf(err, "action %d", i)
+		}
+		res.Actions = append(res.Actions, decoded)
+	}
+	return res, nil
+}
+
+// DecodeDexAction describes the change made by a single proposal or BatchDexProposal action
+func DecodeDexAction(a DexAction) (DecodedAction, error) {
+	switch {
+	case a.UpgradeProxy != nil:
+		return newCmdAction(a, upgradeProxyCmdCode, common.HexToAddress(a.UpgradeProxy.CallpathAddress).Hex(),
+			strconv.FormatUint(a.UpgradeProxy.CallpathIndex, 10)), nil
+	case a.CollectTreasury != nil:
+		return newCmdAction(a, collectTreasuryCmdCode, common.HexToAddress(a.CollectTreasury.TokenAddress).Hex()), nil
+	case a.SetTreasury != nil:
+		return newCmdAction(a, setTreasuryCmdCode, common.HexToAddress(a.SetTreasury.TreasuryAddress).Hex()), nil
+	case a.AuthorityTransfer != nil:
+		return newCmdAction(a, authorityTransferCmdCode, common.HexToAddress(a.AuthorityTransfer.AuthAddress).Hex()), nil
+	case a.HotPathOpen != nil:
+		return newCmdAction(a, hotPathOpenCmdCode, strconv.FormatBool(a.HotPathOpen.Open)), nil
+	case a.SetSafeMode != nil:
+		return newCmdAction(a, setSafeModeCmdCode, strconv.FormatBool(a.SetSafeMode.LockDex)), nil
+	case a.TransferGovernance != nil:
+		return newDecodedAction(ProposalTypeTransferGovernance, "transferGovernance", false,
+			ProtocolCmdArg{Name: "ops", Type: "address", Value: common.HexToAddress(a.TransferGovernance.Ops).Hex()},
+			ProtocolCmdArg{Name: "treasury", Type: "address", Value: ModuleEVMAddress.Hex()},
+			ProtocolCmdArg{Name: "emergency", Type: "address", Value: common.HexToAddress(a.TransferGovernance.Emergency).Hex()},
+		), nil
+	case a.Ops != nil:
+		return newRawCmdAction(ProposalTypeOps, "opsResolution", a.Ops.Callpath, a.Ops.CmdArgs)
+	case a.TreasuryCmd != nil:
+		decoded, err := newRawCmdAction(a.ProposalType(), "treasuryResolution", a.TreasuryCmd.Callpath, a.TreasuryCmd.CmdArgs)
+		decoded.InSafeMode = a.InSafeMode
+		return decoded, err
+	case a.ExecuteContract != nil:
+		value, err := a.ExecuteContract.ValueInt()
+		if err != nil {
+			return DecodedAction{}, err
+		}
+		data := common.FromHex(a.ExecuteContract.Data)
+		decoded := newDecodedAction(ProposalTypeExecuteContract, "", false,
+			ProtocolCmdArg{Name: "value", Type: "uint256", Value: value.String()},
+			ProtocolCmdArg{Name: "data", Type: "bytes", Value: "0x" + common.Bytes2Hex(data)},
+		)
+		decoded.ContractAddress = common.HexToAddress(a.ExecuteContract.ContractAddress).Hex()
+		if len(data) >= SelectorLength {
+			decoded.Command = "0x" + common.Bytes2Hex(data[:SelectorLength])
+		}
+		return decoded, nil
+	default:
+		return DecodedAction{}, errorsmod.Wrap(ErrInvalidProposal, "dex action has no metadata")
+	}
+}
+
+func newDecodedAction(action, method string, inSafeMode bool, args ...ProtocolCmdArg) DecodedAction {
+	if args == nil {
+		args = []ProtocolCmdArg{}
+	}
+	// nolint: exhaustruct
+	return DecodedAction{Action: action, Method: method, InSafeMode: inSafeMode, Args: args}
+}
+
+// newCmdAction describes a treasury proposal's protocolCmd, naming the given values with the command's layout
+func newCmdAction(a DexAction, code uint8, values ...string) DecodedAction {
+	layout := protocolCmdLayouts[code]
+	decoded := newDecodedAction(a.ProposalType(), "treasuryResolution", a.InSafeMode)
+	decoded.Command = layout.command
+	decoded.CmdCode = uint32(code)
+	for i, value := range values {
+		decoded.Args = append(decoded.Args, ProtocolCmdArg{Name: layout.names[i], Type: layout.types[i], Value: value})
+	}
+	return decoded
+}
+
+// newRawCmdAction describes an ABI encoded protocolCmd run on an explicit callpath
+func newRawCmdAction(action, method string, callpath uint64, cmd []byte) (DecodedAction, error) {
+	code, args, err := DecodeProtocolCmd(cmd)
+	if err != nil {
+		return DecodedAction{}, err
+	}
+	decoded := newDecodedAction(action, method, false, args...)
+	decoded.Command = ProtocolCmdName(code)
+	decoded.CmdCode = uint32(code)
+	decoded.Callpath = callpath
+	return decoded, nil
+}
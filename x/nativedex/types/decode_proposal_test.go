@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// nolint: exhaustruct
+func TestDecodeProposal(t *testing.T) {
+	proxy := common.HexToAddress("0xd263DC98dEc57828e26F69bA8687281BA5D052E0")
+
+	setTemplate, err := contracts.EncodeTypes(
+		[]string{"uint8", "uint256", "uint16", "uint16", "uint8", "uint8", "uint8"},
+		[]interface{}{uint8(110), big.NewInt(36000), uint16(250), uint16(16), uint8(10), uint8(0), uint8(0)},
+	)
+	require.NoError(t, err)
+	res, err := types.DecodeProposal(types.NewOpsProposal("Template", "Add a pool template", types.OpsMetadata{Callpath: 3, CmdArgs: setTemplate}))
+	require.NoError(t, err)
+	require.Equal(t, types.ProposalTypeOps, res.ProposalType)
+	require.Len(t, res.Actions, 1)
+	require.Equal(t, "opsResolution", res.Actions[0].Method)
+	require.Equal(t, "SetTemplate", res.Actions[0].Command)
+	require.Equal(t, uint32(110), res.Actions[0].CmdCode)
+	require.Equal(t, uint64(3), res.Actions[0].Callpath)
+	require.Equal(t, []types.ProtocolCmdArg{
+		{Name: "poolIdx", Type: "uint256", Value: "36000"},
+		{Name: "feeRate", Type: "uint16", Value: "250"},
+		{Name: "tickSize", Type: "uint16", Value: "16"},
+		{Name: "jitThresh", Type: "uint8", Value: "10"},
+		{Name: "knockout", Type: "uint8", Value: "0"},
+		{Name: "oracleFlags", Type: "uint8", Value: "0"},
+	}, res.Actions[0].Args)
+
+	// Typed proposals and raw treasury commands decode to the same protocolCmd
+	hotPath, err := contracts.EncodeTypes([]string{"uint8", "bool"}, []interface{}{uint8(22), true})
+	require.NoError(t, err)
+	batch := &types.BatchDexProposal{Title: "Batch", Description: "Upgrade and open", Actions: []types.DexAction{
+		{UpgradeProxy: &types.UpgradeProxyMetadata{CallpathAddress: proxy.Hex(), CallpathIndex: 4}},
+		{TreasuryCmd: &types.TreasuryCmdMetadata{Callpath: 3, CmdArgs: hotPath}},
+		{HotPathOpen: &types.HotPathOpenMetadata{Open: true}},
+	}}
+	res, err = types.DecodeProposal(batch)
+	require.NoError(t, err)
+	require.Len(t, res.Actions, 3)
+	require.Equal(t, "UpgradeProxy", res.Actions[0].Command)
+	require.Equal(t, []types.ProtocolCmdArg{
+		{Name: "proxy", Type: "address", Value: proxy.Hex()},
+		{Name: "proxyIdx", Type: "uint16", Value: "4"},
+	}, res.Actions[0].Args)
+	require.Equal(t, types.ProposalTypeHotPathOpen, res.Actions[1].Action)
+	require.Equal(t, res.Actions[2].Args, res.Actions[1].Args)
+	require.Equal(t, res.Actions[2].CmdCode, res.Actions[1].CmdCode)
+
+	res, err = types.DecodeProposal(types.NewExecuteContractProposal("Call", "Call a contract", types.ExecuteContractMetadata{
+		ContractAddress: proxy.Hex(),
+		Data:            "0xa9059cbb00",
+		Value:           "5",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, proxy.Hex(), res.Actions[0].ContractAddress)
+	require.Equal(t, "0xa9059cbb", res.Actions[0].Command)
+
+	_, err = types.DecodeProposal(types.NewOpsProposal("Bad", "Truncated command", types.OpsMetadata{Callpath: 3, CmdArgs: setTemplate[:64]}))
+	require.Error(t, err)
+}
@@ -8,4 +8,12 @@ import (
 var (
 	ErrInvalidEvmAddress = sdkerrors.Register(ModuleName, 2, "Invalid EVM Address")
 	ErrInvalidCallpath   = sdkerrors.Register(ModuleName, 3, "Invalid Callpath")
+	ErrInvalidPool       = sdkerrors.Register(ModuleName, 4, "Invalid Pool")
+	ErrInvalidSwap       = sdkerrors.Register(ModuleName, 5, "Invalid Swap")
+	ErrInsufficientOut   = sdkerrors.Register(ModuleName, 6, "Swap output below minimum")
+	ErrInvalidPosition   = sdkerrors.Register(ModuleName, 7, "Invalid Position")
+	ErrInvalidProposal   = sdkerrors.Register(ModuleName, 8, "Invalid Proposal")
+	ErrQueuedNotFound    = sdkerrors.Register(ModuleName, 9, "Queued proposal not found")
+	ErrInvalidOracle     = sdkerrors.Register(ModuleName, 10, "Invalid price oracle")
+	ErrInvalidIncentive  = sdkerrors.Register(ModuleName, 11, "Invalid incentive program")
 )
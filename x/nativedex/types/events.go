@@ -0,0 +1,34 @@
+package types
+
+const (
+	EventTypeSwap = "nativedex-swap"
+
+	SwapKeySender    = "sender"
+	SwapKeyPoolIdx   = "pool_idx"
+	SwapKeyAmountIn  = "amount_in"
+	SwapKeyTokenOut  = "token_out"
+	SwapKeyAmountOut = "amount_out"
+
+	EventTypeMintLiquidity = "nativedex-mint-liquidity"
+	EventTypeBurnLiquidity = "nativedex-burn-liquidity"
+	EventTypeHarvest       = "nativedex-harvest"
+
+	LiquidityKeyOwner     = "owner"
+	LiquidityKeyPoolIdx   = "pool_idx"
+	LiquidityKeyAmbient   = "ambient"
+	LiquidityKeyLowerTick = "lower_tick"
+	LiquidityKeyUpperTick = "upper_tick"
+	LiquidityKeyBaseFlow  = "base_flow"
+	LiquidityKeyQuoteFlow = "quote_flow"
+
+	EventTypeProposalQueued    = "nativedex-proposal-queued"
+	EventTypeProposalExecuted  = "nativedex-proposal-executed"
+	EventTypeProposalCancelled = "nativedex-proposal-cancelled"
+
+	TimelockKeyQueuedID      = "queued_id"
+	TimelockKeyProposalType  = "proposal_type"
+	TimelockKeyExecuteHeight = "execute_height"
+	TimelockKeySuccess       = "success"
+	TimelockKeyError         = "error"
+	TimelockKeyCanceller     = "canceller"
+)
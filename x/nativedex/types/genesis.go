@@ -6,6 +6,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -24,21 +25,113 @@ var (
 	ParamsStoreKeyVerifiedNativeDexAddress     = "VerifiedNativeDexAddress"
 	ParamsStoreKeyVerifiedCrocPolicyAddress    = "VerifiedCrocPolicyAddress"
 	ParamsStoreKeyWhitelistedContractAddresses = "WhitelistedContractAddresses"
+	ParamsStoreKeyVerifiedCrocQueryAddress     = "VerifiedCrocQueryAddress"
+	ParamsStoreKeyTimelockedProposalTypes      = "TimelockedProposalTypes"
+	ParamsStoreKeyTimelockBlocks               = "TimelockBlocks"
+	ParamsStoreKeyContractAllowedSelectors     = "ContractAllowedSelectors"
+	ParamsStoreKeyAutoCollectTokens            = "AutoCollectTokens"
+	ParamsStoreKeyAutoCollectInterval          = "AutoCollectInterval"
+	ParamsStoreKeyAutoCollectDestination       = "AutoCollectDestination"
+	ParamsStoreKeyOraclePools                  = "OraclePools"
+	ParamsStoreKeyOracleInterval               = "OracleInterval"
+	ParamsStoreKeyOracleMaxObservations        = "OracleMaxObservations"
+	ParamsStoreKeyIncentiveEpochBlocks         = "IncentiveEpochBlocks"
+	ParamsStoreKeyIncentivePositionsPerBlock   = "IncentivePositionsPerBlock"
+	ParamsStoreKeyIncentiveMinLiquidity        = "IncentiveMinLiquidity"
 )
 
+// AutoCollectDestinationCommunityPool is the AutoCollectDestination which funds the community pool
+const AutoCollectDestinationCommunityPool = "community_pool"
+
 // ValidateBasic validates genesis state by looping through the params and
 // calling their validation functions
 func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
+	if err := ValidatePositions(s.Positions); err != nil {
+		return errorsmod.Wrap(err, "positions")
+	}
+	ids := make(map[uint64]bool, len(s.QueuedProposals))
+	for _, q := range s.QueuedProposals {
+		if err := q.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "queued proposals")
+		}
+		if ids[q.Id] {
+			return errorsmod.Wrapf(ErrInvalidProposal, "duplicate queued proposal id %d", q.Id)
+		}
+		ids[q.Id] = true
+	}
+	pools := make(map[string]bool, len(s.OracleObservations))
+	for _, o := range s.OracleObservations {
+		if err := o.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "oracle observations")
+		}
+		key := string(GetPriceObservationsKey(common.HexToAddress(o.Pool.Base), common.HexToAddress(o.Pool.Quote), o.Pool.PoolIdx))
+		if pools[key] {
+			return errorsmod.Wrapf(ErrInvalidOracle, "duplicate observations for pool %s/%s/%d", o.Pool.Base, o.Pool.Quote, o.Pool.PoolIdx)
+		}
+		pools[key] = true
+	}
+	programs := make(map[uint64]bool, len(s.IncentivePrograms))
+	for _, program := range s.IncentivePrograms {
+		if err := program.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "incentive programs")
+		}
+		if programs[program.Id] {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "duplicate incentive program id %d", program.Id)
+		}
+		programs[program.Id] = true
+	}
+	owners := make(map[string]bool, len(s.IncentiveRewards))
+	for _, rewards := range s.IncentiveRewards {
+		if err := rewards.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "incentive rewards")
+		}
+		if owners[rewards.Owner] {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "duplicate incentive rewards for %s", rewards.Owner)
+		}
+		owners[rewards.Owner] = true
+	}
+	samples := make(map[string]bool, len(s.IncentiveSamples))
+	for _, sample := range s.IncentiveSamples {
+		if err := sample.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "incentive samples")
+		}
+		key := string(sample.Position.Key())
+		if samples[key] {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "duplicate incentive sample for a position of %s", sample.Position.Owner)
+		}
+		samples[key] = true
+	}
+	accruals := make(map[string]bool, len(s.IncentiveAccruals))
+	for _, accrual := range s.IncentiveAccruals {
+		if err := accrual.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "incentive accruals")
+		}
+		if !programs[accrual.ProgramId] {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "accrual for unknown incentive program %d", accrual.ProgramId)
+		}
+		key := string(GetIncentiveAccrualKey(accrual.ProgramId, sdk.MustAccAddressFromBech32(accrual.Owner)))
+		if accruals[key] {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "duplicate incentive accrual for %s in program %d", accrual.Owner, accrual.ProgramId)
+		}
+		accruals[key] = true
+	}
 	return nil
 }
 
 // DefaultGenesis returns empty genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: *DefaultParams(),
+		Params:             *DefaultParams(),
+		Positions:          []Position{},
+		QueuedProposals:    []QueuedProposal{},
+		OracleObservations: []OracleObservations{},
+		IncentivePrograms:  []IncentiveProgram{},
+		IncentiveRewards:   []IncentiveRewards{},
+		IncentiveSamples:   []IncentiveSample{},
+		IncentiveAccruals:  []IncentiveAccrual{},
 	}
 }
 
@@ -48,6 +141,19 @@ func DefaultParams() *Params {
 		VerifiedNativeDexAddress:     common.BytesToAddress([]byte{0x0}).String(),
 		VerifiedCrocPolicyAddress:    common.BytesToAddress([]byte{0x0}).String(),
 		WhitelistedContractAddresses: []string{},
+		VerifiedCrocQueryAddress:     common.BytesToAddress([]byte{0x0}).String(),
+		TimelockedProposalTypes:      []string{ProposalTypeUpgradeProxy, ProposalTypeAuthorityTransfer},
+		TimelockBlocks:               0, // The timelock is disabled until governance sets a delay
+		ContractAllowedSelectors:     []ContractSelectors{},
+		AutoCollectTokens:            []string{},
+		AutoCollectInterval:          0, // Automatic collection is disabled until governance sets an interval
+		AutoCollectDestination:       "",
+		OraclePools:                  []OraclePool{},
+		OracleInterval:               0, // The price oracle is disabled until governance selects its pools
+		OracleMaxObservations:        720,
+		IncentiveEpochBlocks:         0, // Incentive programs are paused until governance sets an epoch length
+		IncentivePositionsPerBlock:   50,
+		IncentiveMinLiquidity:        sdk.NewIntWithDecimal(1, 12),
 	}
 }
 
@@ -62,6 +168,45 @@ func (p Params) ValidateBasic() error {
 	if err := validateWhitelistedContractAddresses(p.WhitelistedContractAddresses); err != nil {
 		return errorsmod.Wrap(err, "WhitelistedContractAddresses")
 	}
+	if err := validateVerifiedCrocQueryAddress(p.VerifiedCrocQueryAddress); err != nil {
+		return errorsmod.Wrap(err, "VerifiedCrocQueryAddress")
+	}
+	if err := validateTimelockedProposalTypes(p.TimelockedProposalTypes); err != nil {
+		return errorsmod.Wrap(err, "TimelockedProposalTypes")
+	}
+	if err := validateTimelockBlocks(p.TimelockBlocks); err != nil {
+		return errorsmod.Wrap(err, "TimelockBlocks")
+	}
+	if err := validateContractAllowedSelectors(p.ContractAllowedSelectors); err != nil {
+		return errorsmod.Wrap(err, "ContractAllowedSelectors")
+	}
+	if err := validateAutoCollectTokens(p.AutoCollectTokens); err != nil {
+		return errorsmod.Wrap(err, "AutoCollectTokens")
+	}
+	if err := validateAutoCollectInterval(p.AutoCollectInterval); err != nil {
+		return errorsmod.Wrap(err, "AutoCollectInterval")
+	}
+	if err := validateAutoCollectDestination(p.AutoCollectDestination); err != nil {
+		return errorsmod.Wrap(err, "AutoCollectDestination")
+	}
+	if err := validateOraclePools(p.OraclePools); err != nil {
+		return errorsmod.Wrap(err, "OraclePools")
+	}
+	if err := validateOracleInterval(p.OracleInterval); err != nil {
+		return errorsmod.Wrap(err, "OracleInterval")
+	}
+	if err := validateOracleMaxObservations(p.OracleMaxObservations); err != nil {
+		return errorsmod.Wrap(err, "OracleMaxObservations")
+	}
+	if err := validateIncentiveEpochBlocks(p.IncentiveEpochBlocks); err != nil {
+		return errorsmod.Wrap(err, "IncentiveEpochBlocks")
+	}
+	if err := validateIncentivePositionsPerBlock(p.IncentivePositionsPerBlock); err != nil {
+		return errorsmod.Wrap(err, "IncentivePositionsPerBlock")
+	}
+	if err := validateIncentiveMinLiquidity(p.IncentiveMinLiquidity); err != nil {
+		return errorsmod.Wrap(err, "IncentiveMinLiquidity")
+	}
 	return nil
 }
 
@@ -77,6 +222,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyVerifiedNativeDexAddress), &p.VerifiedNativeDexAddress, validateVerifiedNativeDexAddress),
 		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyVerifiedCrocPolicyAddress), &p.VerifiedCrocPolicyAddress, validateVerifiedCrocPolicyAddress),
 		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyWhitelistedContractAddresses), &p.WhitelistedContractAddresses, validateWhitelistedContractAddresses),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyVerifiedCrocQueryAddress), &p.VerifiedCrocQueryAddress, validateVerifiedCrocQueryAddress),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyTimelockedProposalTypes), &p.TimelockedProposalTypes, validateTimelockedProposalTypes),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyTimelockBlocks), &p.TimelockBlocks, validateTimelockBlocks),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyContractAllowedSelectors), &p.ContractAllowedSelectors, validateContractAllowedSelectors),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyAutoCollectTokens), &p.AutoCollectTokens, validateAutoCollectTokens),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyAutoCollectInterval), &p.AutoCollectInterval, validateAutoCollectInterval),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyAutoCollectDestination), &p.AutoCollectDestination, validateAutoCollectDestination),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyOraclePools), &p.OraclePools, validateOraclePools),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyOracleInterval), &p.OracleInterval, validateOracleInterval),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyOracleMaxObservations), &p.OracleMaxObservations, validateOracleMaxObservations),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyIncentiveEpochBlocks), &p.IncentiveEpochBlocks, validateIncentiveEpochBlocks),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyIncentivePositionsPerBlock), &p.IncentivePositionsPerBlock, validateIncentivePositionsPerBlock),
+		paramtypes.NewParamSetPair([]byte(ParamsStoreKeyIncentiveMinLiquidity), &p.IncentiveMinLiquidity, validateIncentiveMinLiquidity),
 	}
 }
 
@@ -113,6 +271,23 @@ func validateVerifiedCrocPolicyAddress(i interface{}) error {
 	return nil
 }
 
+func validateVerifiedCrocQueryAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Empty is valid, pool state queries will fail until the CrocQuery address is set
+	if v == "" {
+		return nil
+	}
+	if !common.IsHexAddress(v) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid address")
+	}
+
+	return nil
+}
+
 func validateWhitelistedContractAddresses(i interface{}) error {
 	v, ok := i.([]string)
 	if !ok {
@@ -128,3 +303,179 @@ func validateWhitelistedContractAddresses(i interface{}) error {
 
 	return nil
 }
+
+func validateTimelockedProposalTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, t := range v {
+		valid := false
+		for _, timelockable := range TimelockableProposalTypes {
+			if t == timelockable {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return errorsmod.Wrapf(ErrInvalidProposal, "%s is not a timelockable proposal type", t)
+		}
+	}
+
+	return nil
+}
+
+func validateTimelockBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Any value is valid, 0 disables the timelock
+	return nil
+}
+
+func validateContractAllowedSelectors(i interface{}) error {
+	v, ok := i.([]ContractSelectors)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(v))
+	for _, entry := range v {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+		contract := common.HexToAddress(entry.ContractAddress)
+		if seen[contract] {
+			return errorsmod.Wrapf(ErrInvalidEvmAddress, "duplicate selectors for contract %s", entry.ContractAddress)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
+
+func validateAutoCollectTokens(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(v))
+	for _, token := range v {
+		if !common.IsHexAddress(token) {
+			return errorsmod.Wrapf(ErrInvalidEvmAddress, "invalid token address: %s", token)
+		}
+		if seen[common.HexToAddress(token)] {
+			return errorsmod.Wrapf(ErrInvalidEvmAddress, "duplicate token address: %s", token)
+		}
+		seen[common.HexToAddress(token)] = true
+	}
+
+	return nil
+}
+
+func validateAutoCollectInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Any value is valid, 0 disables automatic collection
+	return nil
+}
+
+func validateAutoCollectDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Empty leaves the collected fees with the module
+	if v == "" || v == AutoCollectDestinationCommunityPool {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return errorsmod.Wrapf(err, "destination must be %s or an account address", AutoCollectDestinationCommunityPool)
+	}
+
+	return nil
+}
+
+func validateOraclePools(i interface{}) error {
+	v, ok := i.([]OraclePool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, pool := range v {
+		if err := pool.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(GetPriceObservationsKey(common.HexToAddress(pool.Base), common.HexToAddress(pool.Quote), pool.PoolIdx))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidOracle, "duplicate oracle pool %s/%s/%d", pool.Base, pool.Quote, pool.PoolIdx)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateOracleInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Any value is valid, 0 disables the price oracle
+	return nil
+}
+
+func validateOracleMaxObservations(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxOracleObservations {
+		return errorsmod.Wrapf(ErrInvalidOracle, "max observations must be between 1 and %d", MaxOracleObservations)
+	}
+
+	return nil
+}
+
+func validateIncentiveEpochBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Any value is valid, 0 pauses the incentive programs
+	return nil
+}
+
+func validateIncentivePositionsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxIncentivePositionsPerBlock {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "positions per block must be between 1 and %d", MaxIncentivePositionsPerBlock)
+	}
+
+	return nil
+}
+
+func validateIncentiveMinLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidIncentive, "min liquidity must not be negative")
+	}
+
+	return nil
+}
@@ -5,17 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +34,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the nativedex module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// positions indexes the DEX liquidity positions opened via the nativedex Msgs or registered for incentives
+	Positions []Position `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions"`
+	// queued_proposals are the passed proposals waiting for their timelock to expire
+	QueuedProposals []QueuedProposal `protobuf:"bytes,3,rep,name=queued_proposals,json=queuedProposals,proto3" json:"queued_proposals"`
+	// oracle_observations are the recorded spot prices of the price oracle's pools
+	OracleObservations []OracleObservations `protobuf:"bytes,4,rep,name=oracle_observations,json=oracleObservations,proto3" json:"oracle_observations"`
+	// incentive_programs are the liquidity mining programs which have epochs left to pay
+	IncentivePrograms []IncentiveProgram `protobuf:"bytes,5,rep,name=incentive_programs,json=incentivePrograms,proto3" json:"incentive_programs"`
+	// incentive_rewards are the liquidity mining rewards paid to each account and not yet claimed
+	IncentiveRewards []IncentiveRewards `protobuf:"bytes,6,rep,name=incentive_rewards,json=incentiveRewards,proto3" json:"incentive_rewards"`
+	// incentive_samples are the eligible liquidity of each position when it was last sampled for the incentive programs
+	IncentiveSamples []IncentiveSample `protobuf:"bytes,7,rep,name=incentive_samples,json=incentiveSamples,proto3" json:"incentive_samples"`
+	// incentive_accruals are the liquidity-blocks each account has earned in the current epoch of each incentive program
+	IncentiveAccruals []IncentiveAccrual `protobuf:"bytes,8,rep,name=incentive_accruals,json=incentiveAccruals,proto3" json:"incentive_accruals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,18 +90,258 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedProposals() []QueuedProposal {
+	if m != nil {
+		return m.QueuedProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleObservations() []OracleObservations {
+	if m != nil {
+		return m.OracleObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentivePrograms() []IncentiveProgram {
+	if m != nil {
+		return m.IncentivePrograms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveRewards() []IncentiveRewards {
+	if m != nil {
+		return m.IncentiveRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveSamples() []IncentiveSample {
+	if m != nil {
+		return m.IncentiveSamples
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveAccruals() []IncentiveAccrual {
+	if m != nil {
+		return m.IncentiveAccruals
+	}
+	return nil
+}
+
+// QueuedProposal is a passed nativedex proposal of a timelocked type, which is executed by the EndBlocker once the
+// chain reaches execute_height unless it is cancelled first
+type QueuedProposal struct {
+	Id            uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       *types.Any `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	QueuedHeight  uint64     `protobuf:"varint,3,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty"`
+	ExecuteHeight uint64     `protobuf:"varint,4,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+}
+
+func (m *QueuedProposal) Reset()         { *m = QueuedProposal{} }
+func (m *QueuedProposal) String() string { return proto.CompactTextString(m) }
+func (*QueuedProposal) ProtoMessage()    {}
+func (*QueuedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{1}
+}
+func (m *QueuedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedProposal.Merge(m, src)
+}
+func (m *QueuedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedProposal proto.InternalMessageInfo
+
+func (m *QueuedProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedProposal) GetContent() *types.Any {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *QueuedProposal) GetQueuedHeight() uint64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
+func (m *QueuedProposal) GetExecuteHeight() uint64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+// Position identifies a DEX liquidity position opened via MsgMintAmbientLiquidity or MsgMintRangeLiquidity, or opened
+// through the EVM and registered with MsgRegisterIncentivePosition.
+// The DEX holds the position's state, this only records that the owner has a position to look up.
+type Position struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Base      string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote     string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx   uint64 `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	Ambient   bool   `protobuf:"varint,5,opt,name=ambient,proto3" json:"ambient,omitempty"`
+	LowerTick int32  `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick int32  `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{2}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *Position) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *Position) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *Position) GetAmbient() bool {
+	if m != nil {
+		return m.Ambient
+	}
+	return false
+}
+
+func (m *Position) GetLowerTick() int32 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int32 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	VerifiedNativeDexAddress     string   `protobuf:"bytes,1,opt,name=verified_native_dex_address,json=verifiedNativeDexAddress,proto3" json:"verified_native_dex_address,omitempty"`
 	VerifiedCrocPolicyAddress    string   `protobuf:"bytes,2,opt,name=verified_croc_policy_address,json=verifiedCrocPolicyAddress,proto3" json:"verified_croc_policy_address,omitempty"`
 	WhitelistedContractAddresses []string `protobuf:"bytes,3,rep,name=whitelisted_contract_addresses,json=whitelistedContractAddresses,proto3" json:"whitelisted_contract_addresses,omitempty"`
+	VerifiedCrocQueryAddress     string   `protobuf:"bytes,4,opt,name=verified_croc_query_address,json=verifiedCrocQueryAddress,proto3" json:"verified_croc_query_address,omitempty"`
+	// The proposal types (e.g. "UpgradeProxy") which are queued for timelock_blocks after passing instead of executing
+	// immediately. A BatchDex proposal is timelocked if any of its actions is of a timelocked type
+	TimelockedProposalTypes []string `protobuf:"bytes,5,rep,name=timelocked_proposal_types,json=timelockedProposalTypes,proto3" json:"timelocked_proposal_types,omitempty"`
+	TimelockBlocks          uint64   `protobuf:"varint,6,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+	// Restrictions on the functions ExecuteContractProposal may call on whitelisted contracts, a whitelisted contract
+	// without an entry may be called with any data
+	ContractAllowedSelectors []ContractSelectors `protobuf:"bytes,7,rep,name=contract_allowed_selectors,json=contractAllowedSelectors,proto3" json:"contract_allowed_selectors"`
+	// The tokens (0x0 for the native token) whose protocol fees the EndBlocker collects into the DEX treasury every
+	// auto_collect_interval blocks
+	AutoCollectTokens   []string `protobuf:"bytes,8,rep,name=auto_collect_tokens,json=autoCollectTokens,proto3" json:"auto_collect_tokens,omitempty"`
+	AutoCollectInterval uint64   `protobuf:"varint,9,opt,name=auto_collect_interval,json=autoCollectInterval,proto3" json:"auto_collect_interval,omitempty"`
+	// Where automatically collected fees are sent when the nativedex module is the DEX treasury: "community_pool", a
+	// bech32 account address, or empty to leave them with the module
+	AutoCollectDestination string `protobuf:"bytes,10,opt,name=auto_collect_destination,json=autoCollectDestination,proto3" json:"auto_collect_destination,omitempty"`
+	// The pools whose spot price the price oracle records every oracle_interval blocks
+	OraclePools           []OraclePool `protobuf:"bytes,11,rep,name=oracle_pools,json=oraclePools,proto3" json:"oracle_pools"`
+	OracleInterval        uint64       `protobuf:"varint,12,opt,name=oracle_interval,json=oracleInterval,proto3" json:"oracle_interval,omitempty"`
+	OracleMaxObservations uint64       `protobuf:"varint,13,opt,name=oracle_max_observations,json=oracleMaxObservations,proto3" json:"oracle_max_observations,omitempty"`
+	// The number of blocks between the epochs of the liquidity incentive programs, 0 pauses every program
+	IncentiveEpochBlocks uint64 `protobuf:"varint,14,opt,name=incentive_epoch_blocks,json=incentiveEpochBlocks,proto3" json:"incentive_epoch_blocks,omitempty"`
+	// The number of positions the EndBlocker samples for the incentive programs each block, positions are sampled in
+	// turn so every position is sampled once every (positions / incentive_positions_per_block) blocks
+	IncentivePositionsPerBlock uint64 `protobuf:"varint,15,opt,name=incentive_positions_per_block,json=incentivePositionsPerBlock,proto3" json:"incentive_positions_per_block,omitempty"`
+	// The smallest position liquidity which earns incentive rewards, smaller positions are sampled as having none
+	IncentiveMinLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=incentive_min_liquidity,json=incentiveMinLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incentive_min_liquidity"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2b87d0ec84a0fc5, []int{1}
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,168 +391,3858 @@ func (m *Params) GetWhitelistedContractAddresses() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "althea.nativedex.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "althea.nativedex.v1.Params")
+func (m *Params) GetVerifiedCrocQueryAddress() string {
+	if m != nil {
+		return m.VerifiedCrocQueryAddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("althea/nativedex/v1/genesis.proto", fileDescriptor_c2b87d0ec84a0fc5) }
+func (m *Params) GetTimelockedProposalTypes() []string {
+	if m != nil {
+		return m.TimelockedProposalTypes
+	}
+	return nil
+}
 
-var fileDescriptor_c2b87d0ec84a0fc5 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x3f, 0x4f, 0x02, 0x31,
-	0x18, 0x06, 0xf0, 0x3b, 0x31, 0x24, 0x54, 0xa7, 0xd3, 0x01, 0x85, 0x54, 0x64, 0x62, 0xf1, 0x1a,
-	0x74, 0x30, 0x0e, 0xc6, 0xf0, 0x27, 0x1a, 0x13, 0x63, 0x10, 0x37, 0x97, 0x4b, 0xb9, 0xbe, 0x1e,
-	0x4d, 0xe0, 0x7a, 0x69, 0xcb, 0x79, 0x7c, 0x0b, 0x3f, 0x16, 0x93, 0x61, 0x74, 0x32, 0x06, 0xbe,
-	0x88, 0xa1, 0xe5, 0x2e, 0x0c, 0x6e, 0x4d, 0xde, 0xdf, 0xf3, 0x0c, 0x4f, 0xd1, 0x39, 0x9d, 0xe8,
-	0x31, 0x50, 0x12, 0x53, 0xcd, 0x53, 0x60, 0x90, 0x91, 0xb4, 0x4d, 0x22, 0x88, 0x41, 0x71, 0xe5,
-	0x27, 0x52, 0x68, 0xe1, 0x1d, 0x59, 0xe2, 0x17, 0xc4, 0x4f, 0xdb, 0xa7, 0xc7, 0x91, 0x88, 0x84,
-	0xb9, 0x93, 0xcd, 0xcb, 0xd2, 0xe6, 0x23, 0x3a, 0x7c, 0xb0, 0xd9, 0x57, 0x4d, 0x35, 0x78, 0x37,
-	0xa8, 0x9c, 0x50, 0x49, 0xa7, 0xaa, 0xea, 0x36, 0xdc, 0xd6, 0xc1, 0x65, 0xcd, 0xff, 0xa7, 0xcb,
-	0x1f, 0x18, 0xd2, 0xdd, 0x5f, 0xfc, 0x9c, 0x39, 0xc3, 0x6d, 0xa0, 0xf9, 0xe5, 0xa2, 0xb2, 0x3d,
-	0x78, 0xb7, 0xa8, 0x96, 0x82, 0xe4, 0xef, 0x1c, 0x58, 0x60, 0x83, 0x01, 0x83, 0x2c, 0xa0, 0x8c,
-	0x49, 0x50, 0xb6, 0xba, 0x32, 0xac, 0xe6, 0xe4, 0xd9, 0x88, 0x3e, 0x64, 0x1d, 0x7b, 0xf7, 0xee,
-	0x50, 0xbd, 0x88, 0x87, 0x52, 0x84, 0x41, 0x22, 0x26, 0x3c, 0x9c, 0x17, 0xf9, 0x3d, 0x93, 0x3f,
-	0xc9, 0x4d, 0x4f, 0x8a, 0x70, 0x60, 0x44, 0x5e, 0xd0, 0x47, 0xf8, 0x63, 0xcc, 0x35, 0x4c, 0xb8,
-	0xd2, 0x9b, 0x0e, 0x11, 0x6b, 0x49, 0x43, 0x9d, 0x17, 0x80, 0xaa, 0x96, 0x1a, 0xa5, 0x56, 0x65,
-	0x58, 0xdf, 0x51, 0xbd, 0x2d, 0xea, 0xe4, 0xa6, 0xfb, 0xb2, 0x58, 0x61, 0x77, 0xb9, 0xc2, 0xee,
-	0xef, 0x0a, 0xbb, 0x9f, 0x6b, 0xec, 0x2c, 0xd7, 0xd8, 0xf9, 0x5e, 0x63, 0xe7, 0xed, 0x3a, 0xe2,
-	0x7a, 0x3c, 0x1b, 0xf9, 0xa1, 0x98, 0x92, 0x8e, 0xd9, 0xe7, 0x5e, 0xcc, 0x62, 0x46, 0x35, 0x17,
-	0x31, 0xb1, 0x83, 0x5d, 0x3c, 0xb5, 0x49, 0xb6, 0xf3, 0x49, 0x7a, 0x9e, 0x80, 0x1a, 0x95, 0xcd,
-	0xea, 0x57, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xdb, 0xe1, 0xa6, 0xc5, 0x01, 0x00, 0x00,
+func (m *Params) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetContractAllowedSelectors() []ContractSelectors {
+	if m != nil {
+		return m.ContractAllowedSelectors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetAutoCollectTokens() []string {
+	if m != nil {
+		return m.AutoCollectTokens
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *Params) GetAutoCollectInterval() uint64 {
+	if m != nil {
+		return m.AutoCollectInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCollectDestination() string {
+	if m != nil {
+		return m.AutoCollectDestination
+	}
+	return ""
+}
+
+func (m *Params) GetOraclePools() []OraclePool {
+	if m != nil {
+		return m.OraclePools
+	}
+	return nil
+}
+
+func (m *Params) GetOracleInterval() uint64 {
+	if m != nil {
+		return m.OracleInterval
+	}
+	return 0
+}
+
+func (m *Params) GetOracleMaxObservations() uint64 {
+	if m != nil {
+		return m.OracleMaxObservations
+	}
+	return 0
+}
+
+func (m *Params) GetIncentiveEpochBlocks() uint64 {
+	if m != nil {
+		return m.IncentiveEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetIncentivePositionsPerBlock() uint64 {
+	if m != nil {
+		return m.IncentivePositionsPerBlock
+	}
+	return 0
+}
+
+// OraclePool identifies a DEX pool tracked by the price oracle
+type OraclePool struct {
+	Base    string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote   string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx uint64 `protobuf:"varint,3,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+}
+
+func (m *OraclePool) Reset()         { *m = OraclePool{} }
+func (m *OraclePool) String() string { return proto.CompactTextString(m) }
+func (*OraclePool) ProtoMessage()    {}
+func (*OraclePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{4}
+}
+func (m *OraclePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *OraclePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePool.Merge(m, src)
+}
+func (m *OraclePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePool.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OraclePool proto.InternalMessageInfo
+
+func (m *OraclePool) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OraclePool) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WhitelistedContractAddresses) > 0 {
-		for iNdEx := len(m.WhitelistedContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistedContractAddresses[iNdEx])
-			copy(dAtA[i:], m.WhitelistedContractAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistedContractAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *OraclePool) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+// PriceObservation is the spot price of an oracle pool recorded at the end of a block
+type PriceObservation struct {
+	Height uint64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// price is the value of one quote token base unit in base token base units
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{5}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// OracleObservations holds the recorded observations of an oracle pool, oldest first
+type OracleObservations struct {
+	Pool         OraclePool         `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	Observations []PriceObservation `protobuf:"bytes,2,rep,name=observations,proto3" json:"observations"`
+}
+
+func (m *OracleObservations) Reset()         { *m = OracleObservations{} }
+func (m *OracleObservations) String() string { return proto.CompactTextString(m) }
+func (*OracleObservations) ProtoMessage()    {}
+func (*OracleObservations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{6}
+}
+func (m *OracleObservations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleObservations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleObservations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleObservations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleObservations.Merge(m, src)
+}
+func (m *OracleObservations) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleObservations) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleObservations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleObservations proto.InternalMessageInfo
+
+func (m *OracleObservations) GetPool() OraclePool {
+	if m != nil {
+		return m.Pool
+	}
+	return OraclePool{}
+}
+
+func (m *OracleObservations) GetObservations() []PriceObservation {
+	if m != nil {
+		return m.Observations
+	}
+	return nil
+}
+
+// ContractSelectors lists the function selectors a whitelisted contract may be called with
+type ContractSelectors struct {
+	ContractAddress string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Selectors       []string `protobuf:"bytes,2,rep,name=selectors,proto3" json:"selectors,omitempty"`
+}
+
+func (m *ContractSelectors) Reset()         { *m = ContractSelectors{} }
+func (m *ContractSelectors) String() string { return proto.CompactTextString(m) }
+func (*ContractSelectors) ProtoMessage()    {}
+func (*ContractSelectors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{7}
+}
+func (m *ContractSelectors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSelectors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSelectors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSelectors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSelectors.Merge(m, src)
+}
+func (m *ContractSelectors) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSelectors) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSelectors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSelectors proto.InternalMessageInfo
+
+func (m *ContractSelectors) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractSelectors) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
+// IncentiveProgram is a governance funded liquidity mining program, which pays reward_per_epoch to the liquidity
+// providers of a DEX pool at the end of every epoch until it has paid for epochs epochs. Rewards are split pro rata by
+// time weighted liquidity: each account earns its sampled in range or ambient liquidity for every block between
+// samples, out of the pool's total active liquidity for every block of the epoch. Only positions known to the module
+// (see Position) can earn, the share of any other liquidity is returned to the community pool when the program ends
+type IncentiveProgram struct {
+	Id             uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base           string      `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote          string      `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx        uint64      `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	RewardPerEpoch types1.Coin `protobuf:"bytes,5,opt,name=reward_per_epoch,json=rewardPerEpoch,proto3" json:"reward_per_epoch"`
+	Epochs         uint64      `protobuf:"varint,6,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	EpochsPaid     uint64      `protobuf:"varint,7,opt,name=epochs_paid,json=epochsPaid,proto3" json:"epochs_paid,omitempty"`
+	// the funds held by the module for the program's remaining epochs, anything left once the program ends is
+	// returned to the community pool
+	Remaining     types1.Coin `protobuf:"bytes,8,opt,name=remaining,proto3" json:"remaining"`
+	CreatedHeight uint64      `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// the pool's active liquidity summed over every block of the current epoch, which the epoch reward is split against
+	PoolLiquidityBlocks github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=pool_liquidity_blocks,json=poolLiquidityBlocks,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_liquidity_blocks"`
+}
+
+func (m *IncentiveProgram) Reset()         { *m = IncentiveProgram{} }
+func (m *IncentiveProgram) String() string { return proto.CompactTextString(m) }
+func (*IncentiveProgram) ProtoMessage()    {}
+func (*IncentiveProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{8}
+}
+func (m *IncentiveProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveProgram.Merge(m, src)
+}
+func (m *IncentiveProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveProgram proto.InternalMessageInfo
+
+func (m *IncentiveProgram) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *IncentiveProgram) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *IncentiveProgram) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetRewardPerEpoch() types1.Coin {
+	if m != nil {
+		return m.RewardPerEpoch
+	}
+	return types1.Coin{}
+}
+
+func (m *IncentiveProgram) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetEpochsPaid() uint64 {
+	if m != nil {
+		return m.EpochsPaid
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetRemaining() types1.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types1.Coin{}
+}
+
+func (m *IncentiveProgram) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+// IncentiveSample is the liquidity a position could earn incentive rewards with when it was last sampled: zero if it
+// was out of range or below the incentive_min_liquidity param. Between two samples a position earns the smaller of
+// their liquidity for each block, so liquidity added just before a sample or removed just after one earns nothing
+type IncentiveSample struct {
+	Position  Position                               `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	Height    uint64                                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+}
+
+func (m *IncentiveSample) Reset()         { *m = IncentiveSample{} }
+func (m *IncentiveSample) String() string { return proto.CompactTextString(m) }
+func (*IncentiveSample) ProtoMessage()    {}
+func (*IncentiveSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{9}
+}
+func (m *IncentiveSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveSample.Merge(m, src)
+}
+func (m *IncentiveSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveSample proto.InternalMessageInfo
+
+func (m *IncentiveSample) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position{}
+}
+
+func (m *IncentiveSample) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// IncentiveAccrual is the time weighted liquidity an account has earned in the current epoch of an incentive program
+type IncentiveAccrual struct {
+	ProgramId uint64 `protobuf:"varint,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the sum over the owner's sampled positions of their liquidity times the blocks between samples
+	LiquidityBlocks github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=liquidity_blocks,json=liquidityBlocks,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity_blocks"`
+}
+
+func (m *IncentiveAccrual) Reset()         { *m = IncentiveAccrual{} }
+func (m *IncentiveAccrual) String() string { return proto.CompactTextString(m) }
+func (*IncentiveAccrual) ProtoMessage()    {}
+func (*IncentiveAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{10}
+}
+func (m *IncentiveAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveAccrual.Merge(m, src)
+}
+func (m *IncentiveAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveAccrual proto.InternalMessageInfo
+
+func (m *IncentiveAccrual) GetProgramId() uint64 {
+	if m != nil {
+		return m.ProgramId
+	}
+	return 0
+}
+
+func (m *IncentiveAccrual) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// IncentiveRewards are the unclaimed liquidity mining rewards of an account
+type IncentiveRewards struct {
+	Owner   string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *IncentiveRewards) Reset()         { *m = IncentiveRewards{} }
+func (m *IncentiveRewards) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRewards) ProtoMessage()    {}
+func (*IncentiveRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b87d0ec84a0fc5, []int{11}
+}
+func (m *IncentiveRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRewards.Merge(m, src)
+}
+func (m *IncentiveRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRewards proto.InternalMessageInfo
+
+func (m *IncentiveRewards) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *IncentiveRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "althea.nativedex.v1.GenesisState")
+	proto.RegisterType((*QueuedProposal)(nil), "althea.nativedex.v1.QueuedProposal")
+	proto.RegisterType((*Position)(nil), "althea.nativedex.v1.Position")
+	proto.RegisterType((*Params)(nil), "althea.nativedex.v1.Params")
+	proto.RegisterType((*OraclePool)(nil), "althea.nativedex.v1.OraclePool")
+	proto.RegisterType((*PriceObservation)(nil), "althea.nativedex.v1.PriceObservation")
+	proto.RegisterType((*OracleObservations)(nil), "althea.nativedex.v1.OracleObservations")
+	proto.RegisterType((*ContractSelectors)(nil), "althea.nativedex.v1.ContractSelectors")
+	proto.RegisterType((*IncentiveProgram)(nil), "althea.nativedex.v1.IncentiveProgram")
+	proto.RegisterType((*IncentiveSample)(nil), "althea.nativedex.v1.IncentiveSample")
+	proto.RegisterType((*IncentiveAccrual)(nil), "althea.nativedex.v1.IncentiveAccrual")
+	proto.RegisterType((*IncentiveRewards)(nil), "althea.nativedex.v1.IncentiveRewards")
+}
+
+func init() { proto.RegisterFile("althea/nativedex/v1/genesis.proto", fileDescriptor_c2b87d0ec84a0fc5) }
+
+var fileDescriptor_c2b87d0ec84a0fc5 = []byte{
+	// 1474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xf6, 0xac, 0xd7, 0x8f, 0x2d, 0xbf, 0x36, 0x6d, 0x3b, 0x19, 0x3b, 0xc9, 0xda, 0x6c, 0x48,
+	0x62, 0x0e, 0x99, 0xc5, 0x06, 0x41, 0x82, 0x14, 0x45, 0x6b, 0x1b, 0x88, 0xa5, 0x3c, 0xd6, 0x13,
+	0x4b, 0x40, 0x84, 0x18, 0xcd, 0xce, 0x74, 0xd6, 0x8d, 0x67, 0xa7, 0xc7, 0x33, 0xbd, 0xf6, 0xfa,
+	0x1f, 0x70, 0x23, 0x3f, 0x01, 0x71, 0x8c, 0xc4, 0x0d, 0x24, 0x0e, 0x70, 0x8f, 0x38, 0xe5, 0x88,
+	0x38, 0x24, 0x28, 0xf9, 0x23, 0xa8, 0x5f, 0x33, 0xb3, 0x0f, 0x2c, 0x13, 0x71, 0x49, 0xb6, 0xab,
+	0xbe, 0xfa, 0xa6, 0xba, 0xba, 0xeb, 0xeb, 0x32, 0xbc, 0xe3, 0x06, 0x6c, 0x1f, 0xbb, 0xb5, 0xd0,
+	0x65, 0xe4, 0x08, 0xfb, 0xb8, 0x5b, 0x3b, 0x5a, 0xaf, 0xb5, 0x70, 0x88, 0x13, 0x92, 0x58, 0x51,
+	0x4c, 0x19, 0x45, 0xf3, 0x12, 0x62, 0xa5, 0x10, 0xeb, 0x68, 0x7d, 0x79, 0xa1, 0x45, 0x5b, 0x54,
+	0xf8, 0x6b, 0xfc, 0x97, 0x84, 0x2e, 0x2f, 0xb5, 0x28, 0x6d, 0x05, 0xb8, 0x26, 0x56, 0xcd, 0xce,
+	0x93, 0x9a, 0x1b, 0x9e, 0x28, 0xd7, 0x4a, 0xbf, 0x8b, 0x91, 0x36, 0x4e, 0x98, 0xdb, 0x8e, 0x74,
+	0xac, 0x47, 0x93, 0x36, 0x4d, 0x1c, 0x49, 0x2a, 0x17, 0xca, 0x55, 0x91, 0xab, 0x5a, 0xd3, 0x4d,
+	0x70, 0xed, 0x68, 0xbd, 0x89, 0x99, 0xbb, 0x5e, 0xf3, 0x28, 0x09, 0xa5, 0xbf, 0xfa, 0xd3, 0x18,
+	0x4c, 0x7f, 0x2e, 0x73, 0x7e, 0xc4, 0x5c, 0x86, 0xd1, 0x2d, 0x18, 0x8f, 0xdc, 0xd8, 0x6d, 0x27,
+	0xa6, 0xb1, 0x6a, 0xac, 0x4d, 0x6d, 0x5c, 0xb4, 0x86, 0xec, 0xc1, 0x6a, 0x08, 0xc8, 0x66, 0xf1,
+	0xf9, 0xcb, 0x95, 0x11, 0x5b, 0x05, 0xa0, 0x3a, 0x94, 0x22, 0x9a, 0x10, 0x46, 0x68, 0x98, 0x98,
+	0x85, 0xd5, 0xd1, 0xb5, 0xa9, 0x8d, 0xcb, 0xc3, 0xa3, 0x15, 0x4a, 0xc5, 0x67, 0x51, 0x68, 0x0f,
+	0xca, 0x87, 0x1d, 0xdc, 0xc1, 0x3e, 0xdf, 0x4b, 0x44, 0x13, 0x37, 0x48, 0xcc, 0x51, 0xc1, 0x74,
+	0x65, 0x28, 0xd3, 0xae, 0x00, 0x37, 0x14, 0x56, 0xf1, 0xcd, 0x1d, 0xf6, 0x58, 0x13, 0xf4, 0x0d,
+	0xcc, 0xd3, 0xd8, 0xf5, 0x02, 0xec, 0xd0, 0x66, 0x82, 0xe3, 0x23, 0x57, 0xa6, 0x58, 0x14, 0xc4,
+	0xd7, 0x87, 0x12, 0x3f, 0x14, 0xf8, 0x87, 0x39, 0xb8, 0x22, 0x47, 0x74, 0xc0, 0x83, 0x1e, 0x03,
+	0x22, 0xa1, 0x87, 0x43, 0x1e, 0xcf, 0x13, 0x6f, 0x89, 0xfa, 0x8d, 0x09, 0xfa, 0xab, 0x43, 0xe9,
+	0x77, 0x34, 0xbc, 0x21, 0xd1, 0x8a, 0xfc, 0x1c, 0xe9, 0xb3, 0x27, 0xe8, 0x4b, 0xc8, 0x8c, 0x4e,
+	0x8c, 0x8f, 0xdd, 0xd8, 0x4f, 0xcc, 0xf1, 0xb3, 0x50, 0xdb, 0x12, 0xac, 0xa8, 0xcb, 0xa4, 0xcf,
+	0x8e, 0xbe, 0xc8, 0x33, 0x27, 0x6e, 0x3b, 0x0a, 0x70, 0x62, 0x4e, 0x08, 0xe6, 0x77, 0x4f, 0x67,
+	0x7e, 0x24, 0xc0, 0x03, 0xc4, 0xd2, 0xdc, 0x57, 0x0e, 0xd7, 0xf3, 0xe2, 0x0e, 0x3f, 0xc6, 0xc9,
+	0xb3, 0xe4, 0x5c, 0x97, 0xe8, 0x81, 0x72, 0x28, 0x7b, 0x52, 0xfd, 0xcd, 0x80, 0xd9, 0xde, 0x43,
+	0x47, 0xb3, 0x50, 0x20, 0xbe, 0xb8, 0xad, 0x45, 0xbb, 0x40, 0x7c, 0xf4, 0x00, 0x26, 0x3c, 0x1a,
+	0x32, 0x1c, 0x32, 0xb3, 0x20, 0xae, 0xf0, 0x82, 0x25, 0x1b, 0xc8, 0xd2, 0x0d, 0x64, 0xd5, 0xc3,
+	0x93, 0xcd, 0xca, 0x1f, 0x3f, 0xdf, 0x58, 0x56, 0xbd, 0xd2, 0xa2, 0x47, 0x96, 0x6a, 0x0e, 0x6b,
+	0x4b, 0xc6, 0xda, 0x9a, 0x04, 0x5d, 0x81, 0x19, 0x75, 0x27, 0xf7, 0x31, 0x69, 0xed, 0x33, 0x73,
+	0x54, 0x7c, 0x6a, 0x5a, 0x1a, 0xef, 0x0a, 0x1b, 0xba, 0x0a, 0xb3, 0xb8, 0x8b, 0xbd, 0x0e, 0xc3,
+	0x1a, 0x55, 0x14, 0xa8, 0x19, 0x65, 0x95, 0xb0, 0xea, 0xef, 0x06, 0x4c, 0xea, 0xdb, 0x8f, 0x16,
+	0x60, 0x8c, 0x1e, 0x87, 0x38, 0x16, 0xb9, 0x97, 0x6c, 0xb9, 0x40, 0x08, 0x8a, 0xbc, 0x59, 0x45,
+	0xee, 0x25, 0x5b, 0xfc, 0xe6, 0xc8, 0xc3, 0x0e, 0x65, 0x58, 0x7c, 0xba, 0x64, 0xcb, 0x05, 0x5a,
+	0x82, 0xc9, 0x88, 0xd2, 0xc0, 0x21, 0x7e, 0x57, 0x7d, 0x6d, 0x82, 0xaf, 0x77, 0xfc, 0x2e, 0x32,
+	0x61, 0xc2, 0x6d, 0x37, 0x09, 0xaf, 0xc1, 0xd8, 0xaa, 0xb1, 0x36, 0x69, 0xeb, 0x25, 0xba, 0x0c,
+	0x10, 0xd0, 0x63, 0x1c, 0x3b, 0x8c, 0x78, 0x07, 0xe6, 0xf8, 0xaa, 0xb1, 0x36, 0x66, 0x97, 0x84,
+	0x65, 0x8f, 0x78, 0x07, 0xdc, 0xdd, 0x89, 0x22, 0xed, 0x9e, 0x90, 0x6e, 0x61, 0xe1, 0xee, 0xea,
+	0x77, 0x93, 0x30, 0x2e, 0x7b, 0x1f, 0xdd, 0x86, 0x8b, 0x47, 0x38, 0x26, 0x4f, 0x08, 0xf6, 0x1d,
+	0x79, 0x98, 0x8e, 0x8f, 0xbb, 0x8e, 0xeb, 0xfb, 0x31, 0x4e, 0x12, 0xb5, 0x27, 0x53, 0x43, 0x1e,
+	0x08, 0xc4, 0x36, 0xee, 0xd6, 0xa5, 0x1f, 0xdd, 0x81, 0x4b, 0x69, 0xb8, 0x17, 0x53, 0xcf, 0x89,
+	0x68, 0x40, 0xbc, 0x93, 0x34, 0x5e, 0x6e, 0x7f, 0x49, 0x63, 0xb6, 0x62, 0xea, 0x35, 0x04, 0x42,
+	0x13, 0x6c, 0x43, 0xe5, 0x78, 0x9f, 0x30, 0x1c, 0x90, 0x84, 0x71, 0x0e, 0x1a, 0xb2, 0xd8, 0xf5,
+	0x98, 0x26, 0xc0, 0x52, 0x38, 0x4a, 0xf6, 0xa5, 0x1c, 0x6a, 0x4b, 0x81, 0xea, 0x1a, 0xd3, 0xb3,
+	0x0b, 0x91, 0xc6, 0x61, 0x07, 0xc7, 0x59, 0x16, 0xc5, 0xde, 0x5d, 0xf0, 0x2c, 0x76, 0x39, 0x40,
+	0x27, 0xf1, 0x09, 0x2c, 0x71, 0x31, 0x0e, 0xa8, 0x77, 0x90, 0xd3, 0x2c, 0x87, 0x9d, 0x44, 0x58,
+	0x0a, 0x40, 0xc9, 0xbe, 0x90, 0x01, 0xf4, 0x95, 0xdd, 0xe3, 0x6e, 0x74, 0x1d, 0xe6, 0xb4, 0xcb,
+	0x69, 0xf2, 0x7f, 0x13, 0x71, 0x1c, 0x45, 0x7b, 0x56, 0x9b, 0x37, 0x85, 0x15, 0x7d, 0x0b, 0xcb,
+	0xd9, 0xee, 0x02, 0x7e, 0x56, 0xbe, 0x93, 0xe0, 0x00, 0x7b, 0x8c, 0xc6, 0xba, 0x63, 0xaf, 0x0d,
+	0xed, 0x2b, 0xbd, 0xdf, 0x47, 0x1a, 0xad, 0x1a, 0xcb, 0xd4, 0x7c, 0x75, 0x49, 0x97, 0xfa, 0x91,
+	0x05, 0xf3, 0x6e, 0x87, 0x51, 0xc7, 0xa3, 0x01, 0x37, 0x39, 0x8c, 0x1e, 0xe0, 0x50, 0x36, 0x6f,
+	0xc9, 0x3e, 0xc7, 0x5d, 0x5b, 0xd2, 0xb3, 0x27, 0x1c, 0x68, 0x03, 0x16, 0x7b, 0xf0, 0x24, 0x64,
+	0x5c, 0x17, 0x03, 0xb3, 0x24, 0xb6, 0x32, 0x9f, 0x8b, 0xd8, 0x51, 0x2e, 0x74, 0x13, 0xcc, 0x9e,
+	0x18, 0x1f, 0x27, 0x8c, 0x84, 0x42, 0x4b, 0x4d, 0x10, 0x05, 0x3f, 0x9f, 0x0b, 0xdb, 0xce, 0xbc,
+	0xe8, 0x2e, 0x4c, 0x2b, 0x21, 0xe7, 0x17, 0x3d, 0x31, 0xa7, 0xc4, 0xde, 0x57, 0x4e, 0x51, 0xf0,
+	0x06, 0xa5, 0x5a, 0x4d, 0xa6, 0x68, 0x6a, 0x11, 0xc5, 0x57, 0x4c, 0x69, 0xc6, 0xd3, 0xb2, 0xf8,
+	0xd2, 0x9c, 0x26, 0xfb, 0x11, 0x5c, 0x50, 0xc0, 0xb6, 0xdb, 0xed, 0x7d, 0x3f, 0x66, 0x44, 0xc0,
+	0xa2, 0x74, 0xdf, 0x77, 0xbb, 0x3d, 0x6f, 0xc2, 0x87, 0x70, 0x3e, 0x13, 0x41, 0x1c, 0x51, 0x6f,
+	0x5f, 0x1f, 0xf2, 0xac, 0x08, 0x5b, 0x48, 0xbd, 0x9f, 0x72, 0xa7, 0x3a, 0xea, 0x3a, 0x5c, 0xce,
+	0xa2, 0xd2, 0x67, 0xd1, 0xe1, 0x0d, 0x29, 0xa2, 0xcd, 0x39, 0x11, 0xbc, 0x9c, 0xbd, 0x13, 0x1a,
+	0xd3, 0xc0, 0xb1, 0xe0, 0x40, 0x4f, 0xe0, 0x42, 0x46, 0xd1, 0x26, 0xa1, 0x13, 0x90, 0xc3, 0x0e,
+	0xf1, 0x09, 0x3b, 0x31, 0xcb, 0xbc, 0xb8, 0x9b, 0x16, 0xaf, 0xc6, 0x5f, 0x2f, 0x57, 0xae, 0xb5,
+	0x08, 0xdb, 0xef, 0x34, 0x2d, 0x8f, 0xb6, 0xd5, 0xcc, 0xa0, 0xfe, 0xbb, 0x91, 0xf8, 0x07, 0x35,
+	0x71, 0x85, 0xad, 0x9d, 0x90, 0xd9, 0x8b, 0x29, 0xdd, 0x7d, 0x12, 0xde, 0xd3, 0x64, 0xd5, 0x5d,
+	0x80, 0xac, 0xc4, 0xa9, 0x6a, 0x19, 0xc3, 0x54, 0xab, 0xf0, 0x6f, 0xaa, 0x35, 0xda, 0xa3, 0x5a,
+	0xd5, 0x67, 0x06, 0x94, 0x1b, 0x31, 0xf1, 0xf2, 0xaf, 0x2b, 0x3a, 0x0f, 0xe3, 0x4a, 0x51, 0xa5,
+	0xc4, 0xab, 0x15, 0xba, 0x09, 0x45, 0xde, 0x27, 0x4a, 0xe3, 0x97, 0x07, 0x34, 0x7e, 0x4f, 0x0f,
+	0x49, 0x9b, 0x93, 0x7c, 0xc3, 0x4f, 0x5f, 0xad, 0x18, 0xb6, 0x88, 0x40, 0xdb, 0x30, 0x16, 0xf1,
+	0xaf, 0x98, 0xa3, 0xff, 0xb9, 0x1e, 0xdb, 0xd8, 0xb3, 0x65, 0x70, 0xf5, 0x07, 0x03, 0xd0, 0xe0,
+	0x94, 0x80, 0x6e, 0x41, 0x91, 0x6f, 0x47, 0x4d, 0x4f, 0x67, 0xbc, 0x9a, 0x22, 0x04, 0x3d, 0x84,
+	0xe9, 0x9e, 0xfb, 0x55, 0x38, 0xe5, 0xc5, 0xec, 0x2f, 0x93, 0x22, 0xea, 0x21, 0xa8, 0x7e, 0x0d,
+	0xe7, 0x06, 0x14, 0x00, 0xbd, 0x07, 0xe5, 0x7e, 0xad, 0x54, 0xa7, 0x36, 0xe7, 0xf5, 0xca, 0x23,
+	0xba, 0x04, 0xa5, 0x4c, 0x67, 0x0a, 0x42, 0x02, 0x32, 0x43, 0xf5, 0x97, 0x51, 0x28, 0xf7, 0xcf,
+	0x31, 0x03, 0x8f, 0xf1, 0xff, 0xf2, 0x9a, 0xed, 0x40, 0x59, 0x4e, 0x3e, 0xa2, 0x11, 0x44, 0x33,
+	0x89, 0x67, 0x6d, 0x6a, 0x63, 0xc9, 0x52, 0x2f, 0x38, 0x27, 0xce, 0x3d, 0xe1, 0x44, 0x17, 0x64,
+	0x56, 0x06, 0x36, 0x70, 0x2c, 0xda, 0x8c, 0xdf, 0x26, 0x11, 0xaf, 0xb5, 0x56, 0xad, 0xd0, 0x0a,
+	0x4c, 0xc9, 0x5f, 0x4e, 0xe4, 0x12, 0x5f, 0x3c, 0x7c, 0x45, 0x1b, 0xa4, 0xa9, 0xe1, 0x12, 0x1f,
+	0xdd, 0x86, 0x52, 0x8c, 0xdb, 0x2e, 0x09, 0x49, 0xd8, 0x32, 0x27, 0xcf, 0xf6, 0xf1, 0x2c, 0x82,
+	0xcf, 0x07, 0x5e, 0x8c, 0x5d, 0x96, 0x4d, 0x11, 0x52, 0x20, 0x67, 0x94, 0x55, 0x8d, 0x11, 0x4d,
+	0x58, 0x14, 0x45, 0x48, 0x7b, 0x56, 0x8b, 0x06, 0xbc, 0x55, 0xeb, 0xce, 0x73, 0xb2, 0xb4, 0x65,
+	0xa5, 0xc6, 0x54, 0x7f, 0x35, 0x60, 0xae, 0x6f, 0x94, 0x43, 0x77, 0x78, 0xf1, 0xa5, 0x92, 0xa8,
+	0x9b, 0x7b, 0xa6, 0xc9, 0x3d, 0x0d, 0xca, 0x75, 0x69, 0xa1, 0xa7, 0x4b, 0xef, 0x41, 0x29, 0xd3,
+	0x9f, 0xd1, 0xb7, 0xda, 0x44, 0x46, 0x50, 0xfd, 0xd1, 0xc8, 0x5d, 0x39, 0x35, 0x13, 0xf2, 0x91,
+	0x45, 0xcd, 0xdc, 0x4e, 0x7a, 0xf5, 0x4a, 0xca, 0xb2, 0xe3, 0x67, 0x53, 0x56, 0x21, 0x3f, 0x65,
+	0x7d, 0x05, 0xe5, 0x81, 0x1a, 0xbf, 0x5d, 0x7a, 0x73, 0x41, 0x5f, 0x7d, 0xbf, 0xcf, 0x27, 0xa9,
+	0x87, 0xed, 0xe1, 0xb3, 0x1e, 0x86, 0x09, 0x3d, 0xd2, 0xcb, 0x66, 0x3f, 0xe5, 0x4a, 0xbd, 0xcf,
+	0xf3, 0x7a, 0xf6, 0x6a, 0x65, 0xed, 0x0c, 0x79, 0xf1, 0x80, 0xc4, 0xd6, 0xdc, 0x9b, 0xbb, 0xcf,
+	0x5f, 0x57, 0x8c, 0x17, 0xaf, 0x2b, 0xc6, 0xdf, 0xaf, 0x2b, 0xc6, 0xd3, 0x37, 0x95, 0x91, 0x17,
+	0x6f, 0x2a, 0x23, 0x7f, 0xbe, 0xa9, 0x8c, 0x3c, 0xfe, 0x38, 0x47, 0x56, 0x17, 0xe7, 0xfd, 0x19,
+	0xed, 0x84, 0xbe, 0xd0, 0x8f, 0x9a, 0xbc, 0x00, 0x37, 0xee, 0xad, 0xd7, 0xba, 0xb9, 0x3f, 0x72,
+	0xc5, 0x17, 0x9a, 0xe3, 0x42, 0x67, 0x3f, 0xf8, 0x67, 0x00, 0xef, 0xb2, 0x44, 0xcc, 0x05, 0x0f,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncentiveAccruals) > 0 {
+		for iNdEx := len(m.IncentiveAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.IncentiveSamples) > 0 {
+		for iNdEx := len(m.IncentiveSamples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveSamples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IncentiveRewards) > 0 {
+		for iNdEx := len(m.IncentiveRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.IncentivePrograms) > 0 {
+		for iNdEx := len(m.IncentivePrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OracleObservations) > 0 {
+		for iNdEx := len(m.OracleObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QueuedProposals) > 0 {
+		for iNdEx := len(m.QueuedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueuedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Ambient {
+		i--
+		if m.Ambient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IncentiveMinLiquidity.Size()
+		i -= size
+		if _, err := m.IncentiveMinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.IncentivePositionsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IncentivePositionsPerBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.IncentiveEpochBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IncentiveEpochBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.OracleMaxObservations != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OracleMaxObservations))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.OracleInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OracleInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.OraclePools) > 0 {
+		for iNdEx := len(m.OraclePools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AutoCollectDestination) > 0 {
+		i -= len(m.AutoCollectDestination)
+		copy(dAtA[i:], m.AutoCollectDestination)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCollectDestination)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AutoCollectInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AutoCollectInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AutoCollectTokens) > 0 {
+		for iNdEx := len(m.AutoCollectTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCollectTokens[iNdEx])
+			copy(dAtA[i:], m.AutoCollectTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCollectTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ContractAllowedSelectors) > 0 {
+		for iNdEx := len(m.ContractAllowedSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractAllowedSelectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TimelockedProposalTypes) > 0 {
+		for iNdEx := len(m.TimelockedProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TimelockedProposalTypes[iNdEx])
+			copy(dAtA[i:], m.TimelockedProposalTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TimelockedProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VerifiedCrocQueryAddress) > 0 {
+		i -= len(m.VerifiedCrocQueryAddress)
+		copy(dAtA[i:], m.VerifiedCrocQueryAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifiedCrocQueryAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WhitelistedContractAddresses) > 0 {
+		for iNdEx := len(m.WhitelistedContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedContractAddresses[iNdEx])
+			copy(dAtA[i:], m.WhitelistedContractAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.WhitelistedContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VerifiedCrocPolicyAddress) > 0 {
+		i -= len(m.VerifiedCrocPolicyAddress)
+		copy(dAtA[i:], m.VerifiedCrocPolicyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifiedCrocPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerifiedNativeDexAddress) > 0 {
+		i -= len(m.VerifiedNativeDexAddress)
+		copy(dAtA[i:], m.VerifiedNativeDexAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifiedNativeDexAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolIdx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleObservations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleObservations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleObservations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Observations) > 0 {
+		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSelectors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSelectors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSelectors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PoolLiquidityBlocks.Size()
+		i -= size
+		if _, err := m.PoolLiquidityBlocks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.EpochsPaid != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochsPaid))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.RewardPerEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PoolIdx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityBlocks.Size()
+		i -= size
+		if _, err := m.LiquidityBlocks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProgramId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProgramId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedProposals) > 0 {
+		for _, e := range m.QueuedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleObservations) > 0 {
+		for _, e := range m.OracleObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentivePrograms) > 0 {
+		for _, e := range m.IncentivePrograms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveRewards) > 0 {
+		for _, e := range m.IncentiveRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveSamples) > 0 {
+		for _, e := range m.IncentiveSamples {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentiveAccruals) > 0 {
+		for _, e := range m.IncentiveAccruals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.QueuedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.QueuedHeight))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecuteHeight))
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolIdx))
+	}
+	if m.Ambient {
+		n += 2
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovGenesis(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovGenesis(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerifiedNativeDexAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.VerifiedCrocPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.WhitelistedContractAddresses) > 0 {
+		for _, s := range m.WhitelistedContractAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.VerifiedCrocQueryAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TimelockedProposalTypes) > 0 {
+		for _, s := range m.TimelockedProposalTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.TimelockBlocks))
+	}
+	if len(m.ContractAllowedSelectors) > 0 {
+		for _, e := range m.ContractAllowedSelectors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCollectTokens) > 0 {
+		for _, s := range m.AutoCollectTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoCollectInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.AutoCollectInterval))
+	}
+	l = len(m.AutoCollectDestination)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OraclePools) > 0 {
+		for _, e := range m.OraclePools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OracleInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.OracleInterval))
+	}
+	if m.OracleMaxObservations != 0 {
+		n += 1 + sovGenesis(uint64(m.OracleMaxObservations))
+	}
+	if m.IncentiveEpochBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.IncentiveEpochBlocks))
+	}
+	if m.IncentivePositionsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.IncentivePositionsPerBlock))
+	}
+	l = m.IncentiveMinLiquidity.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OraclePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolIdx))
+	}
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OracleObservations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Observations) > 0 {
+		for _, e := range m.Observations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractSelectors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolIdx))
+	}
+	l = m.RewardPerEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Epochs != 0 {
+		n += 1 + sovGenesis(uint64(m.Epochs))
+	}
+	if m.EpochsPaid != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochsPaid))
+	}
+	l = m.Remaining.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CreatedHeight))
+	}
+	l = m.PoolLiquidityBlocks.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IncentiveSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IncentiveAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProgramId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProgramId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.LiquidityBlocks.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IncentiveRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedProposals = append(m.QueuedProposals, QueuedProposal{})
+			if err := m.QueuedProposals[len(m.QueuedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleObservations = append(m.OracleObservations, OracleObservations{})
+			if err := m.OracleObservations[len(m.OracleObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePrograms = append(m.IncentivePrograms, IncentiveProgram{})
+			if err := m.IncentivePrograms[len(m.IncentivePrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRewards = append(m.IncentiveRewards, IncentiveRewards{})
+			if err := m.IncentiveRewards[len(m.IncentiveRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveSamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveSamples = append(m.IncentiveSamples, IncentiveSample{})
+			if err := m.IncentiveSamples[len(m.IncentiveSamples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveAccruals = append(m.IncentiveAccruals, IncentiveAccrual{})
+			if err := m.IncentiveAccruals[len(m.IncentiveAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
+			}
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambient", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ambient = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedNativeDexAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedNativeDexAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCrocPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCrocPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedContractAddresses = append(m.WhitelistedContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCrocQueryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCrocQueryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedProposalTypes = append(m.TimelockedProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAllowedSelectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAllowedSelectors = append(m.ContractAllowedSelectors, ContractSelectors{})
+			if err := m.ContractAllowedSelectors[len(m.ContractAllowedSelectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCollectTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCollectTokens = append(m.AutoCollectTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCollectInterval", wireType)
+			}
+			m.AutoCollectInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCollectInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCollectDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCollectDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePools = append(m.OraclePools, OraclePool{})
+			if err := m.OraclePools[len(m.OraclePools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleInterval", wireType)
+			}
+			m.OracleInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaxObservations", wireType)
+			}
+			m.OracleMaxObservations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleMaxObservations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpochBlocks", wireType)
+			}
+			m.IncentiveEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentiveEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePositionsPerBlock", wireType)
+			}
+			m.IncentivePositionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentivePositionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveMinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveMinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleObservations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleObservations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleObservations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observations = append(m.Observations, PriceObservation{})
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSelectors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSelectors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSelectors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPaid", wireType)
+			}
+			m.EpochsPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLiquidityBlocks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolLiquidityBlocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.VerifiedCrocPolicyAddress) > 0 {
-		i -= len(m.VerifiedCrocPolicyAddress)
-		copy(dAtA[i:], m.VerifiedCrocPolicyAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifiedCrocPolicyAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VerifiedNativeDexAddress) > 0 {
-		i -= len(m.VerifiedNativeDexAddress)
-		copy(dAtA[i:], m.VerifiedNativeDexAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifiedNativeDexAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VerifiedNativeDexAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.VerifiedCrocPolicyAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.WhitelistedContractAddresses) > 0 {
-		for _, s := range m.WhitelistedContractAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *IncentiveSample) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -313,15 +4265,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveSample: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveSample: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -348,7 +4300,60 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -373,7 +4378,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *IncentiveAccrual) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,15 +4401,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveAccrual: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			m.ProgramId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedNativeDexAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -432,11 +4456,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifiedNativeDexAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCrocPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBlocks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -464,11 +4488,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifiedCrocPolicyAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidityBlocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -496,7 +4572,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedContractAddresses = append(m.WhitelistedContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,11 +5,45 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
 )
 
 // nolint: exhaustruct
 func TestGenesisState_Validate(t *testing.T) {
+	owner := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	base := common.Address{}
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	ambient := types.NewAmbientPosition(owner, base, quote, 36000)
+	rangePos := types.NewRangePosition(owner, base, quote, 36000, -1024, 1024)
+	cancel := types.NewCancelTimelockedProposal("title", "description", 1)
+	queued, err := types.NewQueuedProposal(1, cancel, 10, 20)
+	require.NoError(t, err)
+	early, err := types.NewQueuedProposal(2, cancel, 10, 5)
+	require.NoError(t, err)
+	autoCollect := func(tokens []string, destination string) types.Params {
+		params := *types.DefaultParams()
+		params.AutoCollectTokens = tokens
+		params.AutoCollectInterval = 1000
+		params.AutoCollectDestination = destination
+		return params
+	}
+	program := types.NewIncentiveProgram(1, base, quote, 36000, sdk.NewInt64Coin("aalthea", 100), 10, 5)
+	finished := program
+	finished.Id = 2
+	finished.EpochsPaid = 10
+	rewards := types.IncentiveRewards{Owner: owner.String(), Rewards: sdk.NewCoins(sdk.NewInt64Coin("aalthea", 5))}
+	sample := types.IncentiveSample{Position: ambient, Height: 7, Liquidity: sdk.NewInt(1000)}
+	accrual := types.IncentiveAccrual{ProgramId: 1, Owner: owner.String(), LiquidityBlocks: sdk.NewInt(5000)}
+	incentiveParams := func(positionsPerBlock uint64, minLiquidity sdk.Int) types.Params {
+		params := *types.DefaultParams()
+		params.IncentivePositionsPerBlock = positionsPerBlock
+		params.IncentiveMinLiquidity = minLiquidity
+		return params
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,6 +54,135 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "valid positions",
+			genState: &types.GenesisState{
+				Params:    *types.DefaultParams(),
+				Positions: []types.Position{ambient, rangePos},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate position",
+			genState: &types.GenesisState{
+				Params:    *types.DefaultParams(),
+				Positions: []types.Position{ambient, rangePos, ambient},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid queued proposal",
+			genState: &types.GenesisState{
+				Params:          *types.DefaultParams(),
+				QueuedProposals: []types.QueuedProposal{queued},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate queued proposal id",
+			genState: &types.GenesisState{
+				Params:          *types.DefaultParams(),
+				QueuedProposals: []types.QueuedProposal{queued, queued},
+			},
+			valid: false,
+		},
+		{
+			desc: "queued proposal executes before it was queued",
+			genState: &types.GenesisState{
+				Params:          *types.DefaultParams(),
+				QueuedProposals: []types.QueuedProposal{early},
+			},
+			valid: false,
+		},
+		{
+			desc:     "auto collect to the community pool",
+			genState: &types.GenesisState{Params: autoCollect([]string{base.Hex(), quote.Hex()}, types.AutoCollectDestinationCommunityPool)},
+			valid:    true,
+		},
+		{
+			desc:     "auto collect to an account",
+			genState: &types.GenesisState{Params: autoCollect([]string{quote.Hex()}, owner.String())},
+			valid:    true,
+		},
+		{
+			desc:     "auto collect duplicate token",
+			genState: &types.GenesisState{Params: autoCollect([]string{quote.Hex(), "0x2222222222222222222222222222222222222222"}, "")},
+			valid:    false,
+		},
+		{
+			desc:     "auto collect invalid destination",
+			genState: &types.GenesisState{Params: autoCollect([]string{quote.Hex()}, "treasury")},
+			valid:    false,
+		},
+		{
+			desc: "valid incentives",
+			genState: &types.GenesisState{
+				Params:            *types.DefaultParams(),
+				IncentivePrograms: []types.IncentiveProgram{program},
+				IncentiveRewards:  []types.IncentiveRewards{rewards},
+				IncentiveSamples:  []types.IncentiveSample{sample},
+				IncentiveAccruals: []types.IncentiveAccrual{accrual},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate incentive sample",
+			genState: &types.GenesisState{
+				Params:           *types.DefaultParams(),
+				IncentiveSamples: []types.IncentiveSample{sample, sample},
+			},
+			valid: false,
+		},
+		{
+			desc: "incentive accrual of an unknown program",
+			genState: &types.GenesisState{
+				Params:            *types.DefaultParams(),
+				IncentiveAccruals: []types.IncentiveAccrual{accrual},
+			},
+			valid: false,
+		},
+		{
+			desc:     "negative incentive min liquidity",
+			genState: &types.GenesisState{Params: incentiveParams(50, sdk.NewInt(-1))},
+			valid:    false,
+		},
+		{
+			desc:     "no incentive positions per block",
+			genState: &types.GenesisState{Params: incentiveParams(0, sdk.ZeroInt())},
+			valid:    false,
+		},
+		{
+			desc: "duplicate incentive program id",
+			genState: &types.GenesisState{
+				Params:            *types.DefaultParams(),
+				IncentivePrograms: []types.IncentiveProgram{program, program},
+			},
+			valid: false,
+		},
+		{
+			desc: "finished incentive program",
+			genState: &types.GenesisState{
+				Params:            *types.DefaultParams(),
+				IncentivePrograms: []types.IncentiveProgram{finished},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate incentive rewards owner",
+			genState: &types.GenesisState{
+				Params:           *types.DefaultParams(),
+				IncentiveRewards: []types.IncentiveRewards{rewards, rewards},
+			},
+			valid: false,
+		},
+		{
+			desc: "inverted ticks",
+			genState: &types.GenesisState{
+				Params:    *types.DefaultParams(),
+				Positions: []types.Position{types.NewRangePosition(owner, base, quote, 36000, 1024, -1024)},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.ValidateBasic()
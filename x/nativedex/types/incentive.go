@@ -0,0 +1,169 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxIncentivePositionsPerBlock bounds the IncentivePositionsPerBlock param, limiting the DEX reads of each EndBlocker
+const MaxIncentivePositionsPerBlock uint64 = 1000
+
+// NewIncentiveProgram returns a program paying rewardPerEpoch to the (base, quote, poolIdx) pool for epochs epochs,
+// holding the funds for all of them
+func NewIncentiveProgram(id uint64, base, quote common.Address, poolIdx uint64, rewardPerEpoch sdk.Coin, epochs, height uint64) IncentiveProgram {
+	return IncentiveProgram{
+		Id:             id,
+		Base:           base.Hex(),
+		Quote:          quote.Hex(),
+		PoolIdx:        poolIdx,
+		RewardPerEpoch: rewardPerEpoch,
+		Epochs:         epochs,
+		EpochsPaid:     0,
+		Remaining:      TotalIncentiveRewards(rewardPerEpoch, epochs),
+		CreatedHeight:  height,
+		// Blocks are counted from the next one, the program was not funded for the current block
+		PoolLiquidityBlocks: sdk.ZeroInt(),
+	}
+}
+
+// TotalIncentiveRewards returns the funds needed to pay rewardPerEpoch for epochs epochs
+func TotalIncentiveRewards(rewardPerEpoch sdk.Coin, epochs uint64) sdk.Coin {
+	return sdk.NewCoin(rewardPerEpoch.Denom, rewardPerEpoch.Amount.Mul(sdk.NewIntFromUint64(epochs)))
+}
+
+// Tokens returns the EVM addresses of the program pool's base and quote tokens
+func (p IncentiveProgram) Tokens() (base, quote common.Address) {
+	return common.HexToAddress(p.Base), common.HexToAddress(p.Quote)
+}
+
+// EpochReward returns the rewards the program pays at the end of the current epoch, which is limited by its
+// remaining funds
+func (p IncentiveProgram) EpochReward() sdk.Coin {
+	if p.Remaining.IsLT(p.RewardPerEpoch) {
+		return p.Remaining
+	}
+	return p.RewardPerEpoch
+}
+
+// Finished returns true once the program has paid for all of its epochs
+func (p IncentiveProgram) Finished() bool {
+	return p.EpochsPaid >= p.Epochs
+}
+
+// ValidateBasic checks the program's pool and rewards, and that it has epochs left to pay
+func (p IncentiveProgram) ValidateBasic() error {
+	if p.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidIncentive, "program id must be positive")
+	}
+	if err := ValidateIncentiveProgram(p.Base, p.Quote, p.PoolIdx, p.RewardPerEpoch, p.Epochs); err != nil {
+		return errorsmod.Wrapf(err, "program %d", p.Id)
+	}
+	if p.Finished() {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "program %d has already paid every epoch", p.Id)
+	}
+	if err := p.Remaining.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "program %d remaining funds", p.Id)
+	}
+	if p.Remaining.Denom != p.RewardPerEpoch.Denom {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "program %d remaining funds must be %s", p.Id, p.RewardPerEpoch.Denom)
+	}
+	if !p.PoolLiquidityBlocks.IsNil() && p.PoolLiquidityBlocks.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "program %d pool liquidity must not be negative", p.Id)
+	}
+	return nil
+}
+
+// GetPoolLiquidityBlocks returns the pool's liquidity-blocks in the current epoch, which are zero if they were never
+// recorded
+func (p IncentiveProgram) GetPoolLiquidityBlocks() sdk.Int {
+	if p.PoolLiquidityBlocks.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return p.PoolLiquidityBlocks
+}
+
+// ValidateIncentiveProgram checks the pool, reward, and duration of a new incentive program
+func ValidateIncentiveProgram(base, quote string, poolIdx uint64, rewardPerEpoch sdk.Coin, epochs uint64) error {
+	if err := ValidatePool(base, quote, poolIdx); err != nil {
+		return err
+	}
+	if err := rewardPerEpoch.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid reward per epoch")
+	}
+	if !rewardPerEpoch.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidIncentive, "reward per epoch must be positive")
+	}
+	if epochs == 0 {
+		return errorsmod.Wrap(ErrInvalidIncentive, "epochs must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks the owner and that the rewards are valid coins
+func (r IncentiveRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner")
+	}
+	if err := r.Rewards.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid rewards for %s", r.Owner)
+	}
+	return nil
+}
+
+// ValidateBasic checks the sample's position and liquidity
+func (s IncentiveSample) ValidateBasic() error {
+	if err := s.Position.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid position")
+	}
+	if s.Liquidity.IsNil() || s.Liquidity.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "invalid sampled liquidity for a position of %s", s.Position.Owner)
+	}
+	return nil
+}
+
+// EarnedLiquidityBlocks returns the liquidity-blocks a position earns between two samples: the smaller of the sampled
+// liquidities for every block between them, so that liquidity only earns for blocks it was known to be present for
+func EarnedLiquidityBlocks(previous, current sdk.Int, blocks uint64) sdk.Int {
+	return sdk.MinInt(previous, current).Mul(sdk.NewIntFromUint64(blocks))
+}
+
+// ValidateBasic checks the accrual's program, owner, and liquidity-blocks
+func (a IncentiveAccrual) ValidateBasic() error {
+	if a.ProgramId == 0 {
+		return errorsmod.Wrap(ErrInvalidIncentive, "program id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner")
+	}
+	if a.LiquidityBlocks.IsNil() || !a.LiquidityBlocks.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "liquidity-blocks of %s in program %d must be positive", a.Owner, a.ProgramId)
+	}
+	return nil
+}
+
+// SplitIncentiveReward splits reward between accounts in proportion to the liquidity-blocks they earned out of the
+// pool's total liquidity-blocks, rounding down. The share of liquidity which earned nothing, e.g. liquidity the module
+// does not know of, is not paid, nor is the rounding remainder. If the sampled liquidity-blocks exceed the pool's, e.g.
+// because positions were sampled late in the epoch, the rewards are split between the accounts alone
+func SplitIncentiveReward(reward, poolLiquidityBlocks sdk.Int, earned []sdk.Int) []sdk.Int {
+	total := poolLiquidityBlocks
+	sum := sdk.ZeroInt()
+	for _, l := range earned {
+		sum = sum.Add(l)
+	}
+	if sum.GT(total) {
+		total = sum
+	}
+
+	shares := make([]sdk.Int, len(earned))
+	for i, l := range earned {
+		if !total.IsPositive() {
+			shares[i] = sdk.ZeroInt()
+			continue
+		}
+		shares[i] = reward.Mul(l).Quo(total)
+	}
+	return shares
+}
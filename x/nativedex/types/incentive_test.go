@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestSplitIncentiveReward(t *testing.T) {
+	earned := []sdk.Int{sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(2)}
+	shares := types.SplitIncentiveReward(sdk.NewInt(100), sdk.NewInt(4), earned)
+	require.Equal(t, []sdk.Int{sdk.NewInt(25), sdk.NewInt(25), sdk.NewInt(50)}, shares)
+
+	// Liquidity which earned nothing is still part of the pool, its share is not paid
+	shares = types.SplitIncentiveReward(sdk.NewInt(100), sdk.NewInt(8), earned)
+	require.Equal(t, []sdk.Int{sdk.NewInt(12), sdk.NewInt(12), sdk.NewInt(25)}, shares)
+
+	// Earned liquidity-blocks above the pool's are split between the accounts alone
+	shares = types.SplitIncentiveReward(sdk.NewInt(100), sdk.NewInt(2), earned)
+	require.Equal(t, []sdk.Int{sdk.NewInt(25), sdk.NewInt(25), sdk.NewInt(50)}, shares)
+
+	// Rounding down leaves a remainder unpaid
+	shares = types.SplitIncentiveReward(sdk.NewInt(10), sdk.NewInt(3), []sdk.Int{sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(1)})
+	require.Equal(t, []sdk.Int{sdk.NewInt(3), sdk.NewInt(3), sdk.NewInt(3)}, shares)
+
+	shares = types.SplitIncentiveReward(sdk.NewInt(10), sdk.ZeroInt(), []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt()})
+	require.Equal(t, []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt()}, shares)
+	require.Empty(t, types.SplitIncentiveReward(sdk.NewInt(10), sdk.NewInt(10), nil))
+}
+
+func TestEarnedLiquidityBlocks(t *testing.T) {
+	// Liquidity added before a sample only earns from that sample on
+	require.Equal(t, sdk.NewInt(50), types.EarnedLiquidityBlocks(sdk.NewInt(5), sdk.NewInt(1000), 10))
+	// Liquidity removed after a sample does not earn for the blocks before it
+	require.Equal(t, sdk.NewInt(20), types.EarnedLiquidityBlocks(sdk.NewInt(1000), sdk.NewInt(2), 10))
+	// A position out of range at either sample earns nothing
+	require.True(t, types.EarnedLiquidityBlocks(sdk.ZeroInt(), sdk.NewInt(1000), 10).IsZero())
+	require.True(t, types.EarnedLiquidityBlocks(sdk.NewInt(1000), sdk.NewInt(1000), 0).IsZero())
+}
+
+func TestIncentiveProgram(t *testing.T) {
+	base := common.Address{}
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	reward := sdk.NewInt64Coin("aalthea", 100)
+
+	program := types.NewIncentiveProgram(1, base, quote, 36000, reward, 3, 10)
+	require.NoError(t, program.ValidateBasic())
+	require.Equal(t, sdk.NewInt64Coin("aalthea", 300), program.Remaining)
+	require.Equal(t, reward, program.EpochReward())
+	require.False(t, program.Finished())
+	require.True(t, program.GetPoolLiquidityBlocks().IsZero())
+
+	// The final epochs are limited by the remaining funds
+	program.Remaining = sdk.NewInt64Coin("aalthea", 40)
+	require.Equal(t, sdk.NewInt64Coin("aalthea", 40), program.EpochReward())
+	program.EpochsPaid = 3
+	require.True(t, program.Finished())
+	require.Error(t, program.ValidateBasic())
+
+	require.NoError(t, types.NewCreateIncentiveProgramProposal("Incentives", "Reward liquidity", base, quote, 36000, reward, 3).ValidateBasic())
+	require.Error(t, types.NewCreateIncentiveProgramProposal("Incentives", "Reward liquidity", quote, base, 36000, reward, 3).ValidateBasic())
+	require.Error(t, types.NewCreateIncentiveProgramProposal("Incentives", "Reward liquidity", base, quote, 36000, sdk.NewInt64Coin("aalthea", 0), 3).ValidateBasic())
+	require.Error(t, types.NewCreateIncentiveProgramProposal("Incentives", "Reward liquidity", base, quote, 36000, reward, 0).ValidateBasic())
+	require.NoError(t, types.NewCancelIncentiveProgramProposal("Cancel", "End incentives", 1).ValidateBasic())
+	require.Error(t, types.NewCancelIncentiveProgramProposal("Cancel", "End incentives", 0).ValidateBasic())
+}
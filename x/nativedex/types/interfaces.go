@@ -1,10 +1,15 @@
 package types
 
 import (
+	"context"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	erc20types "github.com/AltheaFoundation/althea-L1/x/erc20/types"
 )
 
 // Required for deploying Map-Contract/Caling setter methods of Map-Contract
@@ -18,6 +23,42 @@ type EVMKeeper interface {
 		data []byte,
 		commit bool,
 	) (*evmtypes.MsgEthereumTxResponse, error)
+
+	CallEVMWithValue(
+		ctx sdk.Context,
+		from common.Address,
+		contract *common.Address,
+		data []byte,
+		value *big.Int,
+		commit bool,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// Erc20Keeper defines the methods of the erc20 module used to move swap funds between Cosmos coins and ERC20 tokens
+type Erc20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	ConvertCoin(goCtx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error)
+	ConvertERC20(goCtx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
+}
+
+// BankKeeper defines the methods of the bank module used to read and route the module's native token and coins
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the methods of the distribution module used to fund, and be funded by, the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// CircuitKeeper defines the methods of the circuit module used to respect tripped circuits in the conversions and DEX
+// calls the module makes on behalf of its users
+type CircuitKeeper interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+	IsContractTripped(ctx sdk.Context, contract common.Address) bool
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
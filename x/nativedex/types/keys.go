@@ -1,6 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -17,6 +21,10 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// IncentivesModuleName is the module account holding the funds of the liquidity incentive programs, which is kept
+	// apart from the nativedex module account since proposals may spend that account's balance in the EVM
+	IncentivesModuleName = "nativedex_incentives"
 )
 
 var (
@@ -24,8 +32,110 @@ var (
 	ModuleAddress = authtypes.NewModuleAddress(ModuleName)
 	// The Microtx Module's EVM address
 	ModuleEVMAddress = common.BytesToAddress(ModuleAddress.Bytes())
+	// The bech32 address of the incentive programs' module account
+	IncentivesModuleAddress = authtypes.NewModuleAddress(IncentivesModuleName)
+)
+
+var (
+	// PositionKeyPrefix indexes the DEX positions opened via the nativedex Msgs or registered for incentives, see
+	// GetPositionKey
+	PositionKeyPrefix = []byte{0x1}
+	// QueuedProposalKeyPrefix indexes the timelocked proposals by id, see GetQueuedProposalKey
+	QueuedProposalKeyPrefix = []byte{0x2}
+	// NextQueuedProposalIDKey holds the id the next timelocked proposal will be queued with
+	NextQueuedProposalIDKey = []byte{0x3}
+	// PriceObservationKeyPrefix indexes the price oracle's observations by pool and height, see GetPriceObservationKey
+	PriceObservationKeyPrefix = []byte{0x4}
+	// IncentiveProgramKeyPrefix indexes the liquidity incentive programs by id, see GetIncentiveProgramKey
+	IncentiveProgramKeyPrefix = []byte{0x5}
+	// NextIncentiveProgramIDKey holds the id the next incentive program will be created with
+	NextIncentiveProgramIDKey = []byte{0x6}
+	// IncentiveRewardsKeyPrefix indexes the unclaimed incentive rewards by owner, see GetIncentiveRewardsKey
+	IncentiveRewardsKeyPrefix = []byte{0x7}
+	// IncentiveSampleKeyPrefix indexes the last incentive sample of each position, see GetIncentiveSampleKey
+	IncentiveSampleKeyPrefix = []byte{0x8}
+	// IncentiveAccrualKeyPrefix indexes the liquidity-blocks earned in the current epoch by program and owner, see
+	// GetIncentiveAccrualKey
+	IncentiveAccrualKeyPrefix = []byte{0x9}
+	// IncentiveSampleCursorKey holds the key of the last position sampled for the incentive programs
+	IncentiveSampleCursorKey = []byte{0xa}
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// GetPositionsByOwnerKey returns the prefix of every position key held by owner
+// [0x1][len(owner)][owner]
+func GetPositionsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, PositionKeyPrefix...), address.MustLengthPrefix(owner)...)
+}
+
+// GetPositionKey returns the key of a position, ambient positions have zero ticks
+// [0x1][len(owner)][owner][base][quote][poolIdx][ambient][lowerTick][upperTick]
+func GetPositionKey(owner sdk.AccAddress, base, quote common.Address, poolIdx uint64, ambient bool, lowerTick, upperTick int32) []byte {
+	key := GetPositionsByOwnerKey(owner)
+	key = append(key, base.Bytes()...)
+	key = append(key, quote.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(poolIdx)...)
+	if ambient {
+		key = append(key, 1)
+		lowerTick, upperTick = 0, 0
+	} else {
+		key = append(key, 0)
+	}
+	key = binary.BigEndian.AppendUint32(key, uint32(lowerTick))
+	key = binary.BigEndian.AppendUint32(key, uint32(upperTick))
+	return key
+}
+
+// GetQueuedProposalKey returns the key of a timelocked proposal
+// [0x2][id]
+func GetQueuedProposalKey(id uint64) []byte {
+	return append(append([]byte{}, QueuedProposalKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPriceObservationsKey returns the prefix of every price observation of a pool
+// [0x4][base][quote][poolIdx]
+func GetPriceObservationsKey(base, quote common.Address, poolIdx uint64) []byte {
+	key := append([]byte{}, PriceObservationKeyPrefix...)
+	key = append(key, base.Bytes()...)
+	key = append(key, quote.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(poolIdx)...)
+}
+
+// GetPriceObservationKey returns the key of a pool's price observation
+// [0x4][base][quote][poolIdx][height]
+func GetPriceObservationKey(base, quote common.Address, poolIdx uint64, height uint64) []byte {
+	return append(GetPriceObservationsKey(base, quote, poolIdx), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetIncentiveProgramKey returns the key of an incentive program
+// [0x5][id]
+func GetIncentiveProgramKey(id uint64) []byte {
+	return append(append([]byte{}, IncentiveProgramKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetIncentiveRewardsKey returns the key of an account's unclaimed incentive rewards
+// [0x7][len(owner)][owner]
+func GetIncentiveRewardsKey(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, IncentiveRewardsKeyPrefix...), address.MustLengthPrefix(owner)...)
+}
+
+// GetIncentiveSampleKey returns the key of a position's last incentive sample, which follows the position's key
+// [0x8][len(owner)][owner][base][quote][poolIdx][ambient][lowerTick][upperTick]
+func GetIncentiveSampleKey(position Position) []byte {
+	return append(append([]byte{}, IncentiveSampleKeyPrefix...), position.Key()[len(PositionKeyPrefix):]...)
+}
+
+// GetIncentiveAccrualsKey returns the prefix of every accrual of an incentive program
+// [0x9][id]
+func GetIncentiveAccrualsKey(programID uint64) []byte {
+	return append(append([]byte{}, IncentiveAccrualKeyPrefix...), sdk.Uint64ToBigEndian(programID)...)
+}
+
+// GetIncentiveAccrualKey returns the key of the liquidity-blocks owner has earned in an incentive program's epoch
+// [0x9][id][len(owner)][owner]
+func GetIncentiveAccrualKey(programID uint64, owner sdk.AccAddress) []byte {
+	return append(GetIncentiveAccrualsKey(programID), address.MustLengthPrefix(owner)...)
+}
@@ -0,0 +1,431 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authlegacy "github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	TypeMsgSwap                 = "swap"
+	TypeMsgMintAmbientLiquidity = "mint_ambient_liquidity"
+	TypeMsgMintRangeLiquidity   = "mint_range_liquidity"
+	TypeMsgBurnLiquidity        = "burn_liquidity"
+	TypeMsgHarvest              = "harvest"
+	TypeMsgCancelTimelocked     = "cancel_timelocked_proposal"
+	TypeMsgClaimIncentives      = "claim_incentives"
+	TypeMsgRegisterIncentive    = "register_incentive_position"
+)
+
+// nolint: exhaustruct
+var (
+	_ sdk.Msg              = &MsgSwap{}
+	_ sdk.Msg              = &MsgMintAmbientLiquidity{}
+	_ sdk.Msg              = &MsgMintRangeLiquidity{}
+	_ sdk.Msg              = &MsgBurnLiquidity{}
+	_ sdk.Msg              = &MsgHarvest{}
+	_ sdk.Msg              = &MsgCancelTimelockedProposal{}
+	_ sdk.Msg              = &MsgClaimIncentives{}
+	_ sdk.Msg              = &MsgRegisterIncentivePosition{}
+	_ authlegacy.LegacyMsg = &MsgSwap{}
+	_ authlegacy.LegacyMsg = &MsgMintAmbientLiquidity{}
+	_ authlegacy.LegacyMsg = &MsgMintRangeLiquidity{}
+	_ authlegacy.LegacyMsg = &MsgBurnLiquidity{}
+	_ authlegacy.LegacyMsg = &MsgHarvest{}
+	_ authlegacy.LegacyMsg = &MsgCancelTimelockedProposal{}
+	_ authlegacy.LegacyMsg = &MsgClaimIncentives{}
+	_ authlegacy.LegacyMsg = &MsgRegisterIncentivePosition{}
+)
+
+// NewMsgSwap returns a new MsgSwap
+func NewMsgSwap(sender string, base, quote common.Address, poolIdx uint64, amountIn sdk.Coin, minOut sdk.Int, receiveErc20 bool) *MsgSwap {
+	return &MsgSwap{
+		Sender:       sender,
+		Base:         base.Hex(),
+		Quote:        quote.Hex(),
+		PoolIdx:      poolIdx,
+		AmountIn:     amountIn,
+		MinOut:       minOut,
+		ReceiveErc20: receiveErc20,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSwap) Route() string { return RouterKey }
+
+func (msg MsgSwap) Type() string { return TypeMsgSwap }
+
+// ValidateBasic checks for a valid sender, pool, and swap amounts
+func (msg *MsgSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender in nativedex msg swap")
+	}
+	if err := ValidatePool(msg.Base, msg.Quote, msg.PoolIdx); err != nil {
+		return errorsmod.Wrap(err, "invalid pool in nativedex msg swap")
+	}
+	if err := msg.AmountIn.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid amount in nativedex msg swap")
+	}
+	if !msg.AmountIn.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidSwap, "zero amount in nativedex msg swap")
+	}
+	if msg.MinOut.IsNil() || msg.MinOut.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSwap, "negative minimum output in nativedex msg swap")
+	}
+	return nil
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgSwap) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgMintAmbientLiquidity returns a new MsgMintAmbientLiquidity
+func NewMsgMintAmbientLiquidity(sender string, base, quote common.Address, poolIdx uint64, amount, maxOther sdk.Coin) *MsgMintAmbientLiquidity {
+	return &MsgMintAmbientLiquidity{
+		Sender:   sender,
+		Base:     base.Hex(),
+		Quote:    quote.Hex(),
+		PoolIdx:  poolIdx,
+		Amount:   amount,
+		MaxOther: maxOther,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgMintAmbientLiquidity) Route() string { return RouterKey }
+
+func (msg MsgMintAmbientLiquidity) Type() string { return TypeMsgMintAmbientLiquidity }
+
+// ValidateBasic checks for a valid sender, pool, and deposit amounts
+func (msg *MsgMintAmbientLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender in nativedex msg mint ambient liquidity")
+	}
+	if err := ValidatePool(msg.Base, msg.Quote, msg.PoolIdx); err != nil {
+		return errorsmod.Wrap(err, "invalid pool in nativedex msg mint ambient liquidity")
+	}
+	if err := validateMintAmounts(msg.Amount, msg.MaxOther); err != nil {
+		return errorsmod.Wrap(err, "invalid amounts in nativedex msg mint ambient liquidity")
+	}
+	return nil
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgMintAmbientLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMintAmbientLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgMintRangeLiquidity returns a new MsgMintRangeLiquidity
+func NewMsgMintRangeLiquidity(
+	sender string, base, quote common.Address, poolIdx uint64, lowerTick, upperTick int32, amount, maxOther sdk.Coin,
+) *MsgMintRangeLiquidity {
+	return &MsgMintRangeLiquidity{
+		Sender:    sender,
+		Base:      base.Hex(),
+		Quote:     quote.Hex(),
+		PoolIdx:   poolIdx,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Amount:    amount,
+		MaxOther:  maxOther,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgMintRangeLiquidity) Route() string { return RouterKey }
+
+func (msg MsgMintRangeLiquidity) Type() string { return TypeMsgMintRangeLiquidity }
+
+// ValidateBasic checks for a valid sender, pool, ticks, and deposit amounts
+func (msg *MsgMintRangeLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender in nativedex msg mint range liquidity")
+	}
+	if err := ValidatePool(msg.Base, msg.Quote, msg.PoolIdx); err != nil {
+		return errorsmod.Wrap(err, "invalid pool in nativedex msg mint range liquidity")
+	}
+	if err := ValidateTicks(msg.LowerTick, msg.UpperTick); err != nil {
+		return errorsmod.Wrap(err, "invalid ticks in nativedex msg mint range liquidity")
+	}
+	if err := validateMintAmounts(msg.Amount, msg.MaxOther); err != nil {
+		return errorsmod.Wrap(err, "invalid amounts in nativedex msg mint range liquidity")
+	}
+	return nil
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgMintRangeLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMintRangeLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// validateMintAmounts checks that amount is positive and max other is a different, non-negative coin
+func validateMintAmounts(amount, maxOther sdk.Coin) error {
+	if err := amount.Validate(); err != nil {
+		return err
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPosition, "amount must be positive")
+	}
+	if err := maxOther.Validate(); err != nil {
+		return errorsmod.Wrap(err, "max other")
+	}
+	if amount.Denom == maxOther.Denom {
+		return errorsmod.Wrap(ErrInvalidPosition, "amount and max other must be different denoms")
+	}
+	return nil
+}
+
+// NewMsgBurnLiquidity returns a new MsgBurnLiquidity, lowerTick and upperTick are ignored for ambient positions
+func NewMsgBurnLiquidity(
+	sender string, base, quote common.Address, poolIdx uint64, ambient bool, lowerTick, upperTick int32, liquidity sdk.Int,
+	minBaseOut, minQuoteOut sdk.Int, receiveErc20 bool,
+) *MsgBurnLiquidity {
+	if ambient {
+		lowerTick, upperTick = 0, 0
+	}
+	return &MsgBurnLiquidity{
+		Sender:       sender,
+		Base:         base.Hex(),
+		Quote:        quote.Hex(),
+		PoolIdx:      poolIdx,
+		Ambient:      ambient,
+		LowerTick:    lowerTick,
+		UpperTick:    upperTick,
+		Liquidity:    liquidity,
+		ReceiveErc20: receiveErc20,
+		MinBaseOut:   minBaseOut,
+		MinQuoteOut:  minQuoteOut,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgBurnLiquidity) Route() string { return RouterKey }
+
+func (msg MsgBurnLiquidity) Type() string { return TypeMsgBurnLiquidity }
+
+// ValidateBasic checks for a valid sender, position, liquidity, and minimum outputs
+func (msg *MsgBurnLiquidity) ValidateBasic() error {
+	if err := msg.Position().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid position in nativedex msg burn liquidity")
+	}
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPosition, "liquidity must be positive in nativedex msg burn liquidity")
+	}
+	if msg.Liquidity.BigInt().BitLen() > 128 {
+		return errorsmod.Wrap(ErrInvalidPosition, "liquidity must fit in a uint128 in nativedex msg burn liquidity")
+	}
+	if msg.MinBaseOut.IsNil() || msg.MinBaseOut.IsNegative() || msg.MinQuoteOut.IsNil() || msg.MinQuoteOut.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidPosition, "minimum outputs must not be negative in nativedex msg burn liquidity")
+	}
+	return nil
+}
+
+// Position returns the Position the msg burns from
+func (msg *MsgBurnLiquidity) Position() Position {
+	return Position{
+		Owner:     msg.Sender,
+		Base:      msg.Base,
+		Quote:     msg.Quote,
+		PoolIdx:   msg.PoolIdx,
+		Ambient:   msg.Ambient,
+		LowerTick: msg.LowerTick,
+		UpperTick: msg.UpperTick,
+	}
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgBurnLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurnLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgHarvest returns a new MsgHarvest
+func NewMsgHarvest(sender string, base, quote common.Address, poolIdx uint64, lowerTick, upperTick int32, receiveErc20 bool) *MsgHarvest {
+	return &MsgHarvest{
+		Sender:       sender,
+		Base:         base.Hex(),
+		Quote:        quote.Hex(),
+		PoolIdx:      poolIdx,
+		LowerTick:    lowerTick,
+		UpperTick:    upperTick,
+		ReceiveErc20: receiveErc20,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgHarvest) Route() string { return RouterKey }
+
+func (msg MsgHarvest) Type() string { return TypeMsgHarvest }
+
+// ValidateBasic checks for a valid sender and range position
+func (msg *MsgHarvest) ValidateBasic() error {
+	if err := msg.Position().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid position in nativedex msg harvest")
+	}
+	return nil
+}
+
+// Position returns the range Position the msg harvests
+func (msg *MsgHarvest) Position() Position {
+	return Position{
+		Owner:     msg.Sender,
+		Base:      msg.Base,
+		Quote:     msg.Quote,
+		PoolIdx:   msg.PoolIdx,
+		Ambient:   false,
+		LowerTick: msg.LowerTick,
+		UpperTick: msg.UpperTick,
+	}
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgHarvest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgHarvest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgCancelTimelockedProposal returns a new MsgCancelTimelockedProposal
+func NewMsgCancelTimelockedProposal(sender string, queuedID uint64) *MsgCancelTimelockedProposal {
+	return &MsgCancelTimelockedProposal{
+		Sender:   sender,
+		QueuedId: queuedID,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelTimelockedProposal) Route() string { return RouterKey }
+
+func (msg MsgCancelTimelockedProposal) Type() string { return TypeMsgCancelTimelocked }
+
+// ValidateBasic checks for a valid sender, the sender's authority is checked on execution
+func (msg *MsgCancelTimelockedProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender in nativedex msg cancel timelocked proposal")
+	}
+	return nil
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgCancelTimelockedProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelTimelockedProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgClaimIncentives returns a new MsgClaimIncentives
+func NewMsgClaimIncentives(sender string) *MsgClaimIncentives {
+	return &MsgClaimIncentives{
+		Sender: sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgClaimIncentives) Route() string { return RouterKey }
+
+func (msg MsgClaimIncentives) Type() string { return TypeMsgClaimIncentives }
+
+// ValidateBasic checks for a valid sender
+func (msg *MsgClaimIncentives) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender in nativedex msg claim incentives")
+	}
+	return nil
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgClaimIncentives) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimIncentives) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRegisterIncentivePosition returns a new MsgRegisterIncentivePosition, lowerTick and upperTick are ignored for
+// ambient positions
+func NewMsgRegisterIncentivePosition(
+	sender string, base, quote common.Address, poolIdx uint64, ambient bool, lowerTick, upperTick int32,
+) *MsgRegisterIncentivePosition {
+	if ambient {
+		lowerTick, upperTick = 0, 0
+	}
+	return &MsgRegisterIncentivePosition{
+		Sender:    sender,
+		Base:      base.Hex(),
+		Quote:     quote.Hex(),
+		PoolIdx:   poolIdx,
+		Ambient:   ambient,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRegisterIncentivePosition) Route() string { return RouterKey }
+
+func (msg MsgRegisterIncentivePosition) Type() string { return TypeMsgRegisterIncentive }
+
+// ValidateBasic checks for a valid sender and position
+func (msg *MsgRegisterIncentivePosition) ValidateBasic() error {
+	if err := msg.Position().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid position in nativedex msg register incentive position")
+	}
+	return nil
+}
+
+// Position returns the Position the msg registers
+func (msg *MsgRegisterIncentivePosition) Position() Position {
+	return Position{
+		Owner:     msg.Sender,
+		Base:      msg.Base,
+		Quote:     msg.Quote,
+		PoolIdx:   msg.PoolIdx,
+		Ambient:   msg.Ambient,
+		LowerTick: msg.LowerTick,
+		UpperTick: msg.UpperTick,
+	}
+}
+
+// GetSigners requires the Sender to be the signer
+func (msg *MsgRegisterIncentivePosition) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRegisterIncentivePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,161 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// nolint: exhaustruct
+func TestMsgSwap_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	native := common.Address{}
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	amount := sdk.NewCoin("ibc/TOKEN", sdk.NewInt(1000))
+
+	for _, tc := range []struct {
+		desc  string
+		msg   *types.MsgSwap
+		valid bool
+	}{
+		{
+			desc:  "valid",
+			msg:   types.NewMsgSwap(sender, native, token, 36000, amount, sdk.NewInt(990), false),
+			valid: true,
+		},
+		{
+			desc:  "bad sender",
+			msg:   types.NewMsgSwap("althea1bad", native, token, 36000, amount, sdk.NewInt(990), false),
+			valid: false,
+		},
+		{
+			desc:  "base not lexically smaller than quote",
+			msg:   types.NewMsgSwap(sender, token, native, 36000, amount, sdk.NewInt(990), false),
+			valid: false,
+		},
+		{
+			desc:  "zero pool index",
+			msg:   types.NewMsgSwap(sender, native, token, 0, amount, sdk.NewInt(990), false),
+			valid: false,
+		},
+		{
+			desc:  "zero amount",
+			msg:   types.NewMsgSwap(sender, native, token, 36000, sdk.NewCoin("ibc/TOKEN", sdk.ZeroInt()), sdk.NewInt(990), false),
+			valid: false,
+		},
+		{
+			desc:  "negative min out",
+			msg:   types.NewMsgSwap(sender, native, token, 36000, amount, sdk.NewInt(-1), false),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// nolint: exhaustruct
+func TestLiquidityMsgs_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+	native := common.Address{}
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	amount := sdk.NewCoin("ibc/TOKEN", sdk.NewInt(1000))
+	maxOther := sdk.NewCoin("aalthea", sdk.NewInt(2000))
+
+	for _, tc := range []struct {
+		desc  string
+		msg   sdk.Msg
+		valid bool
+	}{
+		{
+			desc:  "valid ambient mint",
+			msg:   types.NewMsgMintAmbientLiquidity(sender, native, token, 36000, amount, maxOther),
+			valid: true,
+		},
+		{
+			desc:  "ambient mint with matching denoms",
+			msg:   types.NewMsgMintAmbientLiquidity(sender, native, token, 36000, amount, amount),
+			valid: false,
+		},
+		{
+			desc:  "ambient mint with zero amount",
+			msg:   types.NewMsgMintAmbientLiquidity(sender, native, token, 36000, sdk.NewCoin("ibc/TOKEN", sdk.ZeroInt()), maxOther),
+			valid: false,
+		},
+		{
+			desc:  "valid range mint",
+			msg:   types.NewMsgMintRangeLiquidity(sender, native, token, 36000, -1024, 1024, amount, maxOther),
+			valid: true,
+		},
+		{
+			desc:  "range mint with inverted ticks",
+			msg:   types.NewMsgMintRangeLiquidity(sender, native, token, 36000, 1024, -1024, amount, maxOther),
+			valid: false,
+		},
+		{
+			desc:  "range mint beyond max tick",
+			msg:   types.NewMsgMintRangeLiquidity(sender, native, token, 36000, 0, types.MaxTick+1, amount, maxOther),
+			valid: false,
+		},
+		{
+			desc:  "valid ambient burn",
+			msg:   types.NewMsgBurnLiquidity(sender, native, token, 36000, true, 5, 10, sdk.NewInt(1024), sdk.ZeroInt(), sdk.NewInt(1), false),
+			valid: true,
+		},
+		{
+			desc:  "burn of zero liquidity",
+			msg:   types.NewMsgBurnLiquidity(sender, native, token, 36000, false, -1024, 1024, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), false),
+			valid: false,
+		},
+		{
+			desc:  "burn with negative min out",
+			msg:   types.NewMsgBurnLiquidity(sender, native, token, 36000, true, 0, 0, sdk.NewInt(1024), sdk.NewInt(-1), sdk.ZeroInt(), false),
+			valid: false,
+		},
+		{
+			desc:  "burn without min out",
+			msg:   types.NewMsgBurnLiquidity(sender, native, token, 36000, true, 0, 0, sdk.NewInt(1024), sdk.Int{}, sdk.Int{}, false),
+			valid: false,
+		},
+		{
+			desc:  "valid harvest",
+			msg:   types.NewMsgHarvest(sender, native, token, 36000, -1024, 1024, false),
+			valid: true,
+		},
+		{
+			desc:  "harvest with bad sender",
+			msg:   types.NewMsgHarvest("althea1bad", native, token, 36000, -1024, 1024, false),
+			valid: false,
+		},
+		{
+			desc:  "valid range incentive registration",
+			msg:   types.NewMsgRegisterIncentivePosition(sender, native, token, 36000, false, -1024, 1024),
+			valid: true,
+		},
+		{
+			desc:  "incentive registration with inverted pool",
+			msg:   types.NewMsgRegisterIncentivePosition(sender, token, native, 36000, true, 0, 0),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -1034,6 +1035,9 @@ func (m *ExecuteContractProposal) GetMetadata() ExecuteContractMetadata {
 type ExecuteContractMetadata struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	Data            string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// The amount of the native token (in its base denom) sent from the nativedex module account with the call, empty
+	// sends no value
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *ExecuteContractMetadata) Reset()         { *m = ExecuteContractMetadata{} }
@@ -1083,517 +1087,729 @@ func (m *ExecuteContractMetadata) GetData() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*UpgradeProxyProposal)(nil), "althea.nativedex.v1.UpgradeProxyProposal")
-	proto.RegisterType((*UpgradeProxyMetadata)(nil), "althea.nativedex.v1.UpgradeProxyMetadata")
-	proto.RegisterType((*CollectTreasuryProposal)(nil), "althea.nativedex.v1.CollectTreasuryProposal")
-	proto.RegisterType((*CollectTreasuryMetadata)(nil), "althea.nativedex.v1.CollectTreasuryMetadata")
-	proto.RegisterType((*SetTreasuryProposal)(nil), "althea.nativedex.v1.SetTreasuryProposal")
-	proto.RegisterType((*SetTreasuryMetadata)(nil), "althea.nativedex.v1.SetTreasuryMetadata")
-	proto.RegisterType((*AuthorityTransferProposal)(nil), "althea.nativedex.v1.AuthorityTransferProposal")
-	proto.RegisterType((*AuthorityTransferMetadata)(nil), "althea.nativedex.v1.AuthorityTransferMetadata")
-	proto.RegisterType((*HotPathOpenProposal)(nil), "althea.nativedex.v1.HotPathOpenProposal")
-	proto.RegisterType((*HotPathOpenMetadata)(nil), "althea.nativedex.v1.HotPathOpenMetadata")
-	proto.RegisterType((*SetSafeModeProposal)(nil), "althea.nativedex.v1.SetSafeModeProposal")
-	proto.RegisterType((*SetSafeModeMetadata)(nil), "althea.nativedex.v1.SetSafeModeMetadata")
-	proto.RegisterType((*TransferGovernanceProposal)(nil), "althea.nativedex.v1.TransferGovernanceProposal")
-	proto.RegisterType((*TransferGovernanceMetadata)(nil), "althea.nativedex.v1.TransferGovernanceMetadata")
-	proto.RegisterType((*OpsProposal)(nil), "althea.nativedex.v1.OpsProposal")
-	proto.RegisterType((*OpsMetadata)(nil), "althea.nativedex.v1.OpsMetadata")
-	proto.RegisterType((*ExecuteContractProposal)(nil), "althea.nativedex.v1.ExecuteContractProposal")
-	proto.RegisterType((*ExecuteContractMetadata)(nil), "althea.nativedex.v1.ExecuteContractMetadata")
+func (m *ExecuteContractMetadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("althea/nativedex/v1/nativedex.proto", fileDescriptor_8a3d4c3de1cf69d0)
+// BatchDexProposal will execute an ordered list of DEX governance actions atomically, if any action fails then the
+// whole proposal fails and none of the actions take effect.
+// This allows dependent changes, like a pool template change and the revision of the pools using it, to land together.
+//
+// BE VERY CAREFUL EXECUTING THIS PROPOSAL, EACH ACTION CARRIES THE SAME RISKS AS THE EQUIVALENT SINGLE PROPOSAL
+type BatchDexProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Actions     []DexAction `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions"`
 }
 
-var fileDescriptor_8a3d4c3de1cf69d0 = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x63, 0xc8, 0xbd, 0x84, 0x49, 0xb8, 0x17, 0x19, 0x24, 0x92, 0xe8, 0x2a, 0xe4, 0x82,
-	0x2a, 0x05, 0xa9, 0xc4, 0x4a, 0x2b, 0xb5, 0x15, 0xab, 0x06, 0x28, 0x6d, 0x69, 0x29, 0xc1, 0xd0,
-	0x4d, 0x17, 0x8d, 0x06, 0xfb, 0xe0, 0x58, 0x38, 0x33, 0xd6, 0xcc, 0x24, 0x4a, 0x1e, 0xa0, 0x8b,
-	0xee, 0xfa, 0x08, 0x7d, 0x88, 0x3e, 0x04, 0x52, 0x37, 0xa8, 0xab, 0x6e, 0x5a, 0x55, 0x64, 0xd3,
-	0x3e, 0x44, 0xa5, 0xca, 0xe3, 0x3f, 0x24, 0x71, 0x58, 0x05, 0xc8, 0x2a, 0x73, 0xbe, 0x71, 0x7c,
-	0xce, 0xf7, 0xd3, 0x19, 0xcf, 0x41, 0xab, 0xd8, 0x11, 0x0d, 0xc0, 0x1a, 0xc1, 0xc2, 0x6e, 0x83,
-	0x09, 0x1d, 0xad, 0x5d, 0xb9, 0x0c, 0xca, 0x2e, 0xa3, 0x82, 0xaa, 0x0b, 0xfe, 0x43, 0xe5, 0x4b,
-	0xbd, 0x5d, 0xc9, 0x2f, 0x5a, 0xd4, 0xa2, 0x72, 0x5f, 0xf3, 0x56, 0xfe, 0xa3, 0xf9, 0x9c, 0x41,
-	0x79, 0x93, 0xf2, 0xba, 0xbf, 0xe1, 0x07, 0xfe, 0xd6, 0xca, 0x37, 0x05, 0x2d, 0xbe, 0x76, 0x2d,
-	0x86, 0x4d, 0xa8, 0x31, 0xda, 0xe9, 0xd6, 0x18, 0x75, 0x29, 0xc7, 0x8e, 0xba, 0x88, 0xfe, 0x12,
-	0xb6, 0x70, 0x20, 0xab, 0x14, 0x95, 0xd2, 0xac, 0xee, 0x07, 0x6a, 0x11, 0xa5, 0x4d, 0xe0, 0x06,
-	0xb3, 0x5d, 0x61, 0x53, 0x92, 0x9d, 0x92, 0x7b, 0xfd, 0x92, 0xfa, 0x02, 0xa5, 0x9a, 0x20, 0xb0,
-	0x89, 0x05, 0xce, 0x4e, 0x17, 0x95, 0x52, 0xfa, 0xde, 0x5a, 0x79, 0x44, 0xa5, 0xe5, 0xfe, 0xa4,
-	0x7b, 0xc1, 0x1f, 0x36, 0x93, 0x67, 0xdf, 0x97, 0x13, 0x7a, 0xf4, 0x82, 0x8d, 0xc7, 0x3f, 0x3f,
-	0x2e, 0x27, 0xbe, 0x7c, 0x5a, 0x7f, 0x64, 0xd9, 0xa2, 0xd1, 0x3a, 0x2e, 0x1b, 0xb4, 0x19, 0x94,
-	0x1f, 0xfc, 0xac, 0x73, 0xf3, 0x54, 0xeb, 0x68, 0x16, 0x6d, 0x6b, 0xa2, 0xeb, 0x02, 0xd7, 0xda,
-	0x95, 0x63, 0x10, 0xb8, 0x52, 0xde, 0xa2, 0x44, 0x00, 0x11, 0x2b, 0x64, 0xd0, 0x5e, 0x98, 0x49,
-	0x5d, 0x43, 0xf3, 0x06, 0x76, 0x1c, 0x17, 0x8b, 0x46, 0x1d, 0x9b, 0x26, 0x03, 0xce, 0x03, 0xa7,
-	0xff, 0x86, 0x7a, 0xd5, 0x97, 0xd5, 0x3b, 0xe8, 0x9f, 0xe8, 0x51, 0x9b, 0x98, 0xd0, 0x91, 0xb6,
-	0x93, 0xfa, 0x5c, 0xa8, 0x3e, 0xf7, 0xc4, 0x8d, 0xa4, 0x57, 0xeb, 0xca, 0xbb, 0x29, 0xb4, 0xb4,
-	0x45, 0x1d, 0x07, 0x0c, 0x71, 0xc4, 0x00, 0xf3, 0x16, 0x1b, 0x1f, 0xe9, 0xab, 0x18, 0xd2, 0xbb,
-	0x23, 0x91, 0x0e, 0xe5, 0xbd, 0x8a, 0xaa, 0x5a, 0x44, 0x19, 0x9b, 0xd4, 0x39, 0x3e, 0x81, 0x7a,
-	0x93, 0x9a, 0x90, 0x4d, 0x16, 0x95, 0x52, 0x4a, 0x47, 0x36, 0x39, 0xc4, 0x27, 0xb0, 0x47, 0x4d,
-	0xb8, 0x06, 0xee, 0xdb, 0x31, 0x0c, 0x11, 0xfa, 0x55, 0x34, 0x27, 0xe8, 0x29, 0x90, 0x21, 0xee,
-	0x19, 0x29, 0x06, 0xd0, 0x03, 0x9a, 0xbf, 0x15, 0xb4, 0x70, 0x08, 0xd7, 0x47, 0x72, 0x37, 0x46,
-	0xb2, 0x34, 0x92, 0x64, 0x5f, 0xce, 0x89, 0x52, 0xdc, 0x19, 0xb0, 0xdf, 0xdf, 0xbc, 0x22, 0xd0,
-	0x86, 0x9b, 0x37, 0xd4, 0x07, 0x39, 0xbe, 0x9f, 0x42, 0xb9, 0x6a, 0x4b, 0x34, 0x28, 0xb3, 0x45,
-	0xf7, 0x88, 0x61, 0xc2, 0x4f, 0x80, 0x8d, 0x4d, 0xb3, 0x16, 0xa3, 0x59, 0x1e, 0x49, 0x33, 0x96,
-	0x79, 0xc2, 0x9d, 0x99, 0xbb, 0xb2, 0x20, 0xf5, 0x7f, 0x94, 0xc1, 0xad, 0xd8, 0x27, 0x21, 0xed,
-	0x69, 0xf1, 0xce, 0x7c, 0x46, 0x45, 0x0d, 0x8b, 0xc6, 0xbe, 0x0b, 0xe4, 0xd6, 0x3a, 0xb3, 0x2f,
-	0xe7, 0x44, 0x29, 0x6a, 0x03, 0xf6, 0x23, 0x7e, 0x2a, 0x4a, 0x52, 0x17, 0x88, 0x74, 0x9f, 0xd2,
-	0xe5, 0x7a, 0xf0, 0x28, 0x87, 0x25, 0xdc, 0xe6, 0x51, 0x0e, 0x73, 0x4e, 0x14, 0xd8, 0x83, 0x01,
-	0xfb, 0x11, 0xb0, 0x1c, 0x4a, 0x39, 0xd4, 0x38, 0xad, 0x7b, 0xd7, 0x8a, 0x0f, 0x6d, 0xc6, 0x8b,
-	0xb7, 0xa3, 0x0b, 0xe5, 0x97, 0x82, 0xf2, 0x61, 0x9b, 0x3e, 0xa5, 0x6d, 0x60, 0x04, 0x13, 0x63,
-	0x7c, 0x7c, 0x07, 0x31, 0x7c, 0xda, 0x48, 0x7c, 0xf1, 0xd4, 0x37, 0x78, 0x59, 0xeb, 0xa3, 0xac,
-	0x46, 0xa8, 0xe6, 0xd1, 0x34, 0x75, 0xc3, 0x23, 0xe9, 0x2d, 0xd5, 0xff, 0xd0, 0x2c, 0x34, 0x81,
-	0x59, 0x40, 0x8c, 0x6e, 0x60, 0xf2, 0x52, 0x08, 0xf8, 0x7d, 0x56, 0x50, 0x7a, 0xdf, 0xe5, 0x63,
-	0x03, 0xdb, 0x8c, 0x01, 0x2b, 0x8e, 0x04, 0xb6, 0xef, 0xf2, 0x1b, 0x24, 0xb4, 0x2b, 0xcd, 0x44,
-	0x48, 0xf2, 0x28, 0x15, 0x0e, 0x21, 0xd2, 0x4f, 0x52, 0x8f, 0x62, 0xaf, 0xb3, 0x8c, 0xa6, 0x59,
-	0xc7, 0xcc, 0xe2, 0xd2, 0x4f, 0x46, 0x9f, 0x31, 0x9a, 0x66, 0x95, 0x59, 0xe1, 0x27, 0xac, 0xa7,
-	0xa0, 0xa5, 0x27, 0x1d, 0x30, 0x5a, 0x02, 0xbc, 0xd7, 0x33, 0x6c, 0x88, 0x5b, 0x1b, 0x55, 0x86,
-	0xf2, 0xde, 0x20, 0xb1, 0xb7, 0x31, 0x93, 0x03, 0x33, 0x60, 0xa0, 0xc5, 0x66, 0xc0, 0x40, 0x0f,
-	0x67, 0x40, 0x15, 0x25, 0xa5, 0x27, 0xdf, 0xb2, 0x5c, 0xfb, 0x14, 0x37, 0x0f, 0xce, 0x2e, 0x0a,
-	0xca, 0xf9, 0x45, 0x41, 0xf9, 0x71, 0x51, 0x50, 0x3e, 0xf4, 0x0a, 0x89, 0xf3, 0x5e, 0x21, 0xf1,
-	0xb5, 0x57, 0x48, 0xbc, 0x79, 0xd8, 0x57, 0x73, 0x55, 0x32, 0xd8, 0xa1, 0x2d, 0x62, 0x62, 0x0f,
-	0x94, 0xe6, 0x43, 0x59, 0x7f, 0x59, 0xd1, 0x3a, 0x7d, 0x63, 0xbe, 0xb4, 0x70, 0xfc, 0xb7, 0x1c,
-	0xcd, 0xef, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x94, 0xf7, 0xce, 0xce, 0x07, 0x0c, 0x00, 0x00,
+func (m *BatchDexProposal) Reset()         { *m = BatchDexProposal{} }
+func (m *BatchDexProposal) String() string { return proto.CompactTextString(m) }
+func (*BatchDexProposal) ProtoMessage()    {}
+func (*BatchDexProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{18}
+}
+func (m *BatchDexProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchDexProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchDexProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchDexProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDexProposal.Merge(m, src)
+}
+func (m *BatchDexProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchDexProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDexProposal.DiscardUnknown(m)
 }
 
-func (m *UpgradeProxyProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchDexProposal proto.InternalMessageInfo
+
+func (m *BatchDexProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpgradeProxyProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchDexProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *UpgradeProxyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *BatchDexProposal) GetActions() []DexAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// DexAction is a single action of a BatchDexProposal, exactly one of the metadata fields must be set and the action is
+// executed exactly like the proposal type with the same metadata
+type DexAction struct {
+	UpgradeProxy       *UpgradeProxyMetadata       `protobuf:"bytes,1,opt,name=upgrade_proxy,json=upgradeProxy,proto3" json:"upgrade_proxy,omitempty"`
+	CollectTreasury    *CollectTreasuryMetadata    `protobuf:"bytes,2,opt,name=collect_treasury,json=collectTreasury,proto3" json:"collect_treasury,omitempty"`
+	SetTreasury        *SetTreasuryMetadata        `protobuf:"bytes,3,opt,name=set_treasury,json=setTreasury,proto3" json:"set_treasury,omitempty"`
+	AuthorityTransfer  *AuthorityTransferMetadata  `protobuf:"bytes,4,opt,name=authority_transfer,json=authorityTransfer,proto3" json:"authority_transfer,omitempty"`
+	HotPathOpen        *HotPathOpenMetadata        `protobuf:"bytes,5,opt,name=hot_path_open,json=hotPathOpen,proto3" json:"hot_path_open,omitempty"`
+	SetSafeMode        *SetSafeModeMetadata        `protobuf:"bytes,6,opt,name=set_safe_mode,json=setSafeMode,proto3" json:"set_safe_mode,omitempty"`
+	TransferGovernance *TransferGovernanceMetadata `protobuf:"bytes,7,opt,name=transfer_governance,json=transferGovernance,proto3" json:"transfer_governance,omitempty"`
+	Ops                *OpsMetadata                `protobuf:"bytes,8,opt,name=ops,proto3" json:"ops,omitempty"`
+	ExecuteContract    *ExecuteContractMetadata    `protobuf:"bytes,9,opt,name=execute_contract,json=executeContract,proto3" json:"execute_contract,omitempty"`
+	TreasuryCmd        *TreasuryCmdMetadata        `protobuf:"bytes,10,opt,name=treasury_cmd,json=treasuryCmd,proto3" json:"treasury_cmd,omitempty"`
+	// must be true if the DEX will be in safe mode when this action executes, used by the collect_treasury,
+	// set_treasury, authority_transfer, hot_path_open, and set_safe_mode actions
+	InSafeMode bool `protobuf:"varint,11,opt,name=in_safe_mode,json=inSafeMode,proto3" json:"in_safe_mode,omitempty"`
+}
+
+func (m *DexAction) Reset()         { *m = DexAction{} }
+func (m *DexAction) String() string { return proto.CompactTextString(m) }
+func (*DexAction) ProtoMessage()    {}
+func (*DexAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{19}
+}
+func (m *DexAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintNativedex(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DexAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexAction.Merge(m, src)
+}
+func (m *DexAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexAction.DiscardUnknown(m)
 }
 
-func (m *UpgradeProxyMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DexAction proto.InternalMessageInfo
+
+func (m *DexAction) GetUpgradeProxy() *UpgradeProxyMetadata {
+	if m != nil {
+		return m.UpgradeProxy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpgradeProxyMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DexAction) GetCollectTreasury() *CollectTreasuryMetadata {
+	if m != nil {
+		return m.CollectTreasury
+	}
+	return nil
 }
 
-func (m *UpgradeProxyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CallpathIndex != 0 {
-		i = encodeVarintNativedex(dAtA, i, uint64(m.CallpathIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CallpathAddress) > 0 {
-		i -= len(m.CallpathAddress)
-		copy(dAtA[i:], m.CallpathAddress)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.CallpathAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *DexAction) GetSetTreasury() *SetTreasuryMetadata {
+	if m != nil {
+		return m.SetTreasury
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CollectTreasuryProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DexAction) GetAuthorityTransfer() *AuthorityTransferMetadata {
+	if m != nil {
+		return m.AuthorityTransfer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CollectTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DexAction) GetHotPathOpen() *HotPathOpenMetadata {
+	if m != nil {
+		return m.HotPathOpen
+	}
+	return nil
 }
 
-func (m *CollectTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InSafeMode {
-		i--
-		if m.InSafeMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintNativedex(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *DexAction) GetSetSafeMode() *SetSafeModeMetadata {
+	if m != nil {
+		return m.SetSafeMode
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *DexAction) GetTransferGovernance() *TransferGovernanceMetadata {
+	if m != nil {
+		return m.TransferGovernance
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CollectTreasuryMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DexAction) GetOps() *OpsMetadata {
+	if m != nil {
+		return m.Ops
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CollectTreasuryMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DexAction) GetExecuteContract() *ExecuteContractMetadata {
+	if m != nil {
+		return m.ExecuteContract
+	}
+	return nil
 }
 
-func (m *CollectTreasuryMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenAddress) > 0 {
-		i -= len(m.TokenAddress)
-		copy(dAtA[i:], m.TokenAddress)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.TokenAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *DexAction) GetTreasuryCmd() *TreasuryCmdMetadata {
+	if m != nil {
+		return m.TreasuryCmd
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SetTreasuryProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DexAction) GetInSafeMode() bool {
+	if m != nil {
+		return m.InSafeMode
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SetTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TreasuryCmdMetadata struct {
+	Callpath uint64 `protobuf:"varint,1,opt,name=callpath,proto3" json:"callpath,omitempty"`
+	CmdArgs  []byte `protobuf:"bytes,2,opt,name=cmd_args,json=cmdArgs,proto3" json:"cmd_args,omitempty"`
 }
 
-func (m *SetTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InSafeMode {
-		i--
-		if m.InSafeMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *TreasuryCmdMetadata) Reset()         { *m = TreasuryCmdMetadata{} }
+func (m *TreasuryCmdMetadata) String() string { return proto.CompactTextString(m) }
+func (*TreasuryCmdMetadata) ProtoMessage()    {}
+func (*TreasuryCmdMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{20}
+}
+func (m *TreasuryCmdMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryCmdMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryCmdMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintNativedex(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SetTreasuryMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TreasuryCmdMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryCmdMetadata.Merge(m, src)
 }
-
-func (m *SetTreasuryMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TreasuryCmdMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryCmdMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryCmdMetadata.DiscardUnknown(m)
 }
 
-func (m *SetTreasuryMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TreasuryAddress) > 0 {
-		i -= len(m.TreasuryAddress)
-		copy(dAtA[i:], m.TreasuryAddress)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.TreasuryAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_TreasuryCmdMetadata proto.InternalMessageInfo
+
+func (m *TreasuryCmdMetadata) GetCallpath() uint64 {
+	if m != nil {
+		return m.Callpath
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AuthorityTransferProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryCmdMetadata) GetCmdArgs() []byte {
+	if m != nil {
+		return m.CmdArgs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AuthorityTransferProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CancelTimelockedProposal will remove a passed proposal from the timelock queue before it is executed
+// The CrocPolicy emergency role may also cancel queued proposals with MsgCancelTimelockedProposal
+type CancelTimelockedProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	QueuedId    uint64 `protobuf:"varint,3,opt,name=queued_id,json=queuedId,proto3" json:"queued_id,omitempty"`
 }
 
-func (m *AuthorityTransferProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InSafeMode {
-		i--
-		if m.InSafeMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *CancelTimelockedProposal) Reset()         { *m = CancelTimelockedProposal{} }
+func (m *CancelTimelockedProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelTimelockedProposal) ProtoMessage()    {}
+func (*CancelTimelockedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{21}
+}
+func (m *CancelTimelockedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelTimelockedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelTimelockedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintNativedex(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AuthorityTransferMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CancelTimelockedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTimelockedProposal.Merge(m, src)
+}
+func (m *CancelTimelockedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelTimelockedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTimelockedProposal.DiscardUnknown(m)
 }
 
-func (m *AuthorityTransferMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CancelTimelockedProposal proto.InternalMessageInfo
+
+func (m *CancelTimelockedProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *AuthorityTransferMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AuthAddress) > 0 {
-		i -= len(m.AuthAddress)
-		copy(dAtA[i:], m.AuthAddress)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.AuthAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *CancelTimelockedProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *HotPathOpenProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CancelTimelockedProposal) GetQueuedId() uint64 {
+	if m != nil {
+		return m.QueuedId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HotPathOpenProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AddWhitelistedContractProposal will add a contract to the addresses callable with ExecuteContractProposal, replacing
+// the allowed function selectors if the contract is already whitelisted
+type AddWhitelistedContractProposal struct {
+	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The 4 byte function selectors (e.g. "0xa9059cbb") the contract may be called with, empty allows any call
+	AllowedSelectors []string `protobuf:"bytes,4,rep,name=allowed_selectors,json=allowedSelectors,proto3" json:"allowed_selectors,omitempty"`
 }
 
-func (m *HotPathOpenProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InSafeMode {
-		i--
-		if m.InSafeMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *AddWhitelistedContractProposal) Reset()         { *m = AddWhitelistedContractProposal{} }
+func (m *AddWhitelistedContractProposal) String() string { return proto.CompactTextString(m) }
+func (*AddWhitelistedContractProposal) ProtoMessage()    {}
+func (*AddWhitelistedContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{22}
+}
+func (m *AddWhitelistedContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddWhitelistedContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddWhitelistedContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintNativedex(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AddWhitelistedContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWhitelistedContractProposal.Merge(m, src)
+}
+func (m *AddWhitelistedContractProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddWhitelistedContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWhitelistedContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWhitelistedContractProposal proto.InternalMessageInfo
+
+func (m *AddWhitelistedContractProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *AddWhitelistedContractProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *HotPathOpenMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddWhitelistedContractProposal) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HotPathOpenMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddWhitelistedContractProposal) GetAllowedSelectors() []string {
+	if m != nil {
+		return m.AllowedSelectors
+	}
+	return nil
 }
 
-func (m *HotPathOpenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Open {
-		i--
-		if m.Open {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// RemoveWhitelistedContractProposal will remove a contract from the addresses callable with ExecuteContractProposal
+type RemoveWhitelistedContractProposal struct {
+	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *RemoveWhitelistedContractProposal) Reset()         { *m = RemoveWhitelistedContractProposal{} }
+func (m *RemoveWhitelistedContractProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveWhitelistedContractProposal) ProtoMessage()    {}
+func (*RemoveWhitelistedContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{23}
+}
+func (m *RemoveWhitelistedContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveWhitelistedContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveWhitelistedContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RemoveWhitelistedContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveWhitelistedContractProposal.Merge(m, src)
+}
+func (m *RemoveWhitelistedContractProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveWhitelistedContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveWhitelistedContractProposal.DiscardUnknown(m)
 }
 
-func (m *SetSafeModeProposal) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_RemoveWhitelistedContractProposal proto.InternalMessageInfo
+
+func (m *RemoveWhitelistedContractProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveWhitelistedContractProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RemoveWhitelistedContractProposal) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// CreateIncentiveProgramProposal will fund a liquidity mining program for a DEX pool from the community pool,
+// withdrawing reward_per_epoch * epochs into the nativedex_incentives module account when the proposal executes. The
+// IncentiveEpochBlocks param must be set
+type CreateIncentiveProgramProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Base        string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Quote       string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx     uint64 `protobuf:"varint,5,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	// The rewards split between the pool's liquidity providers at the end of each epoch
+	RewardPerEpoch types.Coin `protobuf:"bytes,6,opt,name=reward_per_epoch,json=rewardPerEpoch,proto3" json:"reward_per_epoch"`
+	Epochs         uint64     `protobuf:"varint,7,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *CreateIncentiveProgramProposal) Reset()         { *m = CreateIncentiveProgramProposal{} }
+func (m *CreateIncentiveProgramProposal) String() string { return proto.CompactTextString(m) }
+func (*CreateIncentiveProgramProposal) ProtoMessage()    {}
+func (*CreateIncentiveProgramProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{24}
+}
+func (m *CreateIncentiveProgramProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateIncentiveProgramProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateIncentiveProgramProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateIncentiveProgramProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIncentiveProgramProposal.Merge(m, src)
+}
+func (m *CreateIncentiveProgramProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateIncentiveProgramProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIncentiveProgramProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateIncentiveProgramProposal proto.InternalMessageInfo
+
+func (m *CreateIncentiveProgramProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateIncentiveProgramProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateIncentiveProgramProposal) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *CreateIncentiveProgramProposal) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *CreateIncentiveProgramProposal) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *CreateIncentiveProgramProposal) GetRewardPerEpoch() types.Coin {
+	if m != nil {
+		return m.RewardPerEpoch
+	}
+	return types.Coin{}
+}
+
+func (m *CreateIncentiveProgramProposal) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// CancelIncentiveProgramProposal will end a liquidity mining program, returning its remaining funds to the community
+// pool. Rewards already paid to liquidity providers may still be claimed
+type CancelIncentiveProgramProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ProgramId   uint64 `protobuf:"varint,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *CancelIncentiveProgramProposal) Reset()         { *m = CancelIncentiveProgramProposal{} }
+func (m *CancelIncentiveProgramProposal) String() string { return proto.CompactTextString(m) }
+func (*CancelIncentiveProgramProposal) ProtoMessage()    {}
+func (*CancelIncentiveProgramProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3d4c3de1cf69d0, []int{25}
+}
+func (m *CancelIncentiveProgramProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelIncentiveProgramProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelIncentiveProgramProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelIncentiveProgramProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIncentiveProgramProposal.Merge(m, src)
+}
+func (m *CancelIncentiveProgramProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelIncentiveProgramProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIncentiveProgramProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIncentiveProgramProposal proto.InternalMessageInfo
+
+func (m *CancelIncentiveProgramProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CancelIncentiveProgramProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CancelIncentiveProgramProposal) GetProgramId() uint64 {
+	if m != nil {
+		return m.ProgramId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UpgradeProxyProposal)(nil), "althea.nativedex.v1.UpgradeProxyProposal")
+	proto.RegisterType((*UpgradeProxyMetadata)(nil), "althea.nativedex.v1.UpgradeProxyMetadata")
+	proto.RegisterType((*CollectTreasuryProposal)(nil), "althea.nativedex.v1.CollectTreasuryProposal")
+	proto.RegisterType((*CollectTreasuryMetadata)(nil), "althea.nativedex.v1.CollectTreasuryMetadata")
+	proto.RegisterType((*SetTreasuryProposal)(nil), "althea.nativedex.v1.SetTreasuryProposal")
+	proto.RegisterType((*SetTreasuryMetadata)(nil), "althea.nativedex.v1.SetTreasuryMetadata")
+	proto.RegisterType((*AuthorityTransferProposal)(nil), "althea.nativedex.v1.AuthorityTransferProposal")
+	proto.RegisterType((*AuthorityTransferMetadata)(nil), "althea.nativedex.v1.AuthorityTransferMetadata")
+	proto.RegisterType((*HotPathOpenProposal)(nil), "althea.nativedex.v1.HotPathOpenProposal")
+	proto.RegisterType((*HotPathOpenMetadata)(nil), "althea.nativedex.v1.HotPathOpenMetadata")
+	proto.RegisterType((*SetSafeModeProposal)(nil), "althea.nativedex.v1.SetSafeModeProposal")
+	proto.RegisterType((*SetSafeModeMetadata)(nil), "althea.nativedex.v1.SetSafeModeMetadata")
+	proto.RegisterType((*TransferGovernanceProposal)(nil), "althea.nativedex.v1.TransferGovernanceProposal")
+	proto.RegisterType((*TransferGovernanceMetadata)(nil), "althea.nativedex.v1.TransferGovernanceMetadata")
+	proto.RegisterType((*OpsProposal)(nil), "althea.nativedex.v1.OpsProposal")
+	proto.RegisterType((*OpsMetadata)(nil), "althea.nativedex.v1.OpsMetadata")
+	proto.RegisterType((*ExecuteContractProposal)(nil), "althea.nativedex.v1.ExecuteContractProposal")
+	proto.RegisterType((*ExecuteContractMetadata)(nil), "althea.nativedex.v1.ExecuteContractMetadata")
+	proto.RegisterType((*BatchDexProposal)(nil), "althea.nativedex.v1.BatchDexProposal")
+	proto.RegisterType((*DexAction)(nil), "althea.nativedex.v1.DexAction")
+	proto.RegisterType((*TreasuryCmdMetadata)(nil), "althea.nativedex.v1.TreasuryCmdMetadata")
+	proto.RegisterType((*CancelTimelockedProposal)(nil), "althea.nativedex.v1.CancelTimelockedProposal")
+	proto.RegisterType((*AddWhitelistedContractProposal)(nil), "althea.nativedex.v1.AddWhitelistedContractProposal")
+	proto.RegisterType((*RemoveWhitelistedContractProposal)(nil), "althea.nativedex.v1.RemoveWhitelistedContractProposal")
+	proto.RegisterType((*CreateIncentiveProgramProposal)(nil), "althea.nativedex.v1.CreateIncentiveProgramProposal")
+	proto.RegisterType((*CancelIncentiveProgramProposal)(nil), "althea.nativedex.v1.CancelIncentiveProgramProposal")
+}
+
+func init() {
+	proto.RegisterFile("althea/nativedex/v1/nativedex.proto", fileDescriptor_8a3d4c3de1cf69d0)
+}
+
+var fileDescriptor_8a3d4c3de1cf69d0 = []byte{
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x8e, 0x13, 0x37, 0xd9, 0x7d, 0x37, 0x69, 0xd3, 0xd9, 0xe8, 0x17, 0x27, 0x3f, 0xd8, 0x6e,
+	0x5b, 0x21, 0xa5, 0x82, 0xae, 0x95, 0x22, 0x01, 0xea, 0x01, 0x91, 0x3f, 0x2d, 0xa4, 0x7f, 0x53,
+	0xa7, 0xa8, 0x12, 0x12, 0x32, 0x13, 0xcf, 0xdb, 0x5d, 0xab, 0x5e, 0x8f, 0x6b, 0xcf, 0x6e, 0x37,
+	0x1f, 0x80, 0x03, 0x37, 0x3e, 0x02, 0x5f, 0x01, 0x89, 0x0b, 0x57, 0x4e, 0x95, 0xe0, 0x50, 0x71,
+	0xe2, 0x02, 0xaa, 0x9a, 0x0b, 0x5c, 0xb9, 0x23, 0xa1, 0x99, 0xb1, 0x77, 0x37, 0xb1, 0x53, 0x91,
+	0xee, 0xb6, 0x39, 0x65, 0xe6, 0x19, 0x7b, 0xde, 0x79, 0x9e, 0xbc, 0xef, 0x33, 0xef, 0x1a, 0x2e,
+	0xd2, 0x40, 0xb4, 0x90, 0xda, 0x21, 0x15, 0x7e, 0x17, 0x19, 0xf6, 0xec, 0xee, 0xea, 0x60, 0xd2,
+	0x88, 0x62, 0x2e, 0x38, 0xa9, 0xea, 0x87, 0x1a, 0x03, 0xbc, 0xbb, 0xba, 0xbc, 0xd0, 0xe4, 0x4d,
+	0xae, 0xd6, 0x6d, 0x39, 0xd2, 0x8f, 0x2e, 0x2f, 0x79, 0x3c, 0x69, 0xf3, 0xc4, 0xd5, 0x0b, 0x7a,
+	0x92, 0x2e, 0xd5, 0xf4, 0xcc, 0xde, 0xa5, 0x09, 0xda, 0xdd, 0xd5, 0x5d, 0x14, 0x74, 0xd5, 0xf6,
+	0xb8, 0x1f, 0xea, 0xf5, 0x0b, 0xbf, 0x1b, 0xb0, 0xf0, 0x79, 0xd4, 0x8c, 0x29, 0xc3, 0xed, 0x98,
+	0xf7, 0xf6, 0xb6, 0x63, 0x1e, 0xf1, 0x84, 0x06, 0x64, 0x01, 0x4e, 0x09, 0x5f, 0x04, 0x68, 0x19,
+	0x75, 0x63, 0xa5, 0xec, 0xe8, 0x09, 0xa9, 0x43, 0x85, 0x61, 0xe2, 0xc5, 0x7e, 0x24, 0x7c, 0x1e,
+	0x5a, 0x93, 0x6a, 0x6d, 0x18, 0x22, 0x37, 0xa1, 0xd4, 0x46, 0x41, 0x19, 0x15, 0xd4, 0x9a, 0xaa,
+	0x1b, 0x2b, 0x95, 0x2b, 0x97, 0x1a, 0x05, 0x4c, 0x1a, 0xc3, 0x41, 0x6f, 0xa7, 0x2f, 0xac, 0x9b,
+	0x4f, 0xff, 0x38, 0x37, 0xe1, 0xf4, 0x37, 0xb8, 0xfa, 0xc9, 0x9f, 0xdf, 0x9d, 0x9b, 0xf8, 0xf5,
+	0x87, 0xcb, 0x1f, 0x35, 0x7d, 0xd1, 0xea, 0xec, 0x36, 0x3c, 0xde, 0x4e, 0xe9, 0xa5, 0x7f, 0x2e,
+	0x27, 0xec, 0x91, 0xdd, 0xb3, 0x9b, 0xbc, 0x6b, 0x8b, 0xbd, 0x08, 0x93, 0x8c, 0x62, 0x63, 0x83,
+	0x87, 0x02, 0x43, 0x71, 0x21, 0x84, 0x85, 0xa2, 0x48, 0xe4, 0x12, 0xcc, 0x7b, 0x34, 0x08, 0x22,
+	0x2a, 0x5a, 0x2e, 0x65, 0x2c, 0xc6, 0x24, 0x49, 0x99, 0x9e, 0xc9, 0xf0, 0x35, 0x0d, 0x93, 0x77,
+	0xe0, 0x74, 0xff, 0x51, 0x3f, 0x64, 0xd8, 0x53, 0xb4, 0x4d, 0x67, 0x2e, 0x43, 0xb7, 0x24, 0x78,
+	0xd5, 0x94, 0x67, 0xbd, 0xf0, 0xf5, 0x24, 0x2c, 0x6e, 0xf0, 0x20, 0x40, 0x4f, 0xdc, 0x8f, 0x91,
+	0x26, 0x9d, 0x78, 0x74, 0x49, 0xef, 0xe4, 0x24, 0x7d, 0xaf, 0x50, 0xd2, 0x43, 0x71, 0x8f, 0x52,
+	0x95, 0xd4, 0x61, 0xd6, 0x0f, 0xdd, 0x84, 0x3e, 0x44, 0xb7, 0xcd, 0x19, 0x5a, 0x66, 0xdd, 0x58,
+	0x29, 0x39, 0xe0, 0x87, 0x3b, 0xf4, 0x21, 0xde, 0xe6, 0x0c, 0xc7, 0xa0, 0xfb, 0x26, 0x2c, 0x1e,
+	0x71, 0x1c, 0x72, 0x11, 0xe6, 0x04, 0x7f, 0x84, 0xe1, 0x21, 0xdd, 0x67, 0x15, 0x98, 0x8a, 0x9e,
+	0xaa, 0xf9, 0x8f, 0x01, 0xd5, 0x1d, 0x1c, 0x9f, 0x92, 0x37, 0x72, 0x4a, 0xae, 0x14, 0x2a, 0xb9,
+	0x83, 0xb9, 0x63, 0x9f, 0x88, 0x8a, 0xd7, 0xa1, 0x5a, 0x70, 0x14, 0x99, 0xbc, 0x22, 0xc5, 0x0e,
+	0x27, 0x6f, 0x86, 0x1f, 0xd4, 0xf1, 0x9b, 0x49, 0x58, 0x5a, 0xeb, 0x88, 0x16, 0x8f, 0x7d, 0xb1,
+	0x77, 0x3f, 0xa6, 0x61, 0xf2, 0x10, 0xe3, 0x91, 0xd5, 0xdc, 0xce, 0xa9, 0xd9, 0x28, 0x54, 0x33,
+	0x17, 0xf9, 0x84, 0x33, 0x73, 0xe9, 0xc8, 0x03, 0x91, 0xf3, 0x30, 0x4b, 0x3b, 0x39, 0x4b, 0xa8,
+	0x48, 0x2c, 0x9f, 0x99, 0x9f, 0x71, 0xb1, 0x4d, 0x45, 0xeb, 0x6e, 0x84, 0xe1, 0x1b, 0xcb, 0xcc,
+	0xa1, 0x98, 0x27, 0xaa, 0xa2, 0x0d, 0xd5, 0x82, 0xa3, 0x10, 0x02, 0x26, 0x8f, 0x30, 0x54, 0xec,
+	0x4b, 0x8e, 0x1a, 0x1f, 0x2c, 0xe5, 0xec, 0x08, 0x6f, 0xb2, 0x94, 0xb3, 0x98, 0x27, 0x2a, 0xd8,
+	0x07, 0x50, 0x2d, 0x38, 0x0a, 0x59, 0x82, 0x52, 0xc0, 0xbd, 0x47, 0xae, 0xbc, 0x56, 0xb4, 0x68,
+	0x33, 0x72, 0xbe, 0xd9, 0xbf, 0x50, 0xfe, 0x32, 0x60, 0x39, 0x4b, 0xd3, 0x4f, 0x79, 0x17, 0xe3,
+	0x90, 0x86, 0xde, 0xe8, 0xf2, 0xdd, 0xcb, 0xc9, 0x67, 0x17, 0xca, 0x97, 0x0f, 0xfd, 0x1a, 0x2f,
+	0x6b, 0x07, 0x96, 0x8f, 0x8e, 0x47, 0xe6, 0x61, 0x8a, 0x47, 0x59, 0x49, 0xca, 0x21, 0x79, 0x0b,
+	0xca, 0xd8, 0xc6, 0xb8, 0x89, 0xa1, 0xb7, 0x97, 0x92, 0x1c, 0x00, 0xa9, 0x7e, 0x3f, 0x1b, 0x50,
+	0xb9, 0x1b, 0x25, 0x23, 0x0b, 0xb6, 0x9e, 0x13, 0xac, 0x5e, 0x28, 0xd8, 0xdd, 0x28, 0x79, 0x8d,
+	0x0a, 0xdd, 0x80, 0xca, 0x50, 0x00, 0xb2, 0x0c, 0xa5, 0xac, 0x09, 0x51, 0x7c, 0x4c, 0xa7, 0x3f,
+	0x97, 0x99, 0xe5, 0xb5, 0x99, 0x4b, 0xe3, 0x66, 0xa2, 0xf8, 0xcc, 0x3a, 0x33, 0x5e, 0x9b, 0xad,
+	0xc5, 0xcd, 0xcc, 0xc2, 0xf6, 0x0d, 0x58, 0xbc, 0xd6, 0x43, 0xaf, 0x23, 0x50, 0x6e, 0x1f, 0x53,
+	0x4f, 0xbc, 0xb1, 0x56, 0xe5, 0x50, 0xdc, 0xd7, 0xa8, 0x98, 0x80, 0xc5, 0x23, 0x82, 0xa9, 0x1e,
+	0x30, 0xc5, 0x72, 0x3d, 0x60, 0x8a, 0x67, 0x3d, 0x20, 0x01, 0x53, 0x71, 0xd2, 0x94, 0xd5, 0x58,
+	0x6a, 0xd4, 0xa5, 0x41, 0x07, 0x15, 0xd1, 0xb2, 0xa3, 0x27, 0xa9, 0xb6, 0xbf, 0x18, 0x30, 0xbf,
+	0x4e, 0x85, 0xd7, 0xda, 0xc4, 0xde, 0xc8, 0xa2, 0x7e, 0x0c, 0x33, 0xd4, 0x93, 0xa3, 0xc4, 0x9a,
+	0xaa, 0x4f, 0xad, 0x54, 0xae, 0xd4, 0x0a, 0x35, 0xdd, 0xc4, 0xde, 0x9a, 0x7a, 0x2c, 0x55, 0x31,
+	0x7b, 0x69, 0x0c, 0x22, 0x3e, 0x9f, 0x86, 0x72, 0x7f, 0x7b, 0x72, 0x07, 0xe6, 0x3a, 0xba, 0xa7,
+	0x96, 0xbf, 0x38, 0x7a, 0x7b, 0x96, 0x71, 0xcc, 0x3e, 0xdf, 0x99, 0xed, 0x0c, 0xa1, 0xe4, 0x81,
+	0xfc, 0x3f, 0xa8, 0x5e, 0xd1, 0xcd, 0xda, 0x17, 0x6b, 0xf2, 0x25, 0xc9, 0x73, 0x44, 0x63, 0x29,
+	0xff, 0x6b, 0x07, 0x16, 0xc8, 0x4d, 0x98, 0x4d, 0x70, 0x68, 0xd3, 0x63, 0xb6, 0x7c, 0x4e, 0x25,
+	0x19, 0x80, 0xe4, 0x4b, 0x20, 0x34, 0xeb, 0x1b, 0x5c, 0x91, 0xda, 0x94, 0x65, 0xbe, 0x4a, 0xdf,
+	0xe3, 0x9c, 0xa5, 0x87, 0x97, 0xc8, 0x2d, 0x98, 0x6b, 0x71, 0xe1, 0xaa, 0x5f, 0x19, 0xea, 0x0a,
+	0x3d, 0x75, 0xbc, 0x2e, 0xc0, 0xa9, 0xb4, 0x06, 0xa0, 0xdc, 0x4d, 0x32, 0x1f, 0x5c, 0x69, 0xd3,
+	0xc7, 0xbb, 0x22, 0x15, 0xf5, 0x0c, 0x24, 0x5f, 0x41, 0x35, 0x23, 0xec, 0x36, 0xfb, 0xc6, 0x6c,
+	0xcd, 0xbc, 0xd2, 0xbd, 0xe1, 0x10, 0x91, 0x5b, 0x23, 0x57, 0xb4, 0xb7, 0x97, 0xfe, 0x9b, 0xb1,
+	0x6a, 0xf7, 0x7f, 0x00, 0xf3, 0xa8, 0x2b, 0xdb, 0xcd, 0xca, 0xd5, 0x2a, 0x1f, 0xdf, 0x73, 0x9c,
+	0x33, 0x78, 0x70, 0x41, 0xa6, 0x4d, 0xbf, 0xbd, 0xf6, 0xda, 0xcc, 0x82, 0x97, 0x68, 0x97, 0xa5,
+	0xc7, 0x46, 0x9b, 0x0d, 0xb4, 0x13, 0x03, 0x30, 0xd7, 0x5b, 0x54, 0x72, 0xbd, 0x85, 0x76, 0x0c,
+	0x07, 0xaa, 0x05, 0x7b, 0x8d, 0xe6, 0xf0, 0xdf, 0x1b, 0x60, 0x6d, 0x48, 0x7d, 0x83, 0xfb, 0x7e,
+	0x1b, 0x65, 0x5f, 0x81, 0x6c, 0x64, 0x37, 0xfa, 0x3f, 0x94, 0x1f, 0x77, 0xb0, 0x83, 0xcc, 0xf5,
+	0x99, 0xaa, 0x28, 0xd3, 0x29, 0x69, 0x60, 0x8b, 0x8d, 0xc1, 0x6a, 0xfe, 0x36, 0xa0, 0xb6, 0xc6,
+	0xd8, 0x83, 0x96, 0x2f, 0x30, 0xf0, 0x13, 0x81, 0x6c, 0x6c, 0x97, 0x53, 0x91, 0xdf, 0x4f, 0x15,
+	0xfb, 0xfd, 0xbb, 0x70, 0x96, 0x06, 0x01, 0x7f, 0x82, 0xcc, 0x4d, 0x50, 0x7a, 0x0a, 0x8f, 0x13,
+	0xcb, 0xac, 0x4f, 0xad, 0x94, 0x9d, 0xf9, 0x74, 0x61, 0x27, 0xc3, 0xc7, 0x40, 0xfa, 0xa9, 0x01,
+	0xe7, 0x1d, 0x6c, 0xf3, 0x2e, 0x9e, 0x2c, 0xef, 0x31, 0x50, 0xf9, 0x69, 0x12, 0x6a, 0x1b, 0x31,
+	0x52, 0x81, 0x5b, 0xa1, 0x87, 0xa1, 0x2c, 0x95, 0xed, 0x98, 0x37, 0x63, 0xda, 0x1e, 0x99, 0x07,
+	0x01, 0x53, 0x7e, 0xc6, 0x4a, 0xcf, 0xae, 0xc6, 0x72, 0xaf, 0xc7, 0x1d, 0x2e, 0x74, 0xcf, 0x5e,
+	0x76, 0xf4, 0x44, 0x56, 0x46, 0xc4, 0x79, 0xe0, 0xfa, 0xac, 0xa7, 0x7c, 0xd4, 0x74, 0x66, 0xe4,
+	0x7c, 0x8b, 0xf5, 0xc8, 0x16, 0xcc, 0xc7, 0xf8, 0x84, 0xc6, 0xcc, 0x8d, 0x30, 0x76, 0x31, 0xe2,
+	0x5e, 0x2b, 0x35, 0xc7, 0xa5, 0x86, 0xa6, 0xd8, 0x90, 0x1b, 0x37, 0x06, 0xbc, 0xfc, 0xec, 0x42,
+	0x3d, 0xad, 0x5f, 0xdc, 0xc6, 0xf8, 0x9a, 0x7c, 0x8d, 0xfc, 0x0f, 0xa6, 0xd5, 0xfb, 0x89, 0x72,
+	0x42, 0xd3, 0x49, 0x67, 0x63, 0x10, 0xf1, 0x47, 0x03, 0x6a, 0xba, 0x70, 0xc7, 0x2e, 0xe2, 0xdb,
+	0x00, 0x91, 0xde, 0x6a, 0x50, 0xbf, 0xe5, 0x14, 0x19, 0x47, 0x01, 0xaf, 0xdf, 0x7b, 0xfa, 0xa2,
+	0x66, 0x3c, 0x7b, 0x51, 0x33, 0x9e, 0xbf, 0xa8, 0x19, 0xdf, 0xee, 0xd7, 0x26, 0x9e, 0xed, 0xd7,
+	0x26, 0x7e, 0xdb, 0xaf, 0x4d, 0x7c, 0xf1, 0xe1, 0xd0, 0x9e, 0x6b, 0xca, 0x4b, 0xaf, 0xf3, 0x4e,
+	0xc8, 0xa8, 0x3c, 0x97, 0xad, 0xcd, 0xf5, 0xf2, 0xad, 0x55, 0xbb, 0x37, 0xf4, 0x5d, 0x54, 0x85,
+	0xd8, 0x9d, 0x56, 0xdf, 0x2a, 0xdf, 0xff, 0x77, 0x00, 0xf0, 0xbe, 0x4e, 0xfe, 0x38, 0x15, 0x00,
+	0x00,
+}
+
+func (m *UpgradeProxyProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1603,26 +1819,16 @@ func (m *SetSafeModeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetSafeModeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpgradeProxyProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetSafeModeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpgradeProxyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.InSafeMode {
-		i--
-		if m.InSafeMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1650,7 +1856,7 @@ func (m *SetSafeModeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SetSafeModeMetadata) Marshal() (dAtA []byte, err error) {
+func (m *UpgradeProxyMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1660,30 +1866,32 @@ func (m *SetSafeModeMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetSafeModeMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpgradeProxyMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetSafeModeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpgradeProxyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LockDex {
+	if m.CallpathIndex != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.CallpathIndex))
 		i--
-		if m.LockDex {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.CallpathAddress) > 0 {
+		i -= len(m.CallpathAddress)
+		copy(dAtA[i:], m.CallpathAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.CallpathAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TransferGovernanceProposal) Marshal() (dAtA []byte, err error) {
+func (m *CollectTreasuryProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1693,16 +1901,26 @@ func (m *TransferGovernanceProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferGovernanceProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *CollectTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransferGovernanceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CollectTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1730,7 +1948,7 @@ func (m *TransferGovernanceProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *TransferGovernanceMetadata) Marshal() (dAtA []byte, err error) {
+func (m *CollectTreasuryMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1740,34 +1958,27 @@ func (m *TransferGovernanceMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferGovernanceMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *CollectTreasuryMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransferGovernanceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CollectTreasuryMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Emergency) > 0 {
-		i -= len(m.Emergency)
-		copy(dAtA[i:], m.Emergency)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Emergency)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Ops) > 0 {
-		i -= len(m.Ops)
-		copy(dAtA[i:], m.Ops)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Ops)))
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.TokenAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OpsProposal) Marshal() (dAtA []byte, err error) {
+func (m *SetTreasuryProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1777,16 +1988,26 @@ func (m *OpsProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OpsProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetTreasuryProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetTreasuryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1814,7 +2035,7 @@ func (m *OpsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OpsMetadata) Marshal() (dAtA []byte, err error) {
+func (m *SetTreasuryMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1824,32 +2045,27 @@ func (m *OpsMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OpsMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetTreasuryMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpsMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetTreasuryMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CmdArgs) > 0 {
-		i -= len(m.CmdArgs)
-		copy(dAtA[i:], m.CmdArgs)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.CmdArgs)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Callpath != 0 {
-		i = encodeVarintNativedex(dAtA, i, uint64(m.Callpath))
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.TreasuryAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecuteContractProposal) Marshal() (dAtA []byte, err error) {
+func (m *AuthorityTransferProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1859,16 +2075,26 @@ func (m *ExecuteContractProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecuteContractProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthorityTransferProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecuteContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthorityTransferProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1896,7 +2122,7 @@ func (m *ExecuteContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecuteContractMetadata) Marshal() (dAtA []byte, err error) {
+func (m *AuthorityTransferMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1906,366 +2132,3233 @@ func (m *ExecuteContractMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecuteContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuthorityTransferMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecuteContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuthorityTransferMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintNativedex(dAtA, i, uint64(len(m.ContractAddress)))
+	if len(m.AuthAddress) > 0 {
+		i -= len(m.AuthAddress)
+		copy(dAtA[i:], m.AuthAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.AuthAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNativedex(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNativedex(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *UpgradeProxyProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+func (m *HotPathOpenProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpgradeProxyMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CallpathAddress)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	if m.CallpathIndex != 0 {
-		n += 1 + sovNativedex(uint64(m.CallpathIndex))
-	}
-	return n
+func (m *HotPathOpenProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CollectTreasuryProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HotPathOpenProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	if m.InSafeMode {
-		n += 2
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CollectTreasuryMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenAddress)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+func (m *HotPathOpenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetTreasuryProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	if m.InSafeMode {
-		n += 2
-	}
-	return n
+func (m *HotPathOpenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetTreasuryMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HotPathOpenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TreasuryAddress)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthorityTransferProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	if m.InSafeMode {
-		n += 2
+func (m *SetSafeModeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AuthorityTransferMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetSafeModeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSafeModeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AuthAddress)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	return n
-}
-
-func (m *HotPathOpenProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	if m.InSafeMode {
-		n += 2
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HotPathOpenMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Open {
-		n += 2
+func (m *SetSafeModeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetSafeModeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	if m.InSafeMode {
-		n += 2
-	}
-	return n
+func (m *SetSafeModeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetSafeModeMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetSafeModeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.LockDex {
-		n += 2
+		i--
+		if m.LockDex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferGovernanceProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+func (m *TransferGovernanceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TransferGovernanceMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ops)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Emergency)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	return n
+func (m *TransferGovernanceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferGovernanceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OpsMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferGovernanceMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferGovernanceMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferGovernanceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Callpath != 0 {
-		n += 1 + sovNativedex(uint64(m.Callpath))
+	if len(m.Emergency) > 0 {
+		i -= len(m.Emergency)
+		copy(dAtA[i:], m.Emergency)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Emergency)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CmdArgs)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	if len(m.Ops) > 0 {
+		i -= len(m.Ops)
+		copy(dAtA[i:], m.Ops)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Ops)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExecuteContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OpsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OpsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpsMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpsMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpsMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CmdArgs) > 0 {
+		i -= len(m.CmdArgs)
+		copy(dAtA[i:], m.CmdArgs)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.CmdArgs)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Callpath != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.Callpath))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchDexProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchDexProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchDexProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNativedex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InSafeMode {
+		i--
+		if m.InSafeMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TreasuryCmd != nil {
+		{
+			size, err := m.TreasuryCmd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExecuteContract != nil {
+		{
+			size, err := m.ExecuteContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Ops != nil {
+		{
+			size, err := m.Ops.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TransferGovernance != nil {
+		{
+			size, err := m.TransferGovernance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SetSafeMode != nil {
+		{
+			size, err := m.SetSafeMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HotPathOpen != nil {
+		{
+			size, err := m.HotPathOpen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthorityTransfer != nil {
+		{
+			size, err := m.AuthorityTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SetTreasury != nil {
+		{
+			size, err := m.SetTreasury.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CollectTreasury != nil {
+		{
+			size, err := m.CollectTreasury.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpgradeProxy != nil {
+		{
+			size, err := m.UpgradeProxy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNativedex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryCmdMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryCmdMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryCmdMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CmdArgs) > 0 {
+		i -= len(m.CmdArgs)
+		copy(dAtA[i:], m.CmdArgs)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.CmdArgs)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Callpath != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.Callpath))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelTimelockedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelTimelockedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelTimelockedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedId != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.QueuedId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddWhitelistedContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWhitelistedContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddWhitelistedContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSelectors) > 0 {
+		for iNdEx := len(m.AllowedSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSelectors[iNdEx])
+			copy(dAtA[i:], m.AllowedSelectors[iNdEx])
+			i = encodeVarintNativedex(dAtA, i, uint64(len(m.AllowedSelectors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveWhitelistedContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveWhitelistedContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveWhitelistedContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateIncentiveProgramProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateIncentiveProgramProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateIncentiveProgramProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.RewardPerEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNativedex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.PoolIdx != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelIncentiveProgramProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelIncentiveProgramProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelIncentiveProgramProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProgramId != 0 {
+		i = encodeVarintNativedex(dAtA, i, uint64(m.ProgramId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintNativedex(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNativedex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNativedex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpgradeProxyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	return n
+}
+
+func (m *UpgradeProxyMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallpathAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.CallpathIndex != 0 {
+		n += 1 + sovNativedex(uint64(m.CallpathIndex))
+	}
+	return n
+}
+
+func (m *CollectTreasuryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *CollectTreasuryMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *SetTreasuryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetTreasuryMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorityTransferProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *AuthorityTransferMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *HotPathOpenProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *HotPathOpenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Open {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetSafeModeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetSafeModeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockDex {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransferGovernanceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	return n
+}
+
+func (m *TransferGovernanceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ops)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Emergency)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *OpsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	return n
+}
+
+func (m *OpsMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Callpath != 0 {
+		n += 1 + sovNativedex(uint64(m.Callpath))
+	}
+	l = len(m.CmdArgs)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	return n
+}
+
+func (m *ExecuteContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchDexProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovNativedex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DexAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradeProxy != nil {
+		l = m.UpgradeProxy.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.CollectTreasury != nil {
+		l = m.CollectTreasury.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.SetTreasury != nil {
+		l = m.SetTreasury.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.AuthorityTransfer != nil {
+		l = m.AuthorityTransfer.Size()
 		n += 1 + l + sovNativedex(uint64(l))
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovNativedex(uint64(l))
-	return n
-}
+	if m.HotPathOpen != nil {
+		l = m.HotPathOpen.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.SetSafeMode != nil {
+		l = m.SetSafeMode.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.TransferGovernance != nil {
+		l = m.TransferGovernance.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.Ops != nil {
+		l = m.Ops.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.ExecuteContract != nil {
+		l = m.ExecuteContract.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.TreasuryCmd != nil {
+		l = m.TreasuryCmd.Size()
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.InSafeMode {
+		n += 2
+	}
+	return n
+}
+
+func (m *TreasuryCmdMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Callpath != 0 {
+		n += 1 + sovNativedex(uint64(m.Callpath))
+	}
+	l = len(m.CmdArgs)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelTimelockedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.QueuedId != 0 {
+		n += 1 + sovNativedex(uint64(m.QueuedId))
+	}
+	return n
+}
+
+func (m *AddWhitelistedContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if len(m.AllowedSelectors) > 0 {
+		for _, s := range m.AllowedSelectors {
+			l = len(s)
+			n += 1 + l + sovNativedex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveWhitelistedContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateIncentiveProgramProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovNativedex(uint64(m.PoolIdx))
+	}
+	l = m.RewardPerEpoch.Size()
+	n += 1 + l + sovNativedex(uint64(l))
+	if m.Epochs != 0 {
+		n += 1 + sovNativedex(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *CancelIncentiveProgramProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNativedex(uint64(l))
+	}
+	if m.ProgramId != 0 {
+		n += 1 + sovNativedex(uint64(m.ProgramId))
+	}
+	return n
+}
+
+func sovNativedex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNativedex(x uint64) (n int) {
+	return sovNativedex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpgradeProxyProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeProxyProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeProxyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeProxyMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeProxyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallpathAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallpathAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallpathIndex", wireType)
+			}
+			m.CallpathIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallpathIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectTreasuryProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectTreasuryProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSafeMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectTreasuryMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectTreasuryMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectTreasuryMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTreasuryProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSafeMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTreasuryMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTreasuryMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTreasuryMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorityTransferProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorityTransferProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSafeMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorityTransferMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorityTransferMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorityTransferMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotPathOpenProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotPathOpenProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSafeMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotPathOpenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotPathOpenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotPathOpenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSafeModeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSafeModeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSafeModeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSafeMode = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSafeModeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSafeModeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSafeModeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockDex = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferGovernanceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferGovernanceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferGovernanceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferGovernanceMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNativedex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferGovernanceMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferGovernanceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emergency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNativedex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ExecuteContractMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovNativedex(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNativedex(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNativedex(x uint64) (n int) {
-	return sovNativedex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *UpgradeProxyProposal) Unmarshal(dAtA []byte) error {
+func (m *OpsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,10 +5381,10 @@ func (m *UpgradeProxyProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeProxyProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeProxyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2412,7 +5505,7 @@ func (m *UpgradeProxyProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
+func (m *OpsMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,17 +5528,17 @@ func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeProxyMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpsMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeProxyMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpsMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallpathAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callpath", wireType)
 			}
-			var stringLen uint64
+			m.Callpath = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -2455,29 +5548,16 @@ func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Callpath |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallpathAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallpathIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdArgs", wireType)
 			}
-			m.CallpathIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -2487,11 +5567,26 @@ func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallpathIndex |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CmdArgs = append(m.CmdArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CmdArgs == nil {
+				m.CmdArgs = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -2513,7 +5608,7 @@ func (m *UpgradeProxyMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollectTreasuryProposal) Unmarshal(dAtA []byte) error {
+func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2536,10 +5631,10 @@ func (m *CollectTreasuryProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectTreasuryProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2639,26 +5734,6 @@ func (m *CollectTreasuryProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNativedex
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InSafeMode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -2680,7 +5755,7 @@ func (m *CollectTreasuryProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollectTreasuryMetadata) Unmarshal(dAtA []byte) error {
+func (m *ExecuteContractMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2699,19 +5774,83 @@ func (m *CollectTreasuryMetadata) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectTreasuryMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectTreasuryMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2739,7 +5878,7 @@ func (m *CollectTreasuryMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2762,7 +5901,7 @@ func (m *CollectTreasuryMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
+func (m *BatchDexProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2785,10 +5924,10 @@ func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetTreasuryProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchDexProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetTreasuryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchDexProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2857,7 +5996,7 @@ func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2884,30 +6023,11 @@ func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, DexAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNativedex
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InSafeMode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -2929,7 +6049,7 @@ func (m *SetTreasuryProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetTreasuryMetadata) Unmarshal(dAtA []byte) error {
+func (m *DexAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2952,17 +6072,17 @@ func (m *SetTreasuryMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetTreasuryMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetTreasuryMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeProxy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -2972,79 +6092,33 @@ func (m *SetTreasuryMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.UpgradeProxy == nil {
+				m.UpgradeProxy = &UpgradeProxyMetadata{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.UpgradeProxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorityTransferProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorityTransferProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectTreasury", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3054,29 +6128,33 @@ func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if m.CollectTreasury == nil {
+				m.CollectTreasury = &CollectTreasuryMetadata{}
+			}
+			if err := m.CollectTreasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetTreasury", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3086,27 +6164,31 @@ func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.SetTreasury == nil {
+				m.SetTreasury = &SetTreasuryMetadata{}
+			}
+			if err := m.SetTreasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityTransfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3133,15 +6215,18 @@ func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AuthorityTransfer == nil {
+				m.AuthorityTransfer = &AuthorityTransferMetadata{}
+			}
+			if err := m.AuthorityTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotPathOpen", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3151,67 +6236,33 @@ func (m *AuthorityTransferProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InSafeMode = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuthorityTransferMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HotPathOpen == nil {
+				m.HotPathOpen = &HotPathOpenMetadata{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HotPathOpen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorityTransferMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorityTransferMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetSafeMode", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3221,79 +6272,69 @@ func (m *AuthorityTransferMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.SetSafeMode == nil {
+				m.SetSafeMode = &SetSafeModeMetadata{}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNativedex
+			if err := m.SetSafeMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferGovernance", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TransferGovernance == nil {
+				m.TransferGovernance = &TransferGovernanceMetadata{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HotPathOpenProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HotPathOpenProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.TransferGovernance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3303,29 +6344,33 @@ func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if m.Ops == nil {
+				m.Ops = &OpsMetadata{}
+			}
+			if err := m.Ops.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteContract", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3335,27 +6380,31 @@ func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.ExecuteContract == nil {
+				m.ExecuteContract = &ExecuteContractMetadata{}
+			}
+			if err := m.ExecuteContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryCmd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3382,11 +6431,14 @@ func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TreasuryCmd == nil {
+				m.TreasuryCmd = &TreasuryCmdMetadata{}
+			}
+			if err := m.TreasuryCmd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
 			}
@@ -3427,7 +6479,7 @@ func (m *HotPathOpenProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HotPathOpenMetadata) Unmarshal(dAtA []byte) error {
+func (m *TreasuryCmdMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3450,17 +6502,17 @@ func (m *HotPathOpenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HotPathOpenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryCmdMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HotPathOpenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryCmdMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callpath", wireType)
 			}
-			var v int
+			m.Callpath = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3470,12 +6522,45 @@ func (m *HotPathOpenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Callpath |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Open = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdArgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CmdArgs = append(m.CmdArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CmdArgs == nil {
+				m.CmdArgs = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -3497,7 +6582,7 @@ func (m *HotPathOpenMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetSafeModeProposal) Unmarshal(dAtA []byte) error {
+func (m *CancelTimelockedProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3520,10 +6605,10 @@ func (m *SetSafeModeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetSafeModeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelTimelockedProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetSafeModeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelTimelockedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3591,113 +6676,10 @@ func (m *SetSafeModeProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNativedex
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSafeMode", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNativedex
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InSafeMode = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetSafeModeMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetSafeModeMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetSafeModeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedId", wireType)
 			}
-			var v int
+			m.QueuedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3707,12 +6689,11 @@ func (m *SetSafeModeMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.QueuedId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LockDex = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -3734,7 +6715,7 @@ func (m *SetSafeModeMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferGovernanceProposal) Unmarshal(dAtA []byte) error {
+func (m *AddWhitelistedContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3757,10 +6738,10 @@ func (m *TransferGovernanceProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferGovernanceProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddWhitelistedContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferGovernanceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddWhitelistedContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3793,45 +6774,13 @@ func (m *TransferGovernanceProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNativedex
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -3841,78 +6790,27 @@ func (m *TransferGovernanceProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransferGovernanceMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferGovernanceMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferGovernanceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3940,11 +6838,11 @@ func (m *TransferGovernanceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ops = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSelectors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3972,7 +6870,7 @@ func (m *TransferGovernanceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Emergency = string(dAtA[iNdEx:postIndex])
+			m.AllowedSelectors = append(m.AllowedSelectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3995,7 +6893,7 @@ func (m *TransferGovernanceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpsProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveWhitelistedContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4018,10 +6916,10 @@ func (m *OpsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveWhitelistedContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveWhitelistedContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4090,9 +6988,9 @@ func (m *OpsProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -4102,24 +7000,23 @@ func (m *OpsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4142,7 +7039,7 @@ func (m *OpsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpsMetadata) Unmarshal(dAtA []byte) error {
+func (m *CreateIncentiveProgramProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4165,17 +7062,17 @@ func (m *OpsMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpsMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateIncentiveProgramProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpsMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateIncentiveProgramProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callpath", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Callpath = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -4185,16 +7082,29 @@ func (m *OpsMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Callpath |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNativedex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNativedex
@@ -4204,79 +7114,27 @@ func (m *OpsMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNativedex
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNativedex
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CmdArgs = append(m.CmdArgs[:0], dAtA[iNdEx:postIndex]...)
-			if m.CmdArgs == nil {
-				m.CmdArgs = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNativedex(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthNativedex
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNativedex
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteContractProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4304,11 +7162,11 @@ func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4336,11 +7194,30 @@ func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4367,10 +7244,29 @@ func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -4392,7 +7288,7 @@ func (m *ExecuteContractProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteContractMetadata) Unmarshal(dAtA []byte) error {
+func (m *CancelIncentiveProgramProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,15 +7311,15 @@ func (m *ExecuteContractMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteContractMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelIncentiveProgramProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelIncentiveProgramProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4451,11 +7347,11 @@ func (m *ExecuteContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4483,8 +7379,27 @@ func (m *ExecuteContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			m.ProgramId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNativedex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNativedex(dAtA[iNdEx:])
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxOracleObservations bounds the OracleMaxObservations param, limiting the state and TWAP cost of each oracle pool
+const MaxOracleObservations uint64 = 10000
+
+// NativePriceTwapWindow is the TWAP window used to value tokens in the native token for other modules, e.g. to price
+// gasfree alternative fees. The OracleInterval must be short enough to record an observation within every window
+const NativePriceTwapWindow = time.Hour
+
+// PriceOracle is implemented by the nativedex keeper for modules which need to value one token in another, using
+// prices recorded from the DEX instead of reading its manipulable spot price
+type PriceOracle interface {
+	// GetTwap returns the time weighted average value of one quote token base unit in base token base units over the
+	// window ending at the current block
+	GetTwap(ctx sdk.Context, base, quote common.Address, poolIdx uint64, window time.Duration) (sdk.Dec, error)
+	// GetTokenPrice returns the time weighted average value of one base unit of token in base units of denom, using
+	// the first oracle pool which trades the pair
+	GetTokenPrice(ctx sdk.Context, token, denom common.Address, window time.Duration) (sdk.Dec, error)
+}
+
+// NewOraclePool returns the oracle pool of base and quote with the given template
+func NewOraclePool(base, quote common.Address, poolIdx uint64) OraclePool {
+	return OraclePool{Base: base.Hex(), Quote: quote.Hex(), PoolIdx: poolIdx}
+}
+
+// Tokens returns the EVM addresses of the pool's base and quote tokens
+func (p OraclePool) Tokens() (base, quote common.Address) {
+	return common.HexToAddress(p.Base), common.HexToAddress(p.Quote)
+}
+
+func (p OraclePool) ValidateBasic() error {
+	if !common.IsHexAddress(p.Base) {
+		return errorsmod.Wrapf(ErrInvalidEvmAddress, "invalid base address: %s", p.Base)
+	}
+	if !common.IsHexAddress(p.Quote) {
+		return errorsmod.Wrapf(ErrInvalidEvmAddress, "invalid quote address: %s", p.Quote)
+	}
+	if common.HexToAddress(p.Base) == common.HexToAddress(p.Quote) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "base and quote must differ")
+	}
+	return nil
+}
+
+// ValidateBasic checks that the pool is valid and its observations are ordered by height with non-negative prices
+func (o OracleObservations) ValidateBasic() error {
+	if err := o.Pool.ValidateBasic(); err != nil {
+		return err
+	}
+	for i, observation := range o.Observations {
+		if observation.Price.IsNil() || observation.Price.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidOracle, "invalid price at height %d", observation.Height)
+		}
+		if i > 0 && observation.Height <= o.Observations[i-1].Height {
+			return errorsmod.Wrapf(ErrInvalidOracle, "observation at height %d is out of order", observation.Height)
+		}
+	}
+	return nil
+}
+
+// ComputeTwap returns the time weighted average price of observations (oldest first) between start and end, each
+// price applying until the next observation. If the oldest observation is later than start the average begins at
+// that observation, and the actual start time is returned. The latest observation must be within the window, so that
+// a pool which is no longer observed does not report a stale price as current
+func ComputeTwap(observations []PriceObservation, start, end time.Time) (sdk.Dec, time.Time, error) {
+	// Ignore observations recorded after end
+	n := sort.Search(len(observations), func(i int) bool { return observations[i].Time.After(end) })
+	observations = observations[:n]
+	if len(observations) == 0 {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrap(ErrInvalidOracle, "no price observations before the end of the window")
+	}
+	if latest := observations[len(observations)-1].Time; latest.Before(start) {
+		return sdk.Dec{}, time.Time{}, errorsmod.Wrapf(ErrInvalidOracle, "the latest price observation at %s is older than the window", latest)
+	}
+
+	// The observation in effect at start is the last one at or before it
+	first := sort.Search(len(observations), func(i int) bool { return observations[i].Time.After(start) })
+	if first == 0 {
+		start = observations[0].Time
+	} else {
+		first--
+	}
+
+	total := end.Sub(start)
+	if total.Milliseconds() <= 0 {
+		return observations[len(observations)-1].Price, start, nil
+	}
+	sum := sdk.ZeroDec()
+	for i := first; i < len(observations); i++ {
+		from := observations[i].Time
+		if from.Before(start) {
+			from = start
+		}
+		to := end
+		if i+1 < len(observations) {
+			to = observations[i+1].Time
+		}
+		sum = sum.Add(observations[i].Price.MulInt64(to.Sub(from).Milliseconds()))
+	}
+	return sum.QuoInt64(total.Milliseconds()), start, nil
+}
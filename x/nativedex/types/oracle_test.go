@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestComputeTwap(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	observations := []types.PriceObservation{
+		{Height: 1, Time: t0, Price: sdk.NewDec(10)},
+		{Height: 2, Time: t0.Add(10 * time.Second), Price: sdk.NewDec(20)},
+		{Height: 3, Time: t0.Add(30 * time.Second), Price: sdk.NewDec(40)},
+	}
+
+	// 10 for 10s, 20 for 20s and 40 for 10s
+	twap, start, err := types.ComputeTwap(observations, t0, t0.Add(40*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("22.5"), twap)
+	require.Equal(t, t0, start)
+
+	// The observation in effect at the start of the window applies from the start
+	twap, start, err = types.ComputeTwap(observations, t0.Add(20*time.Second), t0.Add(40*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30), twap)
+	require.Equal(t, t0.Add(20*time.Second), start)
+
+	// A window longer than the history starts at the oldest observation
+	twap, start, err = types.ComputeTwap(observations, t0.Add(-time.Hour), t0.Add(20*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(15), twap)
+	require.Equal(t, t0, start)
+
+	// Observations after the end of the window are ignored
+	twap, _, err = types.ComputeTwap(observations, t0.Add(5*time.Second), t0.Add(10*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), twap)
+
+	// An empty window returns the latest price
+	twap, _, err = types.ComputeTwap(observations, t0.Add(30*time.Second), t0.Add(30*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), twap)
+
+	// The latest observation is older than the window
+	_, _, err = types.ComputeTwap(observations, t0.Add(time.Minute), t0.Add(time.Minute))
+	require.Error(t, err)
+	_, _, err = types.ComputeTwap(observations, t0.Add(31*time.Second), t0.Add(time.Hour))
+	require.Error(t, err)
+
+	_, _, err = types.ComputeTwap(observations, t0.Add(-time.Hour), t0.Add(-time.Minute))
+	require.Error(t, err)
+	_, _, err = types.ComputeTwap([]types.PriceObservation{}, t0, t0.Add(time.Minute))
+	require.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MinTick and MaxTick bound the int24 ticks used by the DEX
+	MinTick = -(1 << 23)
+	MaxTick = (1 << 23) - 1
+)
+
+// NewAmbientPosition returns a Position identifying owner's ambient position in the (base, quote, poolIdx) pool
+func NewAmbientPosition(owner sdk.AccAddress, base, quote common.Address, poolIdx uint64) Position {
+	return Position{
+		Owner:     owner.String(),
+		Base:      base.Hex(),
+		Quote:     quote.Hex(),
+		PoolIdx:   poolIdx,
+		Ambient:   true,
+		LowerTick: 0,
+		UpperTick: 0,
+	}
+}
+
+// NewRangePosition returns a Position identifying owner's range position in the (base, quote, poolIdx) pool
+func NewRangePosition(owner sdk.AccAddress, base, quote common.Address, poolIdx uint64, lowerTick, upperTick int32) Position {
+	return Position{
+		Owner:     owner.String(),
+		Base:      base.Hex(),
+		Quote:     quote.Hex(),
+		PoolIdx:   poolIdx,
+		Ambient:   false,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}
+}
+
+// Key returns the store key of the position, which must be valid
+func (p Position) Key() []byte {
+	return GetPositionKey(
+		sdk.MustAccAddressFromBech32(p.Owner), common.HexToAddress(p.Base), common.HexToAddress(p.Quote), p.PoolIdx,
+		p.Ambient, p.LowerTick, p.UpperTick,
+	)
+}
+
+// ValidateBasic checks the position's owner, pool, and ticks
+func (p Position) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner")
+	}
+	if err := ValidatePool(p.Base, p.Quote, p.PoolIdx); err != nil {
+		return err
+	}
+	if p.Ambient {
+		if p.LowerTick != 0 || p.UpperTick != 0 {
+			return errorsmod.Wrap(ErrInvalidPosition, "ambient positions have no ticks")
+		}
+		return nil
+	}
+	return ValidateTicks(p.LowerTick, p.UpperTick)
+}
+
+// ValidatePool checks that base and quote are EVM addresses in the DEX's pool order, and that poolIdx is set
+func ValidatePool(base, quote string, poolIdx uint64) error {
+	if !common.IsHexAddress(base) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid base")
+	}
+	if !common.IsHexAddress(quote) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid quote")
+	}
+	if common.HexToAddress(base).Big().Cmp(common.HexToAddress(quote).Big()) >= 0 {
+		return errorsmod.Wrap(ErrInvalidPool, "base must be lexically smaller than quote")
+	}
+	if poolIdx == 0 {
+		return errorsmod.Wrap(ErrInvalidPool, "pool index must be positive")
+	}
+	return nil
+}
+
+// ValidateTicks checks that the ticks of a range position are ordered and fit in an int24
+func ValidateTicks(lowerTick, upperTick int32) error {
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return errorsmod.Wrapf(ErrInvalidPosition, "ticks must be between %d and %d", MinTick, MaxTick)
+	}
+	if lowerTick >= upperTick {
+		return errorsmod.Wrap(ErrInvalidPosition, "lower tick must be below upper tick")
+	}
+	return nil
+}
+
+// ValidatePositions checks every position and that none are duplicated
+func ValidatePositions(positions []Position) error {
+	seen := make(map[string]bool, len(positions))
+	for i, p := range positions {
+		if err := p.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "position %d", i)
+		}
+		key := string(p.Key())
+		if seen[key] {
+			return errorsmod.Wrap(ErrInvalidPosition, fmt.Sprintf("duplicate position %d", i))
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"math"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -19,12 +22,37 @@ const (
 	ProposalTypeTransferGovernance string = "TransferGovernance"
 	ProposalTypeOps                string = "Ops"
 	ProposalTypeExecuteContract    string = "ExecuteContract"
+	ProposalTypeBatchDex           string = "BatchDex"
+	ProposalTypeCancelTimelocked   string = "CancelTimelocked"
+	ProposalTypeAddWhitelisted     string = "AddWhitelistedContract"
+	ProposalTypeRemoveWhitelisted  string = "RemoveWhitelistedContract"
+	ProposalTypeCreateIncentive    string = "CreateIncentiveProgram"
+	ProposalTypeCancelIncentive    string = "CancelIncentiveProgram"
+	MaxBatchActions                int    = 32
 	MaxDescriptionLength           int    = 1000
 	MaxTitleLength                 int    = 140
 )
 
 var AcceptableCallpathIndexes []uint64 = []uint64{1, 2, 3, 4, 5, 6, 7, 3500, 9999}
 
+// TimelockableProposalTypes are the proposal types which may be set in the TimelockedProposalTypes param
+var TimelockableProposalTypes = []string{
+	ProposalTypeUpgradeProxy, ProposalTypeCollectTreasury, ProposalTypeSetTreasury, ProposalTypeAuthorityTransfer,
+	ProposalTypeHotPathOpen, ProposalTypeSetSafeMode, ProposalTypeTransferGovernance, ProposalTypeOps,
+	ProposalTypeExecuteContract, ProposalTypeBatchDex, ProposalTypeAddWhitelisted, ProposalTypeRemoveWhitelisted,
+	ProposalTypeCreateIncentive, ProposalTypeCancelIncentive,
+}
+
+// protocolCmd codes of the treasuryResolution commands, used to identify raw TreasuryCmd batch actions
+const (
+	authorityTransferCmdCode uint8 = 20
+	upgradeProxyCmdCode      uint8 = 21
+	hotPathOpenCmdCode       uint8 = 22
+	setSafeModeCmdCode       uint8 = 23
+	collectTreasuryCmdCode   uint8 = 40
+	setTreasuryCmdCode       uint8 = 41
+)
+
 // nolint: exhaustruct
 var (
 	_ govv1beta1.Content = &UpgradeProxyProposal{}
@@ -35,6 +63,12 @@ var (
 	_ govv1beta1.Content = &SetSafeModeProposal{}
 	_ govv1beta1.Content = &TransferGovernanceProposal{}
 	_ govv1beta1.Content = &ExecuteContractProposal{}
+	_ govv1beta1.Content = &BatchDexProposal{}
+	_ govv1beta1.Content = &CancelTimelockedProposal{}
+	_ govv1beta1.Content = &AddWhitelistedContractProposal{}
+	_ govv1beta1.Content = &RemoveWhitelistedContractProposal{}
+	_ govv1beta1.Content = &CreateIncentiveProgramProposal{}
+	_ govv1beta1.Content = &CancelIncentiveProgramProposal{}
 )
 
 // Register Compound Proposal type as a valid proposal type in goveranance module
@@ -49,6 +83,12 @@ func init() {
 	govv1beta1.RegisterProposalType(ProposalTypeTransferGovernance)
 	govv1beta1.RegisterProposalType(ProposalTypeOps)
 	govv1beta1.RegisterProposalType(ProposalTypeExecuteContract)
+	govv1beta1.RegisterProposalType(ProposalTypeBatchDex)
+	govv1beta1.RegisterProposalType(ProposalTypeCancelTimelocked)
+	govv1beta1.RegisterProposalType(ProposalTypeAddWhitelisted)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveWhitelisted)
+	govv1beta1.RegisterProposalType(ProposalTypeCreateIncentive)
+	govv1beta1.RegisterProposalType(ProposalTypeCancelIncentive)
 }
 
 func NewUpgradeProxyProposal(title, description string, md UpgradeProxyMetadata) govv1beta1.Content {
@@ -70,16 +110,7 @@ func (p *UpgradeProxyProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-	if !common.IsHexAddress(md.CallpathAddress) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid callpath address")
-	}
-
-	if md.CallpathIndex == 0 {
-		return ErrInvalidCallpath
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewCollectTreasuryProposal(title, description string, md CollectTreasuryMetadata, inSafeMode bool) govv1beta1.Content {
@@ -102,12 +133,7 @@ func (p *CollectTreasuryProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-	if !common.IsHexAddress(md.TokenAddress) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid token address")
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewSetTreasuryProposal(title, description string, md SetTreasuryMetadata, inSafeMode bool) govv1beta1.Content {
@@ -130,12 +156,7 @@ func (p *SetTreasuryProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-	if !common.IsHexAddress(md.TreasuryAddress) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid treasury address")
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewAuthorityTransferProposal(title, description string, md AuthorityTransferMetadata, inSafeMode bool) govv1beta1.Content {
@@ -158,12 +179,7 @@ func (p *AuthorityTransferProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-	if !common.IsHexAddress(md.AuthAddress) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid auth address")
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewHotPathOpenProposal(title, description string, md HotPathOpenMetadata, inSafeMode bool) govv1beta1.Content {
@@ -186,10 +202,7 @@ func (p *HotPathOpenProposal) ValidateBasic() error {
 		return err
 	}
 
-	// The only check to perform here is that the type is valid, which is already a given
-	_ = p.GetMetadata()
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewSetSafeModeProposal(title, description string, md SetSafeModeMetadata, inSafeMode bool) govv1beta1.Content {
@@ -212,10 +225,7 @@ func (p *SetSafeModeProposal) ValidateBasic() error {
 		return err
 	}
 
-	// The only check to perform here is that the type is valid, which is already a given
-	_ = p.GetMetadata()
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewTransferGovernanceProposal(title, description string, md TransferGovernanceMetadata) govv1beta1.Content {
@@ -237,17 +247,7 @@ func (p *TransferGovernanceProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-
-	if !common.IsHexAddress(md.Ops) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid ops address")
-	}
-
-	if !common.IsHexAddress(md.Emergency) {
-		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid emergency address")
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewOpsProposal(title, description string, md OpsMetadata) govv1beta1.Content {
@@ -269,17 +269,7 @@ func (p *OpsProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
-
-	if md.Callpath == 0 {
-		return ErrInvalidCallpath
-	}
-
-	if len(md.CmdArgs) == 0 {
-		return errorsmod.Wrap(govtypes.ErrInvalidProposalContent, "cmd args has zero length")
-	}
-
-	return nil
+	return p.Metadata.ValidateBasic()
 }
 
 func NewExecuteContractProposal(title, description string, md ExecuteContractMetadata) govv1beta1.Content {
@@ -301,8 +291,207 @@ func (p *ExecuteContractProposal) ValidateBasic() error {
 		return err
 	}
 
-	md := p.GetMetadata()
+	return p.Metadata.ValidateBasic()
+}
+
+func NewBatchDexProposal(title, description string, actions []DexAction) govv1beta1.Content {
+	return &BatchDexProposal{
+		Title:       title,
+		Description: description,
+		Actions:     actions,
+	}
+}
+
+func (*BatchDexProposal) ProposalRoute() string { return RouterKey }
+
+func (*BatchDexProposal) ProposalType() string {
+	return ProposalTypeBatchDex
+}
+
+func (p *BatchDexProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Actions) == 0 {
+		return errorsmod.Wrap(govtypes.ErrInvalidProposalContent, "batch has no actions")
+	}
+	if len(p.Actions) > MaxBatchActions {
+		return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "batch has more than %d actions", MaxBatchActions)
+	}
+	for i, action := range p.Actions {
+		if err := action.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "action %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that exactly one metadata field of the action is set, and that it is valid
+func (a DexAction) ValidateBasic() error {
+	var set []interface{ ValidateBasic() error }
+	if a.UpgradeProxy != nil {
+		set = append(set, a.UpgradeProxy)
+	}
+	if a.CollectTreasury != nil {
+		set = append(set, a.CollectTreasury)
+	}
+	if a.SetTreasury != nil {
+		set = append(set, a.SetTreasury)
+	}
+	if a.AuthorityTransfer != nil {
+		set = append(set, a.AuthorityTransfer)
+	}
+	if a.HotPathOpen != nil {
+		set = append(set, a.HotPathOpen)
+	}
+	if a.SetSafeMode != nil {
+		set = append(set, a.SetSafeMode)
+	}
+	if a.TransferGovernance != nil {
+		set = append(set, a.TransferGovernance)
+	}
+	if a.Ops != nil {
+		set = append(set, a.Ops)
+	}
+	if a.ExecuteContract != nil {
+		set = append(set, a.ExecuteContract)
+	}
+	if a.TreasuryCmd != nil {
+		set = append(set, a.TreasuryCmd)
+	}
+
+	if len(set) != 1 {
+		return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "dex action must set exactly one metadata field, got %d", len(set))
+	}
+	return set[0].ValidateBasic()
+}
+
+// ProposalType returns the type of the single proposal equivalent to the action. Raw TreasuryCmd actions are identified
+// by their command code, and are considered BatchDex actions if the code is not one of the treasury proposal commands
+func (a DexAction) ProposalType() string {
+	switch {
+	case a.UpgradeProxy != nil:
+		return ProposalTypeUpgradeProxy
+	case a.CollectTreasury != nil:
+		return ProposalTypeCollectTreasury
+	case a.SetTreasury != nil:
+		return ProposalTypeSetTreasury
+	case a.AuthorityTransfer != nil:
+		return ProposalTypeAuthorityTransfer
+	case a.HotPathOpen != nil:
+		return ProposalTypeHotPathOpen
+	case a.SetSafeMode != nil:
+		return ProposalTypeSetSafeMode
+	case a.TransferGovernance != nil:
+		return ProposalTypeTransferGovernance
+	case a.Ops != nil:
+		return ProposalTypeOps
+	case a.ExecuteContract != nil:
+		return ProposalTypeExecuteContract
+	case a.TreasuryCmd != nil:
+		return a.TreasuryCmd.ProposalType()
+	default:
+		return ProposalTypeBatchDex
+	}
+}
+
+// ProposalType returns the type of the proposal which encodes the same command, the command code is the first
+// ABI encoded word of the cmd args
+func (md TreasuryCmdMetadata) ProposalType() string {
+	if len(md.CmdArgs) < 32 {
+		return ProposalTypeBatchDex
+	}
+	switch md.CmdArgs[31] {
+	case authorityTransferCmdCode:
+		return ProposalTypeAuthorityTransfer
+	case upgradeProxyCmdCode:
+		return ProposalTypeUpgradeProxy
+	case hotPathOpenCmdCode:
+		return ProposalTypeHotPathOpen
+	case setSafeModeCmdCode:
+		return ProposalTypeSetSafeMode
+	case collectTreasuryCmdCode:
+		return ProposalTypeCollectTreasury
+	case setTreasuryCmdCode:
+		return ProposalTypeSetTreasury
+	default:
+		return ProposalTypeBatchDex
+	}
+}
+
+func (md UpgradeProxyMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(md.CallpathAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid callpath address")
+	}
+
+	if md.CallpathIndex == 0 {
+		return ErrInvalidCallpath
+	}
+
+	return nil
+}
+
+func (md CollectTreasuryMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(md.TokenAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid token address")
+	}
+
+	return nil
+}
+
+func (md SetTreasuryMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(md.TreasuryAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid treasury address")
+	}
+
+	return nil
+}
+
+func (md AuthorityTransferMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(md.AuthAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid auth address")
+	}
+
+	return nil
+}
+
+// ValidateBasic has nothing to check, any value of open is valid
+func (md HotPathOpenMetadata) ValidateBasic() error {
+	return nil
+}
+
+// ValidateBasic has nothing to check, any value of lock_dex is valid
+func (md SetSafeModeMetadata) ValidateBasic() error {
+	return nil
+}
+
+func (md TransferGovernanceMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(md.Ops) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid ops address")
+	}
+
+	if !common.IsHexAddress(md.Emergency) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid emergency address")
+	}
+
+	return nil
+}
+
+func (md OpsMetadata) ValidateBasic() error {
+	if md.Callpath == 0 {
+		return ErrInvalidCallpath
+	}
 
+	if len(md.CmdArgs) == 0 {
+		return errorsmod.Wrap(govtypes.ErrInvalidProposalContent, "cmd args has zero length")
+	}
+
+	return nil
+}
+
+func (md ExecuteContractMetadata) ValidateBasic() error {
 	if !common.IsHexAddress(md.ContractAddress) {
 		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid contract address")
 	}
@@ -316,5 +505,151 @@ func (p *ExecuteContractProposal) ValidateBasic() error {
 		return errorsmod.Wrap(govtypes.ErrInvalidProposalContent, "data must be a hex string starting with 0x")
 	}
 
+	if _, err := md.ValueInt(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValueInt returns the amount of the native token sent with the call, which is zero if Value is empty
+func (md ExecuteContractMetadata) ValueInt() (sdk.Int, error) {
+	if md.Value == "" {
+		return sdk.ZeroInt(), nil
+	}
+	value, ok := sdk.NewIntFromString(md.Value)
+	if !ok || value.IsNegative() {
+		return sdk.Int{}, errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "invalid value %s", md.Value)
+	}
+	return value, nil
+}
+
+func (md TreasuryCmdMetadata) ValidateBasic() error {
+	if md.Callpath > math.MaxUint16 {
+		return errorsmod.Wrap(ErrInvalidCallpath, "callpath must fit in a uint16")
+	}
+
+	if len(md.CmdArgs) == 0 {
+		return errorsmod.Wrap(govtypes.ErrInvalidProposalContent, "cmd args has zero length")
+	}
+
+	return nil
+}
+
+func NewCancelTimelockedProposal(title, description string, queuedID uint64) govv1beta1.Content {
+	return &CancelTimelockedProposal{
+		Title:       title,
+		Description: description,
+		QueuedId:    queuedID,
+	}
+}
+
+func (*CancelTimelockedProposal) ProposalRoute() string { return RouterKey }
+
+func (*CancelTimelockedProposal) ProposalType() string {
+	return ProposalTypeCancelTimelocked
+}
+
+func (p *CancelTimelockedProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(p)
+}
+
+func NewAddWhitelistedContractProposal(title, description, contractAddress string, allowedSelectors []string) govv1beta1.Content {
+	return &AddWhitelistedContractProposal{
+		Title:            title,
+		Description:      description,
+		ContractAddress:  contractAddress,
+		AllowedSelectors: allowedSelectors,
+	}
+}
+
+func (*AddWhitelistedContractProposal) ProposalRoute() string { return RouterKey }
+
+func (*AddWhitelistedContractProposal) ProposalType() string {
+	return ProposalTypeAddWhitelisted
+}
+
+func (p *AddWhitelistedContractProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	// nolint: exhaustruct
+	return ContractSelectors{ContractAddress: p.ContractAddress, Selectors: p.AllowedSelectors}.ValidateBasic()
+}
+
+func NewRemoveWhitelistedContractProposal(title, description, contractAddress string) govv1beta1.Content {
+	return &RemoveWhitelistedContractProposal{
+		Title:           title,
+		Description:     description,
+		ContractAddress: contractAddress,
+	}
+}
+
+func (*RemoveWhitelistedContractProposal) ProposalRoute() string { return RouterKey }
+
+func (*RemoveWhitelistedContractProposal) ProposalType() string {
+	return ProposalTypeRemoveWhitelisted
+}
+
+func (p *RemoveWhitelistedContractProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(p.ContractAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid contract address")
+	}
+	return nil
+}
+
+func NewCreateIncentiveProgramProposal(title, description string, base, quote common.Address, poolIdx uint64, rewardPerEpoch sdk.Coin, epochs uint64) govv1beta1.Content {
+	return &CreateIncentiveProgramProposal{
+		Title:          title,
+		Description:    description,
+		Base:           base.Hex(),
+		Quote:          quote.Hex(),
+		PoolIdx:        poolIdx,
+		RewardPerEpoch: rewardPerEpoch,
+		Epochs:         epochs,
+	}
+}
+
+func (*CreateIncentiveProgramProposal) ProposalRoute() string { return RouterKey }
+
+func (*CreateIncentiveProgramProposal) ProposalType() string {
+	return ProposalTypeCreateIncentive
+}
+
+func (p *CreateIncentiveProgramProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateIncentiveProgram(p.Base, p.Quote, p.PoolIdx, p.RewardPerEpoch, p.Epochs)
+}
+
+func NewCancelIncentiveProgramProposal(title, description string, programID uint64) govv1beta1.Content {
+	return &CancelIncentiveProgramProposal{
+		Title:       title,
+		Description: description,
+		ProgramId:   programID,
+	}
+}
+
+func (*CancelIncentiveProgramProposal) ProposalRoute() string { return RouterKey }
+
+func (*CancelIncentiveProgramProposal) ProposalType() string {
+	return ProposalTypeCancelIncentive
+}
+
+func (p *CancelIncentiveProgramProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.ProgramId == 0 {
+		return errorsmod.Wrap(ErrInvalidIncentive, "program id must be positive")
+	}
 	return nil
 }
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+// nolint: exhaustruct
+func TestBatchDexProposal_ValidateBasic(t *testing.T) {
+	ops := types.DexAction{Ops: &types.OpsMetadata{Callpath: 3, CmdArgs: []byte{0x1}}}
+	setTreasury := types.DexAction{
+		SetTreasury: &types.SetTreasuryMetadata{TreasuryAddress: "0x1111111111111111111111111111111111111111"},
+		InSafeMode:  true,
+	}
+	tooMany := make([]types.DexAction, types.MaxBatchActions+1)
+	for i := range tooMany {
+		tooMany[i] = ops
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		actions []types.DexAction
+		valid   bool
+	}{
+		{
+			desc:    "valid",
+			actions: []types.DexAction{ops, setTreasury},
+			valid:   true,
+		},
+		{
+			desc:    "no actions",
+			actions: []types.DexAction{},
+			valid:   false,
+		},
+		{
+			desc:    "too many actions",
+			actions: tooMany,
+			valid:   false,
+		},
+		{
+			desc:    "action without metadata",
+			actions: []types.DexAction{ops, {InSafeMode: true}},
+			valid:   false,
+		},
+		{
+			desc: "action with two metadata fields",
+			actions: []types.DexAction{{
+				Ops:         ops.Ops,
+				SetTreasury: setTreasury.SetTreasury,
+			}},
+			valid: false,
+		},
+		{
+			desc:    "invalid metadata",
+			actions: []types.DexAction{{Ops: &types.OpsMetadata{Callpath: 0, CmdArgs: []byte{0x1}}}},
+			valid:   false,
+		},
+		{
+			desc:    "treasury cmd callpath beyond uint16",
+			actions: []types.DexAction{{TreasuryCmd: &types.TreasuryCmdMetadata{Callpath: 1 << 16, CmdArgs: []byte{0x1}}}},
+			valid:   false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.NewBatchDexProposal("title", "description", tc.actions).ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// nolint: exhaustruct
+func TestParams_IsTimelocked(t *testing.T) {
+	upgradeCmd := make([]byte, 64)
+	upgradeCmd[31] = 21 // upgradeProxy's protocolCmd code
+	upgrade := types.NewUpgradeProxyProposal("title", "description", types.UpgradeProxyMetadata{})
+	ops := types.NewOpsProposal("title", "description", types.OpsMetadata{Callpath: 3, CmdArgs: []byte{0x1}})
+	batchOps := types.NewBatchDexProposal("title", "description", []types.DexAction{{Ops: &types.OpsMetadata{}}})
+	batchUpgrade := types.NewBatchDexProposal("title", "description", []types.DexAction{
+		{Ops: &types.OpsMetadata{}},
+		{TreasuryCmd: &types.TreasuryCmdMetadata{Callpath: 3, CmdArgs: upgradeCmd}},
+	})
+
+	params := types.DefaultParams()
+	require.False(t, params.IsTimelocked(upgrade), "the timelock is disabled by default")
+
+	params.TimelockBlocks = 100
+	require.True(t, params.IsTimelocked(upgrade))
+	require.False(t, params.IsTimelocked(ops))
+	require.False(t, params.IsTimelocked(batchOps))
+	require.True(t, params.IsTimelocked(batchUpgrade), "a batch containing a timelocked action must be timelocked")
+
+	params.TimelockedProposalTypes = []string{types.ProposalTypeOps}
+	require.False(t, params.IsTimelocked(upgrade))
+	require.True(t, params.IsTimelocked(ops))
+	require.True(t, params.IsTimelocked(batchOps))
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// protocolCmd codes of the opsResolution commands, see solidity-dex/contracts/mixins/ProtocolAccount.sol
+const (
+	opsDisableTemplateCmdCode uint8 = 109
+	opsSetTemplateCmdCode     uint8 = 110
+	opsRevisePoolCmdCode      uint8 = 111
+	opsSetNewPoolLiqCmdCode   uint8 = 112
+	opsPegPriceImproveCmdCode uint8 = 113
+	opsSetTakeRateCmdCode     uint8 = 114
+	opsResyncTakeRateCmdCode  uint8 = 115
+	opsRelayerTakeRateCmdCode uint8 = 116
+)
+
+// protocolCmdLayout names a protocolCmd, and names and types the arguments which follow its code
+type protocolCmdLayout struct {
+	command string
+	names   []string
+	types   []string
+}
+
+var protocolCmdLayouts = map[uint8]protocolCmdLayout{
+	authorityTransferCmdCode:  {"AuthorityTransfer", []string{"auth"}, []string{"address"}},
+	upgradeProxyCmdCode:       {"UpgradeProxy", []string{"proxy", "proxyIdx"}, []string{"address", "uint16"}},
+	hotPathOpenCmdCode:        {"HotPathOpen", []string{"open"}, []string{"bool"}},
+	setSafeModeCmdCode:        {"SetSafeMode", []string{"lockDex"}, []string{"bool"}},
+	collectTreasuryCmdCode:    {"CollectTreasury", []string{"token"}, []string{"address"}},
+	setTreasuryCmdCode:        {"SetTreasury", []string{"treasury"}, []string{"address"}},
+	opsDisableTemplateCmdCode: {"DisableTemplate", []string{"poolIdx"}, []string{"uint256"}},
+	opsSetTemplateCmdCode: {
+		"SetTemplate",
+		[]string{"poolIdx", "feeRate", "tickSize", "jitThresh", "knockout", "oracleFlags"},
+		[]string{"uint256", "uint16", "uint16", "uint8", "uint8", "uint8"},
+	},
+	opsRevisePoolCmdCode: {
+		"RevisePool",
+		[]string{"base", "quote", "poolIdx", "feeRate", "tickSize", "jitThresh", "knockout", "oracleFlags"},
+		[]string{"address", "address", "uint256", "uint16", "uint16", "uint8", "uint8", "uint8"},
+	},
+	opsSetNewPoolLiqCmdCode: {"SetNewPoolLiq", []string{"liq"}, []string{"uint128"}},
+	opsPegPriceImproveCmdCode: {
+		"PegPriceImprove",
+		[]string{"token", "unitTickCollateral", "awayTickTol"},
+		[]string{"address", "uint128", "uint16"},
+	},
+	opsSetTakeRateCmdCode:     {"SetTakeRate", []string{"takeRate"}, []string{"uint8"}},
+	opsResyncTakeRateCmdCode:  {"ResyncTakeRate", []string{"base", "quote", "poolIdx"}, []string{"address", "address", "uint256"}},
+	opsRelayerTakeRateCmdCode: {"SetRelayerTakeRate", []string{"takeRate"}, []string{"uint8"}},
+}
+
+// ProtocolCmdName returns the name of a protocolCmd code, e.g. "SetTemplate" for 110
+func ProtocolCmdName(code uint8) string {
+	if layout, ok := protocolCmdLayouts[code]; ok {
+		return layout.command
+	}
+	return fmt.Sprintf("Unknown(%d)", code)
+}
+
+// DecodeProtocolCmd decodes the ABI encoded command passed to CrocPolicy's treasuryResolution() or opsResolution().
+// The arguments of unknown command codes are not decoded
+func DecodeProtocolCmd(cmd []byte) (uint8, []ProtocolCmdArg, error) {
+	if len(cmd) < 32 {
+		return 0, nil, errorsmod.Wrap(ErrInvalidProposal, "protocol command is shorter than its code")
+	}
+	code := cmd[31]
+	layout, ok := protocolCmdLayouts[code]
+	if !ok {
+		return code, []ProtocolCmdArg{}, nil
+	}
+
+	arguments := abi.Arguments{}
+	for _, typeName := range append([]string{"uint8"}, layout.types...) {
+		abiType, err := abi.NewType(typeName, "", nil)
+		if err != nil {
+			return code, nil, err
+		}
+		arguments = append(arguments, abi.Argument{Name: "", Type: abiType, Indexed: false})
+	}
+	values, err := arguments.Unpack(cmd)
+	if err != nil {
+		return code, nil, errorsmod.Wrapf(ErrInvalidProposal, "unable to decode protocol command %d: %s", code, err)
+	}
+
+	args := make([]ProtocolCmdArg, len(layout.names))
+	for i, name := range layout.names {
+		args[i] = ProtocolCmdArg{Name: name, Type: layout.types[i], Value: formatProtocolCmdValue(values[i+1])}
+	}
+	return code, args, nil
+}
+
+func formatProtocolCmdValue(v interface{}) string {
+	switch value := v.(type) {
+	case common.Address:
+		return value.Hex()
+	case *big.Int:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/contracts"
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestDecodeProtocolCmd(t *testing.T) {
+	treasury := common.HexToAddress("0xd263DC98dEc57828e26F69bA8687281BA5D052E0")
+	base := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	quote := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	setTreasury, err := contracts.EncodeTypes([]string{"uint8", "address"}, []interface{}{uint8(41), treasury})
+	require.NoError(t, err)
+	code, args, err := types.DecodeProtocolCmd(setTreasury)
+	require.NoError(t, err)
+	require.Equal(t, uint8(41), code)
+	require.Equal(t, []types.ProtocolCmdArg{{Name: "treasury", Type: "address", Value: treasury.Hex()}}, args)
+
+	revisePool, err := contracts.EncodeTypes(
+		[]string{"uint8", "address", "address", "uint256", "uint16", "uint16", "uint8", "uint8", "uint8"},
+		[]interface{}{uint8(111), base, quote, big.NewInt(36000), uint16(250), uint16(16), uint8(0), uint8(0), uint8(0)},
+	)
+	require.NoError(t, err)
+	code, args, err = types.DecodeProtocolCmd(revisePool)
+	require.NoError(t, err)
+	require.Equal(t, uint8(111), code)
+	require.Len(t, args, 8)
+	require.Equal(t, types.ProtocolCmdArg{Name: "poolIdx", Type: "uint256", Value: "36000"}, args[2])
+	require.Equal(t, types.ProtocolCmdArg{Name: "feeRate", Type: "uint16", Value: "250"}, args[3])
+
+	// Unknown commands only have their code decoded
+	unknown, err := contracts.EncodeTypes([]string{"uint8", "uint256"}, []interface{}{uint8(200), big.NewInt(1)})
+	require.NoError(t, err)
+	code, args, err = types.DecodeProtocolCmd(unknown)
+	require.NoError(t, err)
+	require.Equal(t, uint8(200), code)
+	require.Empty(t, args)
+
+	_, _, err = types.DecodeProtocolCmd([]byte{0x1})
+	require.Error(t, err)
+	_, _, err = types.DecodeProtocolCmd(setTreasury[:40])
+	require.Error(t, err)
+}
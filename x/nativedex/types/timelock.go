@@ -0,0 +1,97 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// nolint: exhaustruct
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueuedProposal{}
+	_ codectypes.UnpackInterfacesMessage = &GenesisState{}
+)
+
+// NewQueuedProposal returns a QueuedProposal holding content
+func NewQueuedProposal(id uint64, content govv1beta1.Content, queuedHeight, executeHeight uint64) (QueuedProposal, error) {
+	contentAny, err := codectypes.NewAnyWithValue(content)
+	if err != nil {
+		return QueuedProposal{}, errorsmod.Wrap(err, "unable to pack queued proposal content")
+	}
+	return QueuedProposal{
+		Id:            id,
+		Content:       contentAny,
+		QueuedHeight:  queuedHeight,
+		ExecuteHeight: executeHeight,
+	}, nil
+}
+
+// ProposalContent returns the unpacked proposal content, which is nil if the interfaces have not been unpacked
+func (q QueuedProposal) ProposalContent() govv1beta1.Content {
+	if q.Content == nil {
+		return nil
+	}
+	content, ok := q.Content.GetCachedValue().(govv1beta1.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// ValidateBasic checks that the queued proposal holds a valid nativedex proposal
+func (q QueuedProposal) ValidateBasic() error {
+	content := q.ProposalContent()
+	if content == nil {
+		return errorsmod.Wrapf(ErrInvalidProposal, "queued proposal %d has no content", q.Id)
+	}
+	if content.ProposalRoute() != RouterKey {
+		return errorsmod.Wrapf(ErrInvalidProposal, "queued proposal %d is not a %s proposal", q.Id, ModuleName)
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(err, "queued proposal %d", q.Id)
+	}
+	if q.ExecuteHeight < q.QueuedHeight {
+		return errorsmod.Wrapf(ErrInvalidProposal, "queued proposal %d executes before it was queued", q.Id)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueuedProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content govv1beta1.Content
+	return unpacker.UnpackAny(q.Content, &content)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, q := range s.QueuedProposals {
+		if err := q.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsTimelocked returns true if content must wait in the timelock queue before executing. Batches are timelocked if any
+// of their actions is equivalent to a timelocked proposal type
+func (p Params) IsTimelocked(content govv1beta1.Content) bool {
+	if p.TimelockBlocks == 0 {
+		return false
+	}
+	timelocked := make(map[string]bool, len(p.TimelockedProposalTypes))
+	for _, t := range p.TimelockedProposalTypes {
+		timelocked[t] = true
+	}
+	if timelocked[content.ProposalType()] {
+		return true
+	}
+	if batch, ok := content.(*BatchDexProposal); ok {
+		for _, action := range batch.Actions {
+			if timelocked[action.ProposalType()] {
+				return true
+			}
+		}
+	}
+	return false
+}
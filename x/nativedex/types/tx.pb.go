@@ -6,10 +6,17 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,20 +30,1134 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgSwap sells exactly amount_in on the native DEX's (base, quote, pool_idx) pool on behalf of the sender, allowing
+// Cosmos-only accounts to trade without an EVM wallet. amount_in is converted to its registered ERC20 via the erc20
+// module before calling CrocSwapDex.swap() from the sender's EVM address, or sent as the call's value if it is the
+// native token. Input left unsold when the swap reaches the pool's price limit is refunded as Cosmos coins.
+// SENDER the bech32 address of the account selling amount_in, must also be the signer of the message
+// BASE the EVM address of the pool's base token (0x0 for the native token)
+// QUOTE the EVM address of the pool's quote token
+// POOL_IDX the index of the pool's template
+// AMOUNT_IN the Cosmos coins to sell, which must be the native token (sold as base) or registered with the erc20
+// module as either base or quote
+// MIN_OUT the minimum amount of the other token to receive, otherwise the swap fails
+// RECEIVE_ERC20 if true the proceeds are left as ERC20 tokens on the sender's EVM address, otherwise they are
+// converted to Cosmos coins. Proceeds in the native token are always Cosmos coins
+type MsgSwap struct {
+	Sender       string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base         string                                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote        string                                 `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx      uint64                                 `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	AmountIn     types.Coin                             `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in"`
+	MinOut       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_out,json=minOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_out"`
+	ReceiveErc20 bool                                   `protobuf:"varint,7,opt,name=receive_erc20,json=receiveErc20,proto3" json:"receive_erc20,omitempty"`
+}
+
+func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
+func (m *MsgSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgSwap) ProtoMessage()    {}
+func (*MsgSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{0}
+}
+func (m *MsgSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwap.Merge(m, src)
+}
+func (m *MsgSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwap proto.InternalMessageInfo
+
+func (m *MsgSwap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSwap) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgSwap) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgSwap) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgSwap) GetAmountIn() types.Coin {
+	if m != nil {
+		return m.AmountIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwap) GetReceiveErc20() bool {
+	if m != nil {
+		return m.ReceiveErc20
+	}
+	return false
+}
+
+// MsgSwapResponse reports the proceeds of a MsgSwap
+// TOKEN_OUT the EVM address of the token bought
+// AMOUNT_OUT the amount of token_out received
+// COIN_OUT the Cosmos coins received, empty if the proceeds were left as ERC20 tokens
+// REFUNDED the part of amount_in the pool did not take, returned to the sender as Cosmos coins
+type MsgSwapResponse struct {
+	TokenOut  string                                 `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount_out,json=amountOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_out"`
+	CoinOut   []types.Coin                           `protobuf:"bytes,3,rep,name=coin_out,json=coinOut,proto3" json:"coin_out"`
+	Refunded  []types.Coin                           `protobuf:"bytes,4,rep,name=refunded,proto3" json:"refunded"`
+}
+
+func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
+func (m *MsgSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapResponse) ProtoMessage()    {}
+func (*MsgSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{1}
+}
+func (m *MsgSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapResponse.Merge(m, src)
+}
+func (m *MsgSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapResponse proto.InternalMessageInfo
+
+func (m *MsgSwapResponse) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+func (m *MsgSwapResponse) GetCoinOut() []types.Coin {
+	if m != nil {
+		return m.CoinOut
+	}
+	return nil
+}
+
+func (m *MsgSwapResponse) GetRefunded() []types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+// MsgMintAmbientLiquidity adds full range liquidity to the native DEX's (base, quote, pool_idx) pool from the sender's
+// EVM address, calling CrocSwapDex.userCmd() on the warm path. Both of the pool's tokens must be registered with the
+// erc20 module, pools with the native token are not supported since module EVM calls can not transfer value.
+// SENDER the bech32 address of the liquidity provider, must also be the signer of the message
+// BASE the EVM address of the pool's base token
+// QUOTE the EVM address of the pool's quote token
+// POOL_IDX the index of the pool's template
+// AMOUNT the exact Cosmos coins to deposit on one side of the pool, this sizes the position
+// MAX_OTHER the most Cosmos coins to deposit on the other side of the pool, limiting slippage. Any unused amount is
+// returned as Cosmos coins
+type MsgMintAmbientLiquidity struct {
+	Sender   string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base     string     `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote    string     `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx  uint64     `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	MaxOther types.Coin `protobuf:"bytes,6,opt,name=max_other,json=maxOther,proto3" json:"max_other"`
+}
+
+func (m *MsgMintAmbientLiquidity) Reset()         { *m = MsgMintAmbientLiquidity{} }
+func (m *MsgMintAmbientLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgMintAmbientLiquidity) ProtoMessage()    {}
+func (*MsgMintAmbientLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{2}
+}
+func (m *MsgMintAmbientLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintAmbientLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintAmbientLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintAmbientLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintAmbientLiquidity.Merge(m, src)
+}
+func (m *MsgMintAmbientLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintAmbientLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintAmbientLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintAmbientLiquidity proto.InternalMessageInfo
+
+func (m *MsgMintAmbientLiquidity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMintAmbientLiquidity) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgMintAmbientLiquidity) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgMintAmbientLiquidity) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgMintAmbientLiquidity) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgMintAmbientLiquidity) GetMaxOther() types.Coin {
+	if m != nil {
+		return m.MaxOther
+	}
+	return types.Coin{}
+}
+
+// MsgMintRangeLiquidity adds concentrated liquidity between lower_tick and upper_tick to the native DEX's
+// (base, quote, pool_idx) pool from the sender's EVM address, see MsgMintAmbientLiquidity for the other fields.
+// LOWER_TICK the lower tick of the position, which must be a multiple of the pool's tick size
+// UPPER_TICK the upper tick of the position, which must be a multiple of the pool's tick size
+type MsgMintRangeLiquidity struct {
+	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base      string     `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote     string     `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx   uint64     `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	LowerTick int32      `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick int32      `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount"`
+	MaxOther  types.Coin `protobuf:"bytes,8,opt,name=max_other,json=maxOther,proto3" json:"max_other"`
+}
+
+func (m *MsgMintRangeLiquidity) Reset()         { *m = MsgMintRangeLiquidity{} }
+func (m *MsgMintRangeLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgMintRangeLiquidity) ProtoMessage()    {}
+func (*MsgMintRangeLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{3}
+}
+func (m *MsgMintRangeLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintRangeLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintRangeLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintRangeLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintRangeLiquidity.Merge(m, src)
+}
+func (m *MsgMintRangeLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintRangeLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintRangeLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintRangeLiquidity proto.InternalMessageInfo
+
+func (m *MsgMintRangeLiquidity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMintRangeLiquidity) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgMintRangeLiquidity) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgMintRangeLiquidity) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgMintRangeLiquidity) GetLowerTick() int32 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgMintRangeLiquidity) GetUpperTick() int32 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *MsgMintRangeLiquidity) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgMintRangeLiquidity) GetMaxOther() types.Coin {
+	if m != nil {
+		return m.MaxOther
+	}
+	return types.Coin{}
+}
+
+// MsgMintLiquidityResponse reports the tokens deposited by a MsgMintAmbientLiquidity or MsgMintRangeLiquidity
+// DEPOSITED the Cosmos coins deposited into the pool
+// REFUNDED the unused Cosmos coins returned to the sender
+type MsgMintLiquidityResponse struct {
+	Deposited []types.Coin `protobuf:"bytes,1,rep,name=deposited,proto3" json:"deposited"`
+	Refunded  []types.Coin `protobuf:"bytes,2,rep,name=refunded,proto3" json:"refunded"`
+}
+
+func (m *MsgMintLiquidityResponse) Reset()         { *m = MsgMintLiquidityResponse{} }
+func (m *MsgMintLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintLiquidityResponse) ProtoMessage()    {}
+func (*MsgMintLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{4}
+}
+func (m *MsgMintLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintLiquidityResponse.Merge(m, src)
+}
+func (m *MsgMintLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintLiquidityResponse proto.InternalMessageInfo
+
+func (m *MsgMintLiquidityResponse) GetDeposited() []types.Coin {
+	if m != nil {
+		return m.Deposited
+	}
+	return nil
+}
+
+func (m *MsgMintLiquidityResponse) GetRefunded() []types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+// MsgBurnLiquidity removes liquidity from the sender's position in the native DEX's (base, quote, pool_idx) pool
+// SENDER the bech32 address of the position owner, must also be the signer of the message
+// BASE the EVM address of the pool's base token (0x0 for the native token)
+// QUOTE the EVM address of the pool's quote token
+// POOL_IDX the index of the pool's template
+// AMBIENT true to burn from the ambient position, false to burn from the range position between the ticks
+// LOWER_TICK the lower tick of a range position
+// UPPER_TICK the upper tick of a range position
+// LIQUIDITY the liquidity units to burn, range positions require a multiple of 1024
+// RECEIVE_ERC20 if true the proceeds are left as ERC20 tokens on the sender's EVM address, otherwise they are
+// converted to Cosmos coins. Proceeds in the native token are always Cosmos coins
+// MIN_BASE_OUT the minimum amount of the base token to withdraw, otherwise the burn fails
+// MIN_QUOTE_OUT the minimum amount of the quote token to withdraw, otherwise the burn fails
+type MsgBurnLiquidity struct {
+	Sender       string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base         string                                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote        string                                 `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx      uint64                                 `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	Ambient      bool                                   `protobuf:"varint,5,opt,name=ambient,proto3" json:"ambient,omitempty"`
+	LowerTick    int32                                  `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick    int32                                  `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+	Liquidity    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	ReceiveErc20 bool                                   `protobuf:"varint,9,opt,name=receive_erc20,json=receiveErc20,proto3" json:"receive_erc20,omitempty"`
+	MinBaseOut   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_base_out,json=minBaseOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_base_out"`
+	MinQuoteOut  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_quote_out,json=minQuoteOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_quote_out"`
+}
+
+func (m *MsgBurnLiquidity) Reset()         { *m = MsgBurnLiquidity{} }
+func (m *MsgBurnLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnLiquidity) ProtoMessage()    {}
+func (*MsgBurnLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{5}
+}
+func (m *MsgBurnLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnLiquidity.Merge(m, src)
+}
+func (m *MsgBurnLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnLiquidity proto.InternalMessageInfo
+
+func (m *MsgBurnLiquidity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBurnLiquidity) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgBurnLiquidity) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgBurnLiquidity) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgBurnLiquidity) GetAmbient() bool {
+	if m != nil {
+		return m.Ambient
+	}
+	return false
+}
+
+func (m *MsgBurnLiquidity) GetLowerTick() int32 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgBurnLiquidity) GetUpperTick() int32 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *MsgBurnLiquidity) GetReceiveErc20() bool {
+	if m != nil {
+		return m.ReceiveErc20
+	}
+	return false
+}
+
+// MsgBurnLiquidityResponse reports the tokens withdrawn by a MsgBurnLiquidity
+// BASE_OUT the amount of the base token withdrawn
+// QUOTE_OUT the amount of the quote token withdrawn
+// COINS_OUT the Cosmos coins received, excluding any proceeds left as ERC20 tokens
+type MsgBurnLiquidityResponse struct {
+	BaseOut  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=base_out,json=baseOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_out"`
+	QuoteOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=quote_out,json=quoteOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_out"`
+	CoinsOut []types.Coin                           `protobuf:"bytes,3,rep,name=coins_out,json=coinsOut,proto3" json:"coins_out"`
+}
+
+func (m *MsgBurnLiquidityResponse) Reset()         { *m = MsgBurnLiquidityResponse{} }
+func (m *MsgBurnLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnLiquidityResponse) ProtoMessage()    {}
+func (*MsgBurnLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{6}
+}
+func (m *MsgBurnLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnLiquidityResponse.Merge(m, src)
+}
+func (m *MsgBurnLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnLiquidityResponse proto.InternalMessageInfo
+
+func (m *MsgBurnLiquidityResponse) GetCoinsOut() []types.Coin {
+	if m != nil {
+		return m.CoinsOut
+	}
+	return nil
+}
+
+// MsgHarvest collects the accumulated rewards of the sender's range position in the native DEX's
+// (base, quote, pool_idx) pool. Ambient positions compound their rewards and have nothing to harvest.
+// See MsgBurnLiquidity for the fields.
+type MsgHarvest struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base         string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote        string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx      uint64 `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	LowerTick    int32  `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick    int32  `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+	ReceiveErc20 bool   `protobuf:"varint,7,opt,name=receive_erc20,json=receiveErc20,proto3" json:"receive_erc20,omitempty"`
+}
+
+func (m *MsgHarvest) Reset()         { *m = MsgHarvest{} }
+func (m *MsgHarvest) String() string { return proto.CompactTextString(m) }
+func (*MsgHarvest) ProtoMessage()    {}
+func (*MsgHarvest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{7}
+}
+func (m *MsgHarvest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHarvest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHarvest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHarvest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHarvest.Merge(m, src)
+}
+func (m *MsgHarvest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHarvest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHarvest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHarvest proto.InternalMessageInfo
+
+func (m *MsgHarvest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgHarvest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgHarvest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgHarvest) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgHarvest) GetLowerTick() int32 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgHarvest) GetUpperTick() int32 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *MsgHarvest) GetReceiveErc20() bool {
+	if m != nil {
+		return m.ReceiveErc20
+	}
+	return false
+}
+
+// MsgHarvestResponse reports the rewards collected by a MsgHarvest, see MsgBurnLiquidityResponse for the fields
+type MsgHarvestResponse struct {
+	BaseOut  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=base_out,json=baseOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_out"`
+	QuoteOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=quote_out,json=quoteOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_out"`
+	CoinsOut []types.Coin                           `protobuf:"bytes,3,rep,name=coins_out,json=coinsOut,proto3" json:"coins_out"`
+}
+
+func (m *MsgHarvestResponse) Reset()         { *m = MsgHarvestResponse{} }
+func (m *MsgHarvestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHarvestResponse) ProtoMessage()    {}
+func (*MsgHarvestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{8}
+}
+func (m *MsgHarvestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHarvestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHarvestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHarvestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHarvestResponse.Merge(m, src)
+}
+func (m *MsgHarvestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHarvestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHarvestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHarvestResponse proto.InternalMessageInfo
+
+func (m *MsgHarvestResponse) GetCoinsOut() []types.Coin {
+	if m != nil {
+		return m.CoinsOut
+	}
+	return nil
+}
+
+// MsgCancelTimelockedProposal cancels a passed proposal waiting in the timelock queue
+type MsgCancelTimelockedProposal struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	QueuedId uint64 `protobuf:"varint,2,opt,name=queued_id,json=queuedId,proto3" json:"queued_id,omitempty"`
+}
+
+func (m *MsgCancelTimelockedProposal) Reset()         { *m = MsgCancelTimelockedProposal{} }
+func (m *MsgCancelTimelockedProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedProposal) ProtoMessage()    {}
+func (*MsgCancelTimelockedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{9}
+}
+func (m *MsgCancelTimelockedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedProposal.Merge(m, src)
+}
+func (m *MsgCancelTimelockedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedProposal proto.InternalMessageInfo
+
+func (m *MsgCancelTimelockedProposal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelTimelockedProposal) GetQueuedId() uint64 {
+	if m != nil {
+		return m.QueuedId
+	}
+	return 0
+}
+
+type MsgCancelTimelockedProposalResponse struct {
+}
+
+func (m *MsgCancelTimelockedProposalResponse) Reset()         { *m = MsgCancelTimelockedProposalResponse{} }
+func (m *MsgCancelTimelockedProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedProposalResponse) ProtoMessage()    {}
+func (*MsgCancelTimelockedProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{10}
+}
+func (m *MsgCancelTimelockedProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelTimelockedProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedProposalResponse proto.InternalMessageInfo
+
+// MsgClaimIncentives sends the sender all of the liquidity mining rewards paid to their positions by the incentive
+// programs
+type MsgClaimIncentives struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgClaimIncentives) Reset()         { *m = MsgClaimIncentives{} }
+func (m *MsgClaimIncentives) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentives) ProtoMessage()    {}
+func (*MsgClaimIncentives) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{11}
+}
+func (m *MsgClaimIncentives) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentives.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentives) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentives.Merge(m, src)
+}
+func (m *MsgClaimIncentives) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentives) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentives.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentives proto.InternalMessageInfo
+
+func (m *MsgClaimIncentives) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgClaimIncentivesResponse reports the rewards claimed by a MsgClaimIncentives
+type MsgClaimIncentivesResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimIncentivesResponse) Reset()         { *m = MsgClaimIncentivesResponse{} }
+func (m *MsgClaimIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentivesResponse) ProtoMessage()    {}
+func (*MsgClaimIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{12}
+}
+func (m *MsgClaimIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentivesResponse.Merge(m, src)
+}
+func (m *MsgClaimIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentivesResponse proto.InternalMessageInfo
+
+func (m *MsgClaimIncentivesResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// MsgRegisterIncentivePosition records the sender's existing position in the native DEX's (base, quote, pool_idx) pool,
+// which must hold liquidity and have an incentive program, so that the program samples it. Positions opened with
+// MsgMintAmbientLiquidity or MsgMintRangeLiquidity are recorded already. Recorded positions are forgotten once they are
+// sampled empty or their pool has no program, and must be registered again to earn from a later program.
+// SENDER the bech32 address of the position owner, whose EVM address holds the position in the DEX
+// AMBIENT true for an ambient position, which must have zero ticks
+// LOWER_TICK and UPPER_TICK the bounds of a range position
+type MsgRegisterIncentivePosition struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Base      string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote     string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolIdx   uint64 `protobuf:"varint,4,opt,name=pool_idx,json=poolIdx,proto3" json:"pool_idx,omitempty"`
+	Ambient   bool   `protobuf:"varint,5,opt,name=ambient,proto3" json:"ambient,omitempty"`
+	LowerTick int32  `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty"`
+	UpperTick int32  `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty"`
+}
+
+func (m *MsgRegisterIncentivePosition) Reset()         { *m = MsgRegisterIncentivePosition{} }
+func (m *MsgRegisterIncentivePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIncentivePosition) ProtoMessage()    {}
+func (*MsgRegisterIncentivePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{13}
+}
+func (m *MsgRegisterIncentivePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIncentivePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIncentivePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIncentivePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIncentivePosition.Merge(m, src)
+}
+func (m *MsgRegisterIncentivePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIncentivePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIncentivePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIncentivePosition proto.InternalMessageInfo
+
+func (m *MsgRegisterIncentivePosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterIncentivePosition) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgRegisterIncentivePosition) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgRegisterIncentivePosition) GetPoolIdx() uint64 {
+	if m != nil {
+		return m.PoolIdx
+	}
+	return 0
+}
+
+func (m *MsgRegisterIncentivePosition) GetAmbient() bool {
+	if m != nil {
+		return m.Ambient
+	}
+	return false
+}
+
+func (m *MsgRegisterIncentivePosition) GetLowerTick() int32 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgRegisterIncentivePosition) GetUpperTick() int32 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type MsgRegisterIncentivePositionResponse struct {
+}
+
+func (m *MsgRegisterIncentivePositionResponse) Reset()         { *m = MsgRegisterIncentivePositionResponse{} }
+func (m *MsgRegisterIncentivePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIncentivePositionResponse) ProtoMessage()    {}
+func (*MsgRegisterIncentivePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fda93abac5a40ea, []int{14}
+}
+func (m *MsgRegisterIncentivePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIncentivePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIncentivePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIncentivePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIncentivePositionResponse.Merge(m, src)
+}
+func (m *MsgRegisterIncentivePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIncentivePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIncentivePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIncentivePositionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSwap)(nil), "althea.nativedex.v1.MsgSwap")
+	proto.RegisterType((*MsgSwapResponse)(nil), "althea.nativedex.v1.MsgSwapResponse")
+	proto.RegisterType((*MsgMintAmbientLiquidity)(nil), "althea.nativedex.v1.MsgMintAmbientLiquidity")
+	proto.RegisterType((*MsgMintRangeLiquidity)(nil), "althea.nativedex.v1.MsgMintRangeLiquidity")
+	proto.RegisterType((*MsgMintLiquidityResponse)(nil), "althea.nativedex.v1.MsgMintLiquidityResponse")
+	proto.RegisterType((*MsgBurnLiquidity)(nil), "althea.nativedex.v1.MsgBurnLiquidity")
+	proto.RegisterType((*MsgBurnLiquidityResponse)(nil), "althea.nativedex.v1.MsgBurnLiquidityResponse")
+	proto.RegisterType((*MsgHarvest)(nil), "althea.nativedex.v1.MsgHarvest")
+	proto.RegisterType((*MsgHarvestResponse)(nil), "althea.nativedex.v1.MsgHarvestResponse")
+	proto.RegisterType((*MsgCancelTimelockedProposal)(nil), "althea.nativedex.v1.MsgCancelTimelockedProposal")
+	proto.RegisterType((*MsgCancelTimelockedProposalResponse)(nil), "althea.nativedex.v1.MsgCancelTimelockedProposalResponse")
+	proto.RegisterType((*MsgClaimIncentives)(nil), "althea.nativedex.v1.MsgClaimIncentives")
+	proto.RegisterType((*MsgClaimIncentivesResponse)(nil), "althea.nativedex.v1.MsgClaimIncentivesResponse")
+	proto.RegisterType((*MsgRegisterIncentivePosition)(nil), "althea.nativedex.v1.MsgRegisterIncentivePosition")
+	proto.RegisterType((*MsgRegisterIncentivePositionResponse)(nil), "althea.nativedex.v1.MsgRegisterIncentivePositionResponse")
+}
+
 func init() { proto.RegisterFile("althea/nativedex/v1/tx.proto", fileDescriptor_1fda93abac5a40ea) }
 
 var fileDescriptor_1fda93abac5a40ea = []byte{
-	// 145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xcc, 0x29, 0xc9,
-	0x48, 0x4d, 0xd4, 0xcf, 0x4b, 0x2c, 0xc9, 0x2c, 0x4b, 0x4d, 0x49, 0xad, 0xd0, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0xc1, 0x65, 0xf5,
-	0xca, 0x0c, 0x8d, 0x58, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x9d, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0xdf, 0x11, 0x6c, 0x80, 0x5b, 0x7e, 0x69, 0x5e, 0x4a, 0x62, 0x49, 0x66, 0x7e, 0x9e, 0x3e,
-	0xc4, 0x44, 0x5d, 0x1f, 0x43, 0xfd, 0x0a, 0x24, 0x4b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0xb6, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xfe, 0x51, 0xef, 0x95, 0x00, 0x00,
-	0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xdb, 0x34, 0x76, 0x5e, 0xb7, 0x5a, 0x64, 0x0a, 0xb8, 0x6e, 0x49, 0x2b, 0xef, 0x0f,
+	0x2a, 0xb4, 0xb5, 0x9b, 0x72, 0x58, 0x7e, 0x1e, 0xb6, 0x15, 0x3f, 0x02, 0x8d, 0xda, 0xf5, 0xee,
+	0x89, 0x4b, 0xe4, 0xd8, 0x83, 0x3b, 0x4a, 0x3c, 0x93, 0x7a, 0xc6, 0x69, 0xf6, 0x8c, 0x84, 0xc4,
+	0x0d, 0x71, 0xe0, 0x7f, 0x80, 0xbf, 0x64, 0x0f, 0x1c, 0x56, 0xe2, 0x82, 0x38, 0x2c, 0xab, 0xf6,
+	0x86, 0x84, 0xc4, 0x05, 0xce, 0x68, 0xc6, 0x8e, 0xbb, 0x69, 0xeb, 0x6c, 0x12, 0xc4, 0x0a, 0xf6,
+	0x94, 0x78, 0xde, 0xfb, 0xbe, 0x79, 0xef, 0x7b, 0xe3, 0x2f, 0x13, 0x58, 0xf5, 0x3a, 0xfc, 0x10,
+	0x79, 0x0e, 0xf1, 0x38, 0xee, 0xa1, 0x00, 0xf5, 0x9d, 0x5e, 0xcd, 0xe1, 0x7d, 0xbb, 0x1b, 0x53,
+	0x4e, 0xf5, 0x97, 0xd3, 0xa8, 0x9d, 0x47, 0xed, 0x5e, 0xcd, 0x5c, 0x0a, 0x69, 0x48, 0x65, 0xdc,
+	0x11, 0xdf, 0xd2, 0x54, 0xb3, 0xea, 0x53, 0x16, 0x51, 0xe6, 0xb4, 0x3c, 0x86, 0x9c, 0x5e, 0xad,
+	0x85, 0xb8, 0x57, 0x73, 0x7c, 0x8a, 0x49, 0x1a, 0xb7, 0xbe, 0x9d, 0x05, 0xb5, 0xc1, 0xc2, 0x7b,
+	0xc7, 0x5e, 0x57, 0x7f, 0x15, 0xca, 0x0c, 0x91, 0x00, 0xc5, 0x86, 0xb2, 0xae, 0x6c, 0x54, 0xdc,
+	0xec, 0x49, 0xd7, 0xa1, 0x24, 0xe0, 0xc6, 0xac, 0x5c, 0x95, 0xdf, 0xf5, 0x25, 0x98, 0x3f, 0x4a,
+	0x28, 0x47, 0xc6, 0x9c, 0x5c, 0x4c, 0x1f, 0xf4, 0x65, 0xd0, 0xba, 0x94, 0x76, 0x9a, 0x38, 0xe8,
+	0x1b, 0xa5, 0x75, 0x65, 0xa3, 0xe4, 0xaa, 0xe2, 0xb9, 0x1e, 0xf4, 0xf5, 0xf7, 0xa1, 0xe2, 0x45,
+	0x34, 0x21, 0xbc, 0x89, 0x89, 0x31, 0xbf, 0xae, 0x6c, 0x2c, 0x6c, 0x2f, 0xdb, 0x69, 0x71, 0xb6,
+	0x60, 0xb4, 0xb3, 0xe2, 0xec, 0x5d, 0x8a, 0xc9, 0x4e, 0xe9, 0xe1, 0xe3, 0xb5, 0x19, 0x57, 0x4b,
+	0x11, 0x75, 0xa2, 0x7f, 0x0c, 0x6a, 0x84, 0x49, 0x93, 0x26, 0xdc, 0x28, 0x8b, 0x0d, 0x77, 0x6c,
+	0x91, 0xf0, 0xcb, 0xe3, 0xb5, 0x9b, 0x21, 0xe6, 0x87, 0x49, 0xcb, 0xf6, 0x69, 0xe4, 0x64, 0xad,
+	0xa6, 0x1f, 0x9b, 0x2c, 0x68, 0x3b, 0xfc, 0x41, 0x17, 0x31, 0xbb, 0x4e, 0xb8, 0x5b, 0x8e, 0x30,
+	0xd9, 0x4f, 0xb8, 0x7e, 0x0d, 0x16, 0x63, 0xe4, 0x23, 0xdc, 0x43, 0x4d, 0x14, 0xfb, 0xdb, 0x5b,
+	0x86, 0xba, 0xae, 0x6c, 0x68, 0xee, 0x95, 0x6c, 0xf1, 0x43, 0xb1, 0x66, 0xfd, 0xa5, 0xc0, 0xd5,
+	0x4c, 0x14, 0x17, 0xb1, 0x2e, 0x25, 0x0c, 0xe9, 0x2b, 0x50, 0xe1, 0xb4, 0x8d, 0xd2, 0x1a, 0x52,
+	0x7d, 0x34, 0xb9, 0x20, 0x58, 0x1b, 0x00, 0x59, 0x73, 0x22, 0x3a, 0x3b, 0x55, 0x85, 0x99, 0x3c,
+	0x82, 0xee, 0x5d, 0xd0, 0xc4, 0x88, 0x24, 0xd9, 0xdc, 0xfa, 0xdc, 0x38, 0x52, 0xa9, 0x02, 0x20,
+	0xb0, 0xef, 0x81, 0x16, 0xa3, 0x2f, 0x12, 0x12, 0xa0, 0xc0, 0x28, 0x8d, 0x87, 0xcd, 0x01, 0xd6,
+	0xef, 0x0a, 0xbc, 0xd6, 0x60, 0x61, 0x03, 0x13, 0x7e, 0x27, 0x6a, 0x61, 0x44, 0xf8, 0x1e, 0x3e,
+	0x4a, 0x70, 0x80, 0xf9, 0x83, 0x7f, 0xf7, 0x74, 0xdc, 0x86, 0x72, 0xda, 0xfe, 0xb8, 0x47, 0x23,
+	0x4b, 0x17, 0xc7, 0x2a, 0xf2, 0xfa, 0x4d, 0xca, 0x0f, 0x51, 0x6c, 0x94, 0xc7, 0xc3, 0x6a, 0x91,
+	0xd7, 0xdf, 0x17, 0x00, 0xeb, 0xfb, 0x59, 0x78, 0x25, 0xeb, 0xd7, 0xf5, 0x48, 0x88, 0x9e, 0x53,
+	0xb7, 0xaf, 0x03, 0x74, 0xe8, 0x31, 0x8a, 0x9b, 0x1c, 0xfb, 0x6d, 0xd9, 0xf1, 0xbc, 0x5b, 0x91,
+	0x2b, 0xf7, 0xb1, 0xdf, 0x16, 0xe1, 0xa4, 0xdb, 0x1d, 0x84, 0xcb, 0x69, 0x58, 0xae, 0xc8, 0xf0,
+	0x99, 0x56, 0xea, 0x3f, 0xd0, 0x4a, 0x9b, 0x54, 0xab, 0xef, 0x14, 0x30, 0x32, 0xad, 0x72, 0x99,
+	0xf2, 0xb7, 0xe3, 0x03, 0xa8, 0x04, 0xa8, 0x4b, 0x19, 0xe6, 0x28, 0x30, 0x94, 0xf1, 0x8e, 0xdd,
+	0x19, 0x62, 0xe8, 0xd0, 0xce, 0x4e, 0x7a, 0x68, 0x9f, 0xcc, 0xc1, 0x4b, 0x0d, 0x16, 0xee, 0x24,
+	0x31, 0x79, 0x4e, 0xf3, 0x33, 0x40, 0xf5, 0xd2, 0xd7, 0x43, 0x0e, 0x4f, 0x73, 0x07, 0x8f, 0xe7,
+	0x26, 0x5b, 0x1e, 0x3d, 0x59, 0xf5, 0xfc, 0x64, 0xf7, 0xa0, 0xd2, 0x19, 0x74, 0x60, 0x68, 0xd3,
+	0xb9, 0x48, 0x4e, 0x70, 0xd1, 0xea, 0x2a, 0x17, 0xad, 0x4e, 0x3f, 0x80, 0x2b, 0xc2, 0x58, 0x85,
+	0x0e, 0xd2, 0x6e, 0x60, 0xaa, 0x5d, 0x21, 0xc2, 0x64, 0xc7, 0x63, 0x48, 0x18, 0x90, 0x0b, 0x8b,
+	0x82, 0x51, 0x8a, 0x28, 0x29, 0x17, 0xa6, 0xa2, 0x5c, 0x88, 0x30, 0xb9, 0x2b, 0x38, 0xf6, 0x13,
+	0x6e, 0xfd, 0x91, 0x9e, 0xbd, 0xa1, 0x11, 0xe7, 0x67, 0xaf, 0x0e, 0x5a, 0x5e, 0xbe, 0x32, 0xd5,
+	0x5e, 0x6a, 0x2b, 0xab, 0xfd, 0x33, 0xa8, 0x9c, 0xd5, 0x3d, 0x9d, 0x8d, 0x6b, 0x47, 0x59, 0xd1,
+	0xe2, 0x75, 0x13, 0xa6, 0xcc, 0x26, 0xb1, 0x71, 0xe9, 0xfb, 0x4c, 0xb4, 0xfc, 0xa3, 0x02, 0xd0,
+	0x60, 0xe1, 0x27, 0x5e, 0xdc, 0x43, 0x8c, 0xff, 0x97, 0xfd, 0x68, 0xac, 0x9f, 0xd4, 0xdf, 0x14,
+	0xd0, 0xcf, 0xda, 0x79, 0xc1, 0x67, 0xe7, 0xc2, 0x4a, 0x83, 0x85, 0xbb, 0x1e, 0xf1, 0x51, 0xe7,
+	0x3e, 0x8e, 0x50, 0x87, 0xfa, 0x6d, 0x14, 0x1c, 0xc4, 0xb4, 0x4b, 0x99, 0xd7, 0x29, 0x9c, 0xe5,
+	0x8a, 0xe8, 0x00, 0x25, 0x28, 0x68, 0xe2, 0x40, 0x76, 0x50, 0x72, 0xb5, 0x74, 0xa1, 0x1e, 0x58,
+	0x37, 0xe0, 0xda, 0x08, 0xce, 0x81, 0xa0, 0xd6, 0x2d, 0x29, 0xf3, 0x6e, 0xc7, 0xc3, 0x51, 0x9d,
+	0xf8, 0x88, 0x88, 0x0b, 0x22, 0x2b, 0xda, 0xd1, 0xfa, 0x52, 0x01, 0xf3, 0x62, 0x7a, 0x3e, 0x1d,
+	0x04, 0x6a, 0x8c, 0x8e, 0xbd, 0x38, 0x60, 0xcf, 0xf6, 0xf4, 0x2d, 0xa1, 0xc1, 0x0f, 0xbf, 0xae,
+	0x6d, 0x8c, 0xa1, 0xb5, 0x00, 0x30, 0x77, 0xc0, 0x6d, 0xfd, 0xa4, 0xc0, 0x6a, 0x83, 0x85, 0x2e,
+	0x0a, 0x31, 0xe3, 0x28, 0xce, 0x0b, 0x39, 0xa0, 0x0c, 0x73, 0x4c, 0xc9, 0xff, 0xd2, 0xcc, 0xad,
+	0x9b, 0x70, 0x7d, 0x54, 0x53, 0x03, 0x91, 0xb7, 0xff, 0x2c, 0xc3, 0x5c, 0x83, 0x85, 0xfa, 0xa7,
+	0x50, 0x92, 0xb7, 0xf0, 0x55, 0xfb, 0x92, 0xdb, 0xbd, 0x9d, 0x5d, 0x47, 0xcd, 0xeb, 0xa3, 0xa2,
+	0xf9, 0xe0, 0x18, 0x2c, 0x5d, 0x7a, 0x87, 0xbb, 0x55, 0x84, 0xbe, 0x2c, 0xdb, 0xdc, 0x1c, 0x95,
+	0x7d, 0xd1, 0x87, 0x29, 0xe8, 0x97, 0x5c, 0xa4, 0xde, 0x1c, 0x45, 0x32, 0x9c, 0x3b, 0xe9, 0x86,
+	0x08, 0x16, 0x87, 0x7f, 0xf4, 0x6f, 0x14, 0xe1, 0x87, 0xd2, 0xcc, 0xcd, 0xb1, 0xd2, 0xf2, 0x6d,
+	0xee, 0x81, 0x3a, 0x70, 0xe1, 0xb5, 0x22, 0x64, 0x96, 0x60, 0xbe, 0xf1, 0x8c, 0x84, 0x9c, 0xf4,
+	0x2b, 0x05, 0x8c, 0x42, 0x83, 0xd8, 0x2a, 0x62, 0x29, 0x42, 0x98, 0x6f, 0x4f, 0x8a, 0xc8, 0x0b,
+	0x69, 0xc3, 0xd5, 0xf3, 0x6e, 0x51, 0xd8, 0xc4, 0xb9, 0x44, 0xd3, 0x19, 0x33, 0x31, 0xdf, 0xec,
+	0x6b, 0x05, 0x96, 0x8b, 0x5f, 0xf3, 0x5a, 0x11, 0x5d, 0x21, 0xc4, 0x7c, 0x67, 0x62, 0xc8, 0xa0,
+	0x96, 0x9d, 0xbb, 0x0f, 0x4f, 0xaa, 0xca, 0xa3, 0x93, 0xaa, 0xf2, 0xe4, 0xa4, 0xaa, 0x7c, 0x73,
+	0x5a, 0x9d, 0x79, 0x74, 0x5a, 0x9d, 0xf9, 0xf9, 0xb4, 0x3a, 0xf3, 0xf9, 0xed, 0xa7, 0x2c, 0xec,
+	0x8e, 0xa4, 0xff, 0x88, 0x26, 0x24, 0xf0, 0x04, 0xdc, 0x49, 0xf7, 0xdb, 0xdc, 0xab, 0x39, 0xfd,
+	0xa7, 0xfe, 0x9d, 0x4b, 0x5f, 0x6b, 0x95, 0xe5, 0x7f, 0xea, 0xb7, 0xfe, 0x1e, 0x00, 0x1b, 0xb7,
+	0xa1, 0x72, 0xbe, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -51,6 +1172,24 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// Swap sells Cosmos coins on the native DEX on behalf of the signer
+	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	// MintAmbientLiquidity adds full range liquidity to a native DEX pool on behalf of the signer
+	MintAmbientLiquidity(ctx context.Context, in *MsgMintAmbientLiquidity, opts ...grpc.CallOption) (*MsgMintLiquidityResponse, error)
+	// MintRangeLiquidity adds concentrated liquidity to a native DEX pool on behalf of the signer
+	MintRangeLiquidity(ctx context.Context, in *MsgMintRangeLiquidity, opts ...grpc.CallOption) (*MsgMintLiquidityResponse, error)
+	// BurnLiquidity removes liquidity from one of the signer's native DEX positions
+	BurnLiquidity(ctx context.Context, in *MsgBurnLiquidity, opts ...grpc.CallOption) (*MsgBurnLiquidityResponse, error)
+	// Harvest collects the accumulated rewards of one of the signer's concentrated native DEX positions
+	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
+	// CancelTimelockedProposal removes a queued proposal from the timelock, the signer must hold the CrocPolicy
+	// emergency role
+	CancelTimelockedProposal(ctx context.Context, in *MsgCancelTimelockedProposal, opts ...grpc.CallOption) (*MsgCancelTimelockedProposalResponse, error)
+	// ClaimIncentives collects the signer's liquidity mining rewards
+	ClaimIncentives(ctx context.Context, in *MsgClaimIncentives, opts ...grpc.CallOption) (*MsgClaimIncentivesResponse, error)
+	// RegisterIncentivePosition records a DEX position the signer opened through the EVM, so that it earns liquidity
+	// mining rewards
+	RegisterIncentivePosition(ctx context.Context, in *MsgRegisterIncentivePosition, opts ...grpc.CallOption) (*MsgRegisterIncentivePositionResponse, error)
 }
 
 type msgClient struct {
@@ -61,22 +1200,4136 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintAmbientLiquidity(ctx context.Context, in *MsgMintAmbientLiquidity, opts ...grpc.CallOption) (*MsgMintLiquidityResponse, error) {
+	out := new(MsgMintLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/MintAmbientLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintRangeLiquidity(ctx context.Context, in *MsgMintRangeLiquidity, opts ...grpc.CallOption) (*MsgMintLiquidityResponse, error) {
+	out := new(MsgMintLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/MintRangeLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnLiquidity(ctx context.Context, in *MsgBurnLiquidity, opts ...grpc.CallOption) (*MsgBurnLiquidityResponse, error) {
+	out := new(MsgBurnLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/BurnLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
+	out := new(MsgHarvestResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/Harvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTimelockedProposal(ctx context.Context, in *MsgCancelTimelockedProposal, opts ...grpc.CallOption) (*MsgCancelTimelockedProposalResponse, error) {
+	out := new(MsgCancelTimelockedProposalResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/CancelTimelockedProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimIncentives(ctx context.Context, in *MsgClaimIncentives, opts ...grpc.CallOption) (*MsgClaimIncentivesResponse, error) {
+	out := new(MsgClaimIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/ClaimIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterIncentivePosition(ctx context.Context, in *MsgRegisterIncentivePosition, opts ...grpc.CallOption) (*MsgRegisterIncentivePositionResponse, error) {
+	out := new(MsgRegisterIncentivePositionResponse)
+	err := c.cc.Invoke(ctx, "/althea.nativedex.v1.Msg/RegisterIncentivePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// Swap sells Cosmos coins on the native DEX on behalf of the signer
+	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	// MintAmbientLiquidity adds full range liquidity to a native DEX pool on behalf of the signer
+	MintAmbientLiquidity(context.Context, *MsgMintAmbientLiquidity) (*MsgMintLiquidityResponse, error)
+	// MintRangeLiquidity adds concentrated liquidity to a native DEX pool on behalf of the signer
+	MintRangeLiquidity(context.Context, *MsgMintRangeLiquidity) (*MsgMintLiquidityResponse, error)
+	// BurnLiquidity removes liquidity from one of the signer's native DEX positions
+	BurnLiquidity(context.Context, *MsgBurnLiquidity) (*MsgBurnLiquidityResponse, error)
+	// Harvest collects the accumulated rewards of one of the signer's concentrated native DEX positions
+	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
+	// CancelTimelockedProposal removes a queued proposal from the timelock, the signer must hold the CrocPolicy
+	// emergency role
+	CancelTimelockedProposal(context.Context, *MsgCancelTimelockedProposal) (*MsgCancelTimelockedProposalResponse, error)
+	// ClaimIncentives collects the signer's liquidity mining rewards
+	ClaimIncentives(context.Context, *MsgClaimIncentives) (*MsgClaimIncentivesResponse, error)
+	// RegisterIncentivePosition records a DEX position the signer opened through the EVM, so that it earns liquidity
+	// mining rewards
+	RegisterIncentivePosition(context.Context, *MsgRegisterIncentivePosition) (*MsgRegisterIncentivePositionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) MintAmbientLiquidity(ctx context.Context, req *MsgMintAmbientLiquidity) (*MsgMintLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAmbientLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) MintRangeLiquidity(ctx context.Context, req *MsgMintRangeLiquidity) (*MsgMintLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRangeLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) BurnLiquidity(ctx context.Context, req *MsgBurnLiquidity) (*MsgBurnLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+}
+func (*UnimplementedMsgServer) CancelTimelockedProposal(ctx context.Context, req *MsgCancelTimelockedProposal) (*MsgCancelTimelockedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimelockedProposal not implemented")
+}
+func (*UnimplementedMsgServer) ClaimIncentives(ctx context.Context, req *MsgClaimIncentives) (*MsgClaimIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimIncentives not implemented")
+}
+func (*UnimplementedMsgServer) RegisterIncentivePosition(ctx context.Context, req *MsgRegisterIncentivePosition) (*MsgRegisterIncentivePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIncentivePosition not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintAmbientLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintAmbientLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintAmbientLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/MintAmbientLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintAmbientLiquidity(ctx, req.(*MsgMintAmbientLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintRangeLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintRangeLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintRangeLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/MintRangeLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintRangeLiquidity(ctx, req.(*MsgMintRangeLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/BurnLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnLiquidity(ctx, req.(*MsgBurnLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHarvest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Harvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/Harvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Harvest(ctx, req.(*MsgHarvest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTimelockedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTimelockedProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTimelockedProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/CancelTimelockedProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTimelockedProposal(ctx, req.(*MsgCancelTimelockedProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimIncentives)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/ClaimIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimIncentives(ctx, req.(*MsgClaimIncentives))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterIncentivePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterIncentivePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterIncentivePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/althea.nativedex.v1.Msg/RegisterIncentivePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterIncentivePosition(ctx, req.(*MsgRegisterIncentivePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "althea.nativedex.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "althea/nativedex/v1/tx.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "MintAmbientLiquidity",
+			Handler:    _Msg_MintAmbientLiquidity_Handler,
+		},
+		{
+			MethodName: "MintRangeLiquidity",
+			Handler:    _Msg_MintRangeLiquidity_Handler,
+		},
+		{
+			MethodName: "BurnLiquidity",
+			Handler:    _Msg_BurnLiquidity_Handler,
+		},
+		{
+			MethodName: "Harvest",
+			Handler:    _Msg_Harvest_Handler,
+		},
+		{
+			MethodName: "CancelTimelockedProposal",
+			Handler:    _Msg_CancelTimelockedProposal_Handler,
+		},
+		{
+			MethodName: "ClaimIncentives",
+			Handler:    _Msg_ClaimIncentives_Handler,
+		},
+		{
+			MethodName: "RegisterIncentivePosition",
+			Handler:    _Msg_RegisterIncentivePosition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "althea/nativedex/v1/tx.proto",
+}
+
+func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveErc20 {
+		i--
+		if m.ReceiveErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinOut.Size()
+		i -= size
+		if _, err := m.MinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AmountIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CoinOut) > 0 {
+		for iNdEx := len(m.CoinOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintAmbientLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintAmbientLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintAmbientLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxOther.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintRangeLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintRangeLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintRangeLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxOther.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Deposited) > 0 {
+		for iNdEx := len(m.Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinQuoteOut.Size()
+		i -= size
+		if _, err := m.MinQuoteOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinBaseOut.Size()
+		i -= size
+		if _, err := m.MinBaseOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.ReceiveErc20 {
+		i--
+		if m.ReceiveErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Ambient {
+		i--
+		if m.Ambient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsOut) > 0 {
+		for iNdEx := len(m.CoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.QuoteOut.Size()
+		i -= size
+		if _, err := m.QuoteOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseOut.Size()
+		i -= size
+		if _, err := m.BaseOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveErc20 {
+		i--
+		if m.ReceiveErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsOut) > 0 {
+		for iNdEx := len(m.CoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.QuoteOut.Size()
+		i -= size
+		if _, err := m.QuoteOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseOut.Size()
+		i -= size
+		if _, err := m.BaseOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueuedId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentives) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentives) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentives) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIncentivePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIncentivePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIncentivePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Ambient {
+		i--
+		if m.Ambient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIncentivePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIncentivePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIncentivePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReceiveErc20 {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.CoinOut) > 0 {
+		for _, e := range m.CoinOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMintAmbientLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxOther.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintRangeLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxOther.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposited) > 0 {
+		for _, e := range m.Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	if m.Ambient {
+		n += 2
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReceiveErc20 {
+		n += 2
+	}
+	l = m.MinBaseOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinQuoteOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.CoinsOut) > 0 {
+		for _, e := range m.CoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgHarvest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	if m.ReceiveErc20 {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgHarvestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.CoinsOut) > 0 {
+		for _, e := range m.CoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.QueuedId != 0 {
+		n += 1 + sovTx(uint64(m.QueuedId))
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimIncentives) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
+
+func (m *MsgClaimIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterIncentivePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdx != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdx))
+	}
+	if m.Ambient {
+		n += 2
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *MsgRegisterIncentivePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveErc20 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinOut = append(m.CoinOut, types.Coin{})
+			if err := m.CoinOut[len(m.CoinOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintAmbientLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintAmbientLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintAmbientLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOther", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOther.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintRangeLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintRangeLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintRangeLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOther", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOther.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposited = append(m.Deposited, types.Coin{})
+			if err := m.Deposited[len(m.Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambient", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ambient = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveErc20 = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuoteOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuoteOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsOut = append(m.CoinsOut, types.Coin{})
+			if err := m.CoinsOut[len(m.CoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveErc20 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinsOut = append(m.CoinsOut, types.Coin{})
+			if err := m.CoinsOut[len(m.CoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedId", wireType)
+			}
+			m.QueuedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentives) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentives: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentives: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterIncentivePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterIncentivePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterIncentivePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdx", wireType)
+			}
+			m.PoolIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambient", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ambient = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterIncentivePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterIncentivePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterIncentivePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
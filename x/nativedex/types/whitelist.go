@@ -0,0 +1,122 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SelectorLength is the length in bytes of an EVM function selector
+const SelectorLength = 4
+
+// ValidateBasic checks that the contract is a valid EVM address and that every selector is a unique 4 byte hex string
+func (cs ContractSelectors) ValidateBasic() error {
+	if !common.IsHexAddress(cs.ContractAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddress, "invalid contract address")
+	}
+
+	seen := make(map[string]bool, len(cs.Selectors))
+	for _, selector := range cs.Selectors {
+		if !isSelector(selector) {
+			return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "invalid function selector %s, expected 0x followed by 8 hex characters", selector)
+		}
+		normalized := strings.ToLower(selector)
+		if seen[normalized] {
+			return errorsmod.Wrapf(govtypes.ErrInvalidProposalContent, "duplicate function selector %s", selector)
+		}
+		seen[normalized] = true
+	}
+
+	return nil
+}
+
+// IsWhitelistedContract returns true if the contract is in WhitelistedContractAddresses, regardless of the hex case
+// used in the param
+func (p Params) IsWhitelistedContract(contract common.Address) bool {
+	return p.whitelistIndex(contract) >= 0
+}
+
+// IsCallAllowed returns true if the contract has no selector restrictions or data starts with an allowed selector
+func (p Params) IsCallAllowed(contract common.Address, data []byte) bool {
+	idx := p.selectorsIndex(contract)
+	if idx < 0 || len(p.ContractAllowedSelectors[idx].Selectors) == 0 {
+		return true
+	}
+	if len(data) < SelectorLength {
+		return false
+	}
+	for _, selector := range p.ContractAllowedSelectors[idx].Selectors {
+		if common.Bytes2Hex(data[:SelectorLength]) == strings.ToLower(selector[2:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddWhitelistedContract whitelists the contract if it is not already, and replaces its allowed selectors.
+// An empty selectors list lifts any restriction on the calls made to the contract
+func (p *Params) AddWhitelistedContract(contract common.Address, selectors []string) {
+	if !p.IsWhitelistedContract(contract) {
+		p.WhitelistedContractAddresses = append(p.WhitelistedContractAddresses, contract.Hex())
+	}
+
+	idx := p.selectorsIndex(contract)
+	switch {
+	case len(selectors) == 0 && idx >= 0:
+		p.ContractAllowedSelectors = append(p.ContractAllowedSelectors[:idx], p.ContractAllowedSelectors[idx+1:]...)
+	case len(selectors) != 0 && idx >= 0:
+		p.ContractAllowedSelectors[idx].Selectors = selectors
+	case len(selectors) != 0:
+		p.ContractAllowedSelectors = append(p.ContractAllowedSelectors, ContractSelectors{
+			ContractAddress: contract.Hex(),
+			Selectors:       selectors,
+		})
+	}
+}
+
+// RemoveWhitelistedContract removes the contract and its allowed selectors, returning false if it was not whitelisted
+func (p *Params) RemoveWhitelistedContract(contract common.Address) bool {
+	idx := p.whitelistIndex(contract)
+	if idx < 0 {
+		return false
+	}
+	p.WhitelistedContractAddresses = append(p.WhitelistedContractAddresses[:idx], p.WhitelistedContractAddresses[idx+1:]...)
+	if idx = p.selectorsIndex(contract); idx >= 0 {
+		p.ContractAllowedSelectors = append(p.ContractAllowedSelectors[:idx], p.ContractAllowedSelectors[idx+1:]...)
+	}
+	return true
+}
+
+func (p Params) whitelistIndex(contract common.Address) int {
+	for i, addr := range p.WhitelistedContractAddresses {
+		if common.HexToAddress(addr) == contract {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p Params) selectorsIndex(contract common.Address) int {
+	for i, entry := range p.ContractAllowedSelectors {
+		if common.HexToAddress(entry.ContractAddress) == contract {
+			return i
+		}
+	}
+	return -1
+}
+
+func isSelector(s string) bool {
+	if len(s) != 2+2*SelectorLength || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	for _, c := range s[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
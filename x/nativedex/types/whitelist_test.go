@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AltheaFoundation/althea-L1/x/nativedex/types"
+)
+
+func TestParams_Whitelist(t *testing.T) {
+	contract := common.HexToAddress("0xd263DC98dEc57828e26F69bA8687281BA5D052E0")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	transfer := common.FromHex("0xa9059cbb0000")
+	approve := common.FromHex("0x095ea7b30000")
+
+	params := *types.DefaultParams()
+	params.WhitelistedContractAddresses = []string{strings.ToLower(contract.Hex())}
+	require.True(t, params.IsWhitelistedContract(contract), "hex case must not matter")
+	require.False(t, params.IsWhitelistedContract(other))
+	require.True(t, params.IsCallAllowed(contract, approve), "contracts without selectors allow any call")
+
+	params.AddWhitelistedContract(contract, []string{"0xA9059CBB"})
+	require.Len(t, params.WhitelistedContractAddresses, 1)
+	require.True(t, params.IsCallAllowed(contract, transfer))
+	require.False(t, params.IsCallAllowed(contract, approve))
+	require.False(t, params.IsCallAllowed(contract, []byte{0xa9}))
+	require.NoError(t, params.ValidateBasic())
+
+	params.AddWhitelistedContract(other, nil)
+	require.True(t, params.IsWhitelistedContract(other))
+	require.Len(t, params.ContractAllowedSelectors, 1)
+
+	params.AddWhitelistedContract(contract, nil)
+	require.Empty(t, params.ContractAllowedSelectors, "an empty selector list lifts the restriction")
+	require.True(t, params.IsCallAllowed(contract, approve))
+
+	params.AddWhitelistedContract(contract, []string{"0x095ea7b3"})
+	require.True(t, params.RemoveWhitelistedContract(contract))
+	require.False(t, params.IsWhitelistedContract(contract))
+	require.Empty(t, params.ContractAllowedSelectors)
+	require.False(t, params.RemoveWhitelistedContract(contract))
+}
+
+// nolint: exhaustruct
+func TestWhitelistProposals_ValidateBasic(t *testing.T) {
+	contract := "0x1111111111111111111111111111111111111111"
+	for _, tc := range []struct {
+		desc    string
+		content interface{ ValidateBasic() error }
+		valid   bool
+	}{
+		{"add without selectors", types.NewAddWhitelistedContractProposal("title", "description", contract, nil), true},
+		{"add with selectors", types.NewAddWhitelistedContractProposal("title", "description", contract, []string{"0xa9059cbb", "0x095EA7B3"}), true},
+		{"add invalid address", types.NewAddWhitelistedContractProposal("title", "description", "0x1234", nil), false},
+		{"add short selector", types.NewAddWhitelistedContractProposal("title", "description", contract, []string{"0xa9059c"}), false},
+		{"add selector without prefix", types.NewAddWhitelistedContractProposal("title", "description", contract, []string{"a9059cbb"}), false},
+		{"add duplicate selector", types.NewAddWhitelistedContractProposal("title", "description", contract, []string{"0xa9059cbb", "0xA9059CBB"}), false},
+		{"remove", types.NewRemoveWhitelistedContractProposal("title", "description", contract), true},
+		{"remove invalid address", types.NewRemoveWhitelistedContractProposal("title", "description", "not an address"), false},
+		{"execute with value", types.NewExecuteContractProposal("title", "description", types.ExecuteContractMetadata{ContractAddress: contract, Data: "0x", Value: "1000"}), true},
+		{"execute with negative value", types.NewExecuteContractProposal("title", "description", types.ExecuteContractMetadata{ContractAddress: contract, Data: "0x", Value: "-1"}), false},
+		{"execute with invalid value", types.NewExecuteContractProposal("title", "description", types.ExecuteContractMetadata{ContractAddress: contract, Data: "0x", Value: "1e18"}), false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.content.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}